@@ -0,0 +1,176 @@
+package autumnlib
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/pkg/errors"
+)
+
+//blocks of an IngestRequest are at most about ingestBatchSize bytes
+const ingestBatchSize = 4 << 20
+
+//Export calls fn with the newest version of each key of the partition in the order
+//of keys, deleted keys are exported as deletes. It returns the seqNumber which the
+//partition is read at
+func (lib *AutumnLib) Export(ctx context.Context, partID uint64, fn func(e *pspb.ExportEntry) error) (uint64, error) {
+	var region *pspb.RegionInfo
+	for _, r := range lib.getRegions() {
+		if r.PartID == partID {
+			region = r
+			break
+		}
+	}
+	if region == nil {
+		return 0, errors.Errorf("no such partition %d", partID)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	conn := lib.getConn(region.Addr)
+	client := pspb.NewPartitionKVClient(conn)
+	stream, err := client.Export(ctx, &pspb.ExportRequest{
+		Partid:    partID,
+		Psversion: region.Psversion,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var seq uint64
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return 0, err
+		}
+		for _, e := range res.Entries {
+			if err = fn(e); err != nil {
+				return 0, err
+			}
+		}
+		seq = res.Seq
+	}
+	//only the last response has the seqNumber
+	if seq == 0 {
+		return 0, errors.Errorf("export of partition %d is not finished", partID)
+	}
+	return seq, nil
+}
+
+//Ingester copies tables built by table.Builder, e.g. those written by export, to
+//the partitions they belong to, without going through their logs. A partition
+//attaches its tables when the ingester moves to the next partition or Close is
+//called, partitions before a failure keep their tables
+type Ingester struct {
+	lib     *AutumnLib
+	ctx     context.Context
+	regions []*pspb.RegionInfo
+	region  *pspb.RegionInfo //partition of stream
+	stream  pspb.PartitionKV_IngestClient
+	cancel  context.CancelFunc //cancels stream, so the partition drops its tables
+}
+
+func (lib *AutumnLib) NewIngester(ctx context.Context) *Ingester {
+	return &Ingester{
+		lib:     lib,
+		ctx:     ctx,
+		regions: lib.getRegions(),
+	}
+}
+
+func inRegion(region *pspb.RegionInfo, key []byte) bool {
+	return bytes.Compare(region.Rg.StartKey, key) <= 0 &&
+		(len(region.Rg.EndKey) == 0 || bytes.Compare(key, region.Rg.EndKey) < 0)
+}
+
+//AddTable sends blocks of a table and its index, smallest and biggest are its user
+//keys, tables are sorted by key. read returns the block at a location of the index.
+//A table can not span partitions. Versions of keys are replaced by the partition
+func (in *Ingester) AddTable(smallest, biggest []byte, index *pspb.TableIndex,
+	read func(*pspb.BlockOffset) (*pb.Block, error)) error {
+	if in.region == nil || !inRegion(in.region, smallest) {
+		if err := in.finish(); err != nil {
+			return err
+		}
+		idx := regionIndex(in.regions, smallest)
+		if idx == len(in.regions) {
+			return errors.Errorf("no region for key %q", smallest)
+		}
+		conn := in.lib.getConn(in.regions[idx].Addr)
+		client := pspb.NewPartitionKVClient(conn)
+		ctx, cancel := context.WithCancel(in.ctx)
+		stream, err := client.Ingest(ctx)
+		if err != nil {
+			cancel()
+			return err
+		}
+		in.region = in.regions[idx]
+		in.stream = stream
+		in.cancel = cancel
+	}
+	if !inRegion(in.region, biggest) {
+		in.Abort()
+		return errors.Errorf("table [%q, %q] spans partition %d", smallest, biggest, in.region.PartID)
+	}
+
+	var blocks []*pb.Block
+	var size int
+	for _, offset := range index.Offsets {
+		block, err := read(offset)
+		if err != nil {
+			in.Abort()
+			return err
+		}
+		blocks = append(blocks, block)
+		if size += len(block.Data); size >= ingestBatchSize {
+			if err = in.send(blocks, nil); err != nil {
+				in.Abort()
+				return err
+			}
+			blocks, size = nil, 0
+		}
+	}
+	if err := in.send(blocks, index); err != nil {
+		in.Abort()
+		return err
+	}
+	return nil
+}
+
+func (in *Ingester) send(blocks []*pb.Block, index *pspb.TableIndex) error {
+	return in.stream.Send(&pspb.IngestRequest{
+		Partid:    in.region.PartID,
+		Psversion: in.region.Psversion,
+		Blocks:    blocks,
+		Index:     index,
+	})
+}
+
+//finish waits for the partition to attach the tables
+func (in *Ingester) finish() error {
+	if in.stream == nil {
+		return nil
+	}
+	_, err := in.stream.CloseAndRecv()
+	in.cancel()
+	in.stream = nil
+	return err
+}
+
+//Abort drops tables sent to the current partition, AddTable calls it if it fails
+func (in *Ingester) Abort() {
+	if in.stream != nil {
+		in.cancel()
+		in.stream = nil
+		in.region = nil
+	}
+}
+
+//Close finishes the ingest of the last partition
+func (in *Ingester) Close() error {
+	return in.finish()
+}
@@ -0,0 +1,615 @@
+package autumnlib
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/journeymidnight/autumn/manager/pmclient"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+type AutumnLib struct {
+	pm              *pmclient.AutumnPMClient
+	pmAddr          []string
+	regions         []*pspb.RegionInfo
+	utils.SafeMutex //protect regions
+
+	conns    map[string]*grpc.ClientConn
+	connLock utils.SafeMutex //protect conns
+
+	kv objectKV //objects are stored in it
+
+	//newPSClient returns the client of the PS at addr, tests replace it
+	newPSClient func(addr string) pspb.PartitionKVClient
+}
+
+func NewAutumnLib(pmAddr []string) *AutumnLib {
+	lib := &AutumnLib{
+		pmAddr: pmAddr,
+		pm:     pmclient.NewAutumnPMClient(pmAddr),
+		conns:  make(map[string]*grpc.ClientConn),
+	}
+	lib.kv = lib
+	lib.newPSClient = func(addr string) pspb.PartitionKVClient {
+		return pspb.NewPartitionKVClient(lib.getConn(addr))
+	}
+	return lib
+}
+
+func (lib *AutumnLib) Connect() error {
+	if err := lib.pm.Connect(); err != nil {
+		return err
+	}
+	lib.update()
+	return nil
+}
+
+func (lib *AutumnLib) getConn(addr string) *grpc.ClientConn {
+	lib.connLock.RLock()
+	conn, ok := lib.conns[addr]
+	lib.connLock.RUnlock()
+	if ok {
+		return conn
+	}
+
+	var err error
+	for {
+		//PS sends messages up to 65MB
+		conn, err = grpc.Dial(addr, grpc.WithBackoffMaxDelay(time.Second), grpc.WithInsecure(),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(65<<20)))
+		if err == nil {
+			break
+		}
+		//FIXME: client log
+		time.Sleep(5 * time.Millisecond)
+	}
+	lib.connLock.Lock()
+	lib.conns[addr] = conn
+	lib.connLock.Unlock()
+
+	return conn
+}
+
+func (lib *AutumnLib) getRegions() []*pspb.RegionInfo {
+	lib.RLock()
+	defer lib.RUnlock()
+	return lib.regions
+}
+
+func (lib *AutumnLib) update() {
+	//loop forever
+	var newRegions []*pspb.RegionInfo
+	for {
+		newRegions = lib.pm.GetRegions()
+		if newRegions != nil {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	//sort by StartKEY
+	sort.Slice(newRegions, func(i, j int) bool {
+		return bytes.Compare(newRegions[i].Rg.StartKey, newRegions[j].Rg.StartKey) < 0
+	})
+
+	lib.Lock()
+	lib.regions = newRegions
+	lib.Unlock()
+
+}
+
+//withRedirect runs f again with new regions if the partition has been moved,
+//split or merged
+func (lib *AutumnLib) withRedirect(f func(sortedRegions []*pspb.RegionInfo) error) error {
+	err := f(lib.getRegions())
+	if wire_errors.IsRedirect(err) || wire_errors.IsPSVersionMismatch(err) {
+		lib.update()
+		err = f(lib.getRegions())
+	}
+	return err
+}
+
+//regionIndex returns the region which key belongs to
+func regionIndex(sortedRegions []*pspb.RegionInfo, key []byte) int {
+	return sort.Search(len(sortedRegions), func(i int) bool {
+		if len(sortedRegions[i].Rg.EndKey) == 0 {
+			return true
+		}
+		return bytes.Compare(sortedRegions[i].Rg.EndKey, key) > 0
+	})
+}
+
+func (lib *AutumnLib) Put(ctx context.Context, key, value []byte) error {
+	return lib.PutWithTTL(ctx, key, value, 0)
+}
+
+//PutWithTTL puts a key which expires after ttl, ttl 0 means never expire
+func (lib *AutumnLib) PutWithTTL(ctx context.Context, key, value []byte, ttl time.Duration) error {
+	return lib.put(ctx, key, value, expiresAt(ttl))
+}
+
+//expiresAt returns the unix time after ttl, 0 means never
+func expiresAt(ttl time.Duration) uint64 {
+	if ttl > 0 {
+		return uint64(time.Now().Add(ttl).Unix())
+	}
+	return 0
+}
+
+func (lib *AutumnLib) put(ctx context.Context, key, value []byte, expiresAt uint64) error {
+	return lib.withRedirect(func(sortedRegions []*pspb.RegionInfo) error {
+		if len(sortedRegions) == 0 {
+			return errors.New("no regions to write")
+		}
+		idx := regionIndex(sortedRegions, key)
+
+		conn := lib.getConn(sortedRegions[idx].Addr)
+		client := pspb.NewPartitionKVClient(conn)
+		_, err := client.Put(ctx, &pspb.PutRequest{
+			Key:       key,
+			Value:     value,
+			ExpiresAt: expiresAt,
+			Partid:    sortedRegions[idx].PartID,
+			Psversion: sortedRegions[idx].Psversion,
+		})
+		return err
+	})
+}
+
+func (lib *AutumnLib) Get(ctx context.Context, key []byte) ([]byte, error) {
+	var value []byte
+	err := lib.withRedirect(func(sortedRegions []*pspb.RegionInfo) error {
+		if len(sortedRegions) == 0 {
+			return errors.New("no regions to write")
+		}
+		idx := regionIndex(sortedRegions, key)
+
+		conn := lib.getConn(sortedRegions[idx].Addr)
+		client := pspb.NewPartitionKVClient(conn)
+		res, err := client.Get(ctx, &pspb.GetRequest{
+			Key:       key,
+			Partid:    sortedRegions[idx].PartID,
+			Psversion: sortedRegions[idx].Psversion,
+		})
+		if err != nil {
+			return err
+		}
+		value = res.Value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+//GetVersions returns at most limit versions of key older than before from the newest one,
+//before 0 means from the latest version. It returns true if there are older versions
+func (lib *AutumnLib) GetVersions(ctx context.Context, key []byte, before uint64, limit uint32, values bool) ([]*pspb.KeyVersion, bool, error) {
+	var res *pspb.GetVersionsResponse
+	err := lib.withRedirect(func(sortedRegions []*pspb.RegionInfo) error {
+		if len(sortedRegions) == 0 {
+			return errors.New("no regions to read")
+		}
+		idx := regionIndex(sortedRegions, key)
+
+		conn := lib.getConn(sortedRegions[idx].Addr)
+		client := pspb.NewPartitionKVClient(conn)
+		var err error
+		res, err = client.GetVersions(ctx, &pspb.GetVersionsRequest{
+			Key:       key,
+			Before:    before,
+			Limit:     limit,
+			Values:    values,
+			Partid:    sortedRegions[idx].PartID,
+			Psversion: sortedRegions[idx].Psversion,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return res.Versions, res.Truncated, nil
+}
+
+//PrefixEnd returns the smallest key bigger than all keys having the prefix, nil means no bound
+func PrefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+//RangeOption describes a scan over [Start, End) whose keys have Prefix, the scan
+//walks all consecutive regions overlapping the range
+type RangeOption struct {
+	Prefix   []byte
+	Start    []byte //inclusive
+	End      []byte //exclusive, empty means no upper bound
+	Continue []byte //resume strictly after this key in the scan direction
+	Limit    uint32 //0 means no limit
+	Reverse  bool
+	Values   bool //also return values
+	//Version reads at this seqNumber, 0 means the latest. seqNumbers belong to
+	//one partition, so a range with Version must not cross regions
+	Version uint64
+}
+
+type RangeResult struct {
+	Keys   [][]byte
+	Values [][]byte //only set if RangeOption.Values
+	//Truncated is true if there may be more keys, pass Continue in the next RangeOption to get them
+	Truncated bool
+	Continue  []byte
+}
+
+//Range returns keys having prefix from start in order, it walks all consecutive regions
+//overlapping the range. limit 0 means no limit
+func (lib *AutumnLib) Range(ctx context.Context, prefix []byte, start []byte, limit uint32) ([][]byte, error) {
+	res, err := lib.RangeWithOption(ctx, RangeOption{Prefix: prefix, Start: start, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	return res.Keys, nil
+}
+
+//RangeWithOption scans keys of all regions overlapping the range described by opt
+func (lib *AutumnLib) RangeWithOption(ctx context.Context, opt RangeOption) (*RangeResult, error) {
+	if opt.Limit == 0 {
+		opt.Limit = math.MaxUint32
+	}
+	var res *RangeResult
+	err := lib.withRedirect(func(sortedRegions []*pspb.RegionInfo) (err error) {
+		res, err = lib.rangeRegions(ctx, sortedRegions, opt)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+//rangeBounds returns [lower, upper) of the scan, empty upper means no bound
+func rangeBounds(opt RangeOption) ([]byte, []byte) {
+	lower := opt.Start
+	if bytes.Compare(opt.Prefix, lower) > 0 {
+		lower = opt.Prefix
+	}
+	upper := opt.End
+	if end := PrefixEnd(opt.Prefix); len(end) > 0 && (len(upper) == 0 || bytes.Compare(end, upper) < 0) {
+		upper = end
+	}
+	return lower, upper
+}
+
+//lastRegionBefore returns the last region which has keys smaller than key, key
+//must not be empty
+func lastRegionBefore(sortedRegions []*pspb.RegionInfo, key []byte) int {
+	return sort.Search(len(sortedRegions), func(i int) bool {
+		return bytes.Compare(sortedRegions[i].Rg.StartKey, key) >= 0
+	}) - 1
+}
+
+func (lib *AutumnLib) rangeRegions(ctx context.Context, sortedRegions []*pspb.RegionInfo, opt RangeOption) (*RangeResult, error) {
+	if len(sortedRegions) == 0 {
+		return nil, errors.New("no regions to read")
+	}
+	res := &RangeResult{}
+	lower, upper := rangeBounds(opt)
+	if len(upper) > 0 && bytes.Compare(lower, upper) >= 0 {
+		return res, nil
+	}
+
+	//regions overlapping [lower, upper)
+	first, last := regionIndex(sortedRegions, lower), len(sortedRegions)-1
+	if len(upper) > 0 {
+		last = lastRegionBefore(sortedRegions, upper)
+	}
+	if opt.Version > 0 && first != last {
+		return nil, errors.New("range with a read version crosses regions")
+	}
+	//a continued scan starts from the region of Continue
+	if len(opt.Continue) > 0 {
+		if !opt.Reverse && bytes.Compare(opt.Continue, lower) > 0 {
+			first = regionIndex(sortedRegions, opt.Continue)
+		} else if opt.Reverse && (len(upper) == 0 || bytes.Compare(opt.Continue, upper) < 0) {
+			last = lastRegionBefore(sortedRegions, opt.Continue)
+		}
+	}
+
+	continuation := opt.Continue
+	//regions are sorted and do not overlap, so appending region by region keeps the key order
+	for i := 0; i <= last-first; i++ {
+		region := sortedRegions[first+i]
+		if opt.Reverse {
+			region = sortedRegions[last-i]
+		}
+		regionStart := lower
+		if bytes.Compare(region.Rg.StartKey, regionStart) > 0 {
+			regionStart = region.Rg.StartKey
+		}
+
+		client := lib.newPSClient(region.Addr)
+		for {
+			//the next region may have more keys
+			if uint32(len(res.Keys)) >= opt.Limit {
+				res.Truncated = true
+				return res, nil
+			}
+			r, err := client.Range(ctx, &pspb.RangeRequest{
+				Prefix:       opt.Prefix,
+				Start:        regionStart,
+				End:          upper,
+				Limit:        opt.Limit - uint32(len(res.Keys)),
+				Reverse:      opt.Reverse,
+				Values:       opt.Values,
+				Version:      opt.Version,
+				Partid:       region.PartID,
+				Psversion:    region.Psversion,
+				Continuation: continuation,
+			})
+			if err != nil {
+				return nil, err
+			}
+			res.Keys = append(res.Keys, r.Keys...)
+			res.Values = append(res.Values, r.Values...)
+			if len(r.Keys) > 0 {
+				res.Continue = r.Keys[len(r.Keys)-1]
+			}
+			if r.Truncated == 0 {
+				break
+			}
+			continuation = r.Continuation
+		}
+		continuation = nil
+	}
+	return res, nil
+}
+
+func (lib *AutumnLib) Delete(ctx context.Context, key []byte) error {
+	return lib.withRedirect(func(sortedRegions []*pspb.RegionInfo) error {
+		if len(sortedRegions) == 0 {
+			return errors.New("no regions to write")
+		}
+		idx := regionIndex(sortedRegions, key)
+
+		conn := lib.getConn(sortedRegions[idx].Addr)
+		client := pspb.NewPartitionKVClient(conn)
+		_, err := client.Delete(ctx, &pspb.DeleteRequest{
+			Key:       key,
+			Partid:    sortedRegions[idx].PartID,
+			Psversion: sortedRegions[idx].Psversion,
+		})
+		return err
+	})
+}
+
+//DeleteRange deletes keys in [start, end) of all regions overlapping the range,
+//empty end means no upper bound. Each region is deleted atomically, but regions
+//are deleted one by one
+func (lib *AutumnLib) DeleteRange(ctx context.Context, start, end []byte) error {
+	return lib.withRedirect(func(sortedRegions []*pspb.RegionInfo) error {
+		if len(sortedRegions) == 0 {
+			return errors.New("no regions to write")
+		}
+		for idx := regionIndex(sortedRegions, start); idx < len(sortedRegions); idx++ {
+			region := sortedRegions[idx]
+			if len(end) > 0 && bytes.Compare(region.Rg.StartKey, end) >= 0 {
+				break
+			}
+			regionStart := start
+			if bytes.Compare(region.Rg.StartKey, regionStart) > 0 {
+				regionStart = region.Rg.StartKey
+			}
+
+			conn := lib.getConn(region.Addr)
+			client := pspb.NewPartitionKVClient(conn)
+			_, err := client.DeleteRange(ctx, &pspb.DeleteRangeRequest{
+				Start:     regionStart,
+				End:       end,
+				Partid:    region.PartID,
+				Psversion: region.Psversion,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+//DropPrefix deletes all keys having prefix
+func (lib *AutumnLib) DropPrefix(ctx context.Context, prefix []byte) error {
+	return lib.DeleteRange(ctx, prefix, PrefixEnd(prefix))
+}
+
+//SplitPart splits the partition at splitKey, empty splitKey means the PS picks one.
+//it returns the new partition and the split key
+func (lib *AutumnLib) SplitPart(ctx context.Context, partID uint64, splitKey []byte) (uint64, []byte, error) {
+	var region *pspb.RegionInfo
+	for _, r := range lib.getRegions() {
+		if r.PartID == partID {
+			region = r
+			break
+		}
+	}
+	if region == nil {
+		return 0, nil, errors.Errorf("no such partition %d", partID)
+	}
+
+	conn := lib.getConn(region.Addr)
+	client := pspb.NewPartitionKVClient(conn)
+	res, err := client.SplitPart(ctx, &pspb.SplitPartRequest{
+		Partid:    partID,
+		SplitKey:  splitKey,
+		Psversion: region.Psversion,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	if res.Code != pb.Code_OK {
+		return 0, nil, wire_errors.FromPBCode(res.Code, res.CodeDes)
+	}
+	lib.update()
+	return res.NewPartID, res.SplitKey, nil
+}
+
+//MergePart merges the partition with the next one, they must be on the same PS.
+//it returns the partition which is merged into partID
+func (lib *AutumnLib) MergePart(ctx context.Context, partID uint64) (uint64, error) {
+	var region *pspb.RegionInfo
+	for _, r := range lib.getRegions() {
+		if r.PartID == partID {
+			region = r
+			break
+		}
+	}
+	if region == nil {
+		return 0, errors.Errorf("no such partition %d", partID)
+	}
+
+	conn := lib.getConn(region.Addr)
+	client := pspb.NewPartitionKVClient(conn)
+	res, err := client.MergePart(ctx, &pspb.MergePartRequest{
+		Partid:    partID,
+		Psversion: region.Psversion,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if res.Code != pb.Code_OK {
+		return 0, wire_errors.FromPBCode(res.Code, res.CodeDes)
+	}
+	lib.update()
+	return res.RightPartID, nil
+}
+
+//PartStats returns loads and pending compactions of the partition
+func (lib *AutumnLib) PartStats(ctx context.Context, partID uint64) (*pspb.PartStatsResponse, error) {
+	var region *pspb.RegionInfo
+	for _, r := range lib.getRegions() {
+		if r.PartID == partID {
+			region = r
+			break
+		}
+	}
+	if region == nil {
+		return nil, errors.Errorf("no such partition %d", partID)
+	}
+
+	conn := lib.getConn(region.Addr)
+	client := pspb.NewPartitionKVClient(conn)
+	res, err := client.PartStats(ctx, &pspb.PartStatsRequest{
+		Partid: partID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != pb.Code_OK {
+		return nil, wire_errors.FromPBCode(res.Code, res.CodeDes)
+	}
+	return res, nil
+}
+
+//Reassign moves the partition to PS psID
+func (lib *AutumnLib) Reassign(ctx context.Context, partID uint64, psID uint64) error {
+	if err := lib.pm.ReassignPartition(partID, psID); err != nil {
+		return err
+	}
+	lib.update()
+	return nil
+}
+
+//Watch calls fn with puts and deletes of keys having prefix in all partitions
+//overlapping the prefix, events of each partition are in the order of seq. cursors
+//is the next seq to watch of each partition, partitions not in cursors are watched
+//from the next write. fn is called concurrently by partitions. wire_errors.IsWatchCompacted
+//is true for the error if a cursor is before the log head of its partition.
+func (lib *AutumnLib) Watch(ctx context.Context, prefix []byte, cursors map[uint64]uint64, fn func(partID uint64, ev *pspb.WatchEvent) error) error {
+	end := PrefixEnd(prefix)
+	var parts []uint64
+	for _, region := range lib.getRegions() {
+		if len(region.Rg.EndKey) > 0 && bytes.Compare(region.Rg.EndKey, prefix) <= 0 {
+			continue
+		}
+		if len(end) > 0 && bytes.Compare(region.Rg.StartKey, end) >= 0 {
+			continue
+		}
+		parts = append(parts, region.PartID)
+	}
+	if len(parts) == 0 {
+		return errors.New("no regions to watch")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errC := make(chan error, len(parts))
+	var wg sync.WaitGroup
+	for _, partID := range parts {
+		wg.Add(1)
+		go func(partID uint64) {
+			defer wg.Done()
+			err := lib.watchPart(ctx, partID, prefix, cursors[partID], fn)
+			if err != nil {
+				errC <- err
+				cancel()
+			}
+		}(partID)
+	}
+	wg.Wait()
+	close(errC)
+	//the first error
+	return <-errC
+}
+
+func (lib *AutumnLib) watchPart(ctx context.Context, partID uint64, prefix []byte, next uint64, fn func(partID uint64, ev *pspb.WatchEvent) error) error {
+	return lib.withRedirect(func(sortedRegions []*pspb.RegionInfo) error {
+		var region *pspb.RegionInfo
+		for _, r := range sortedRegions {
+			if r.PartID == partID {
+				region = r
+				break
+			}
+		}
+		if region == nil {
+			return errors.Errorf("partition %d is merged or moved", partID)
+		}
+
+		conn := lib.getConn(region.Addr)
+		client := pspb.NewPartitionKVClient(conn)
+		stream, err := client.Watch(ctx, &pspb.WatchRequest{
+			Prefix:    prefix,
+			StartSeq:  next,
+			Partid:    partID,
+			Psversion: region.Psversion,
+		})
+		if err != nil {
+			return err
+		}
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			for _, ev := range res.Events {
+				if err = fn(partID, ev); err != nil {
+					return err
+				}
+				//resume after this event if the partition moves
+				next = ev.Seq + 1
+			}
+		}
+	})
+}
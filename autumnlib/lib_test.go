@@ -0,0 +1,160 @@
+package autumnlib
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+//fakePS serves Range of regions from keys in memory, it returns at most
+//pageSize keys a call, so both PS and regions are paged
+type fakePS struct {
+	pspb.PartitionKVClient
+	regions  map[uint64]*pspb.RegionInfo
+	keys     []string //sorted
+	pageSize int
+}
+
+func (ps *fakePS) Range(ctx context.Context, req *pspb.RangeRequest, opts ...grpc.CallOption) (*pspb.RangeResponse, error) {
+	region := ps.regions[req.Partid]
+	if region == nil || !inRegion(region, req.Start) {
+		return nil, wire_errors.Redirect
+	}
+	var keys []string
+	for _, k := range ps.keys {
+		if !inRegion(region, []byte(k)) || !bytes.HasPrefix([]byte(k), req.Prefix) || k < string(req.Start) ||
+			(len(req.End) > 0 && k >= string(req.End)) {
+			continue
+		}
+		if len(req.Continuation) > 0 && ((!req.Reverse && k <= string(req.Continuation)) ||
+			(req.Reverse && k >= string(req.Continuation))) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	if req.Reverse {
+		sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+	}
+
+	limit := ps.pageSize
+	if int(req.Limit) < limit {
+		limit = int(req.Limit)
+	}
+	res := &pspb.RangeResponse{}
+	for i, k := range keys {
+		if i == limit {
+			res.Truncated = 1
+			res.Continuation = res.Keys[len(res.Keys)-1]
+			break
+		}
+		res.Keys = append(res.Keys, []byte(k))
+		if req.Values {
+			res.Values = append(res.Values, []byte("v"+k))
+		}
+	}
+	return res, nil
+}
+
+//newRangeLib returns a lib whose regions are [,k10), [k10,k20) and [k20,),
+//keys are k00 to k29
+func newRangeLib() *AutumnLib {
+	ps := &fakePS{regions: make(map[uint64]*pspb.RegionInfo), pageSize: 3}
+	bounds := []string{"", "k10", "k20", ""}
+	lib := &AutumnLib{newPSClient: func(addr string) pspb.PartitionKVClient { return ps }}
+	for i := 0; i < 3; i++ {
+		region := &pspb.RegionInfo{
+			Rg:     &pspb.Range{StartKey: []byte(bounds[i]), EndKey: []byte(bounds[i+1])},
+			PartID: uint64(i + 1),
+			Addr:   fmt.Sprintf("ps%d", i),
+		}
+		ps.regions[region.PartID] = region
+		lib.regions = append(lib.regions, region)
+	}
+	for i := 0; i < 30; i++ {
+		ps.keys = append(ps.keys, fmt.Sprintf("k%02d", i))
+	}
+	return lib
+}
+
+//rangeAll pages through the range with Continue, it returns keys, values and
+//the number of pages
+func rangeAll(t *testing.T, lib *AutumnLib, opt RangeOption) ([]string, []string, int) {
+	var keys, values []string
+	pages := 0
+	for {
+		res, err := lib.rangeRegions(context.Background(), lib.getRegions(), opt)
+		require.NoError(t, err)
+		pages++
+		require.True(t, uint32(len(res.Keys)) <= opt.Limit)
+		for i, k := range res.Keys {
+			keys = append(keys, string(k))
+			if opt.Values {
+				values = append(values, string(res.Values[i]))
+			}
+		}
+		if !res.Truncated {
+			return keys, values, pages
+		}
+		opt.Continue = res.Continue
+	}
+}
+
+func rangeKeys(from, to int, reverse bool) []string {
+	var out []string
+	for i := from; i < to; i++ {
+		out = append(out, fmt.Sprintf("k%02d", i))
+	}
+	if reverse {
+		sort.Sort(sort.Reverse(sort.StringSlice(out)))
+	}
+	return out
+}
+
+func TestRangeContinueAcrossRegions(t *testing.T) {
+	lib := newRangeLib()
+
+	//the page ends at the last key of a region, the next one starts from the next region
+	keys, _, pages := rangeAll(t, lib, RangeOption{Limit: 10})
+	require.Equal(t, rangeKeys(0, 30, false), keys)
+	require.Equal(t, 3, pages)
+
+	keys, values, _ := rangeAll(t, lib, RangeOption{Prefix: []byte("k"), Limit: 4, Values: true})
+	require.Equal(t, rangeKeys(0, 30, false), keys)
+	for i := range keys {
+		require.Equal(t, "v"+keys[i], values[i])
+	}
+
+	keys, _, _ = rangeAll(t, lib, RangeOption{Start: []byte("k05"), End: []byte("k25"), Limit: 4})
+	require.Equal(t, rangeKeys(5, 25, false), keys)
+
+	//end at the start of a region
+	keys, _, _ = rangeAll(t, lib, RangeOption{Start: []byte("k05"), End: []byte("k20"), Limit: 7})
+	require.Equal(t, rangeKeys(5, 20, false), keys)
+
+	keys, _, pages = rangeAll(t, lib, RangeOption{Reverse: true, Limit: 10})
+	require.Equal(t, rangeKeys(0, 30, true), keys)
+	require.Equal(t, 3, pages)
+
+	keys, _, _ = rangeAll(t, lib, RangeOption{Reverse: true, Start: []byte("k05"), End: []byte("k20"), Limit: 4})
+	require.Equal(t, rangeKeys(5, 20, true), keys)
+
+	keys, _, _ = rangeAll(t, lib, RangeOption{Prefix: []byte("k1"), Reverse: true, Limit: 4})
+	require.Equal(t, rangeKeys(10, 20, true), keys)
+}
+
+func TestRangeVersionInOneRegion(t *testing.T) {
+	lib := newRangeLib()
+
+	_, err := lib.rangeRegions(context.Background(), lib.getRegions(), RangeOption{Version: 100, Limit: 100})
+	require.Error(t, err)
+
+	keys, _, _ := rangeAll(t, lib, RangeOption{Start: []byte("k12"), End: []byte("k18"), Version: 100, Limit: 4})
+	require.Equal(t, rangeKeys(12, 18, false), keys)
+}
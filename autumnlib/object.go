@@ -0,0 +1,334 @@
+package autumnlib
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/pkg/errors"
+)
+
+//keys CleanChunks reads at a time
+var cleanPageSize uint32 = 1000
+
+//ObjectChunkSize is the size of chunks of objects written by PutObject. PS writes
+//blocks bigger than 2MB synchronously, so chunks are smaller than it
+const ObjectChunkSize = 1 << 20
+
+//values which have the magic prefix are manifests of chunked objects
+var manifestMagic = []byte("\x00autumn-manifest\x00")
+
+//uploadID is the unix nano time of the upload in hex and a random number,
+//so orphaned chunks can be found by their age
+const (
+	uploadIDLen       = 24
+	chunkIndexLen     = 8
+	chunkKeySuffixLen = 1 + uploadIDLen + 1 + chunkIndexLen
+)
+
+//the last key of an upload is written when its object is replaced or deleted, the
+//value is the unix nano time in hex, CleanChunks keeps the chunks for readers of
+//the old object till olderThan after it
+const orphanedIndex = "orphaned"
+
+//objectKV is the KV which objects are stored in, AutumnLib itself except in tests
+type objectKV interface {
+	Get(ctx context.Context, key []byte) ([]byte, error)
+	put(ctx context.Context, key, value []byte, expiresAt uint64) error
+	Delete(ctx context.Context, key []byte) error
+	Range(ctx context.Context, prefix []byte, start []byte, limit uint32) ([][]byte, error)
+	DropPrefix(ctx context.Context, prefix []byte) error
+}
+
+func newUploadID() string {
+	return fmt.Sprintf("%016x%08x", time.Now().UnixNano(), rand.Uint32())
+}
+
+//uploadTime returns when the upload started
+func uploadTime(uploadID string) time.Time {
+	return parseTime(uploadID[:16])
+}
+
+func parseTime(s string) time.Time {
+	ns, _ := strconv.ParseUint(s, 16, 64)
+	return time.Unix(0, int64(ns))
+}
+
+//ChunkPrefix is the prefix of chunks of one upload, chunks sort right after the
+//object key, so they are usually in the same partition
+func ChunkPrefix(key []byte, uploadID string) []byte {
+	out := make([]byte, 0, len(key)+chunkKeySuffixLen)
+	out = append(out, key...)
+	out = append(out, 0)
+	out = append(out, uploadID...)
+	return append(out, '/')
+}
+
+func chunkKey(key []byte, uploadID string, i uint32) []byte {
+	return append(ChunkPrefix(key, uploadID), fmt.Sprintf("%08d", i)...)
+}
+
+func orphanedKey(key []byte, uploadID string) []byte {
+	return append(ChunkPrefix(key, uploadID), orphanedIndex...)
+}
+
+//ParseChunkKey returns the object key and the uploadID of a chunk key, or of the
+//orphaned mark of an upload. ok is false if key is not a chunk key
+func ParseChunkKey(key []byte) (objectKey []byte, uploadID string, ok bool) {
+	if len(key) < chunkKeySuffixLen {
+		return nil, "", false
+	}
+	suffix := key[len(key)-chunkKeySuffixLen:]
+	if suffix[0] != 0 || suffix[1+uploadIDLen] != '/' {
+		return nil, "", false
+	}
+	if _, err := hex.DecodeString(string(suffix[1 : 1+uploadIDLen])); err != nil {
+		return nil, "", false
+	}
+	if index := string(suffix[2+uploadIDLen:]); index != orphanedIndex {
+		if _, err := strconv.ParseUint(index, 10, 32); err != nil {
+			return nil, "", false
+		}
+	}
+	return key[:len(key)-chunkKeySuffixLen], string(suffix[1 : 1+uploadIDLen]), true
+}
+
+//IsChunkKey returns true if key is a chunk of an object, listings skip them
+func IsChunkKey(key []byte) bool {
+	_, _, ok := ParseChunkKey(key)
+	return ok
+}
+
+func decodeManifest(value []byte) (*pspb.ObjectManifest, bool) {
+	if !bytes.HasPrefix(value, manifestMagic) {
+		return nil, false
+	}
+	m := &pspb.ObjectManifest{}
+	if err := m.Unmarshal(value[len(manifestMagic):]); err != nil {
+		return nil, false
+	}
+	return m, true
+}
+
+func encodeManifest(m *pspb.ObjectManifest) []byte {
+	data, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return append(append([]byte{}, manifestMagic...), data...)
+}
+
+//getManifest returns the manifest of key, nil if key does not exist or is not chunked
+func (lib *AutumnLib) getManifest(ctx context.Context, key []byte) (*pspb.ObjectManifest, error) {
+	value, err := lib.kv.Get(ctx, key)
+	if wire_errors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	m, _ := decodeManifest(value)
+	return m, nil
+}
+
+//PutObject writes the object read from r. Objects not bigger than ObjectChunkSize are
+//written as one value, bigger ones are written in chunks, then the manifest is written
+//to key as the commit point, so readers see either the old or the new object. Chunks of
+//a failed upload are deleted. Chunks of the replaced object may still be read, they are
+//marked orphaned and deleted by CleanChunks, as are those left by crashed clients
+func (lib *AutumnLib) PutObject(ctx context.Context, key []byte, r io.Reader, ttl time.Duration) error {
+	exp := expiresAt(ttl)
+	buf := make([]byte, ObjectChunkSize)
+	n, rerr := io.ReadFull(r, buf)
+	if rerr != nil && rerr != io.EOF && rerr != io.ErrUnexpectedEOF {
+		return rerr
+	}
+
+	old, err := lib.getManifest(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	//small values which look like manifests are chunked too
+	var m *pspb.ObjectManifest
+	if rerr != nil && !bytes.HasPrefix(buf[:n], manifestMagic) {
+		if err = lib.kv.put(ctx, key, buf[:n], exp); err != nil {
+			return err
+		}
+	} else {
+		m = &pspb.ObjectManifest{UploadID: newUploadID(), ChunkSize: ObjectChunkSize}
+		for n > 0 {
+			if err = lib.kv.put(ctx, chunkKey(key, m.UploadID, m.NumChunks), buf[:n], exp); err != nil {
+				lib.dropChunks(key, m.UploadID)
+				return err
+			}
+			m.NumChunks++
+			m.Length += uint64(n)
+			if rerr != nil {
+				break
+			}
+			n, rerr = io.ReadFull(r, buf)
+			if rerr != nil && rerr != io.EOF && rerr != io.ErrUnexpectedEOF {
+				lib.dropChunks(key, m.UploadID)
+				return rerr
+			}
+		}
+		if err = lib.kv.put(ctx, key, encodeManifest(m), exp); err != nil {
+			lib.dropChunks(key, m.UploadID)
+			return err
+		}
+	}
+
+	if old != nil {
+		lib.markOrphaned(key, old.UploadID)
+	}
+	return nil
+}
+
+//dropChunks deletes chunks of an upload, it is best effort and does not use the
+//ctx of the request, which may be canceled
+func (lib *AutumnLib) dropChunks(key []byte, uploadID string) error {
+	return lib.kv.DropPrefix(context.Background(), ChunkPrefix(key, uploadID))
+}
+
+//markOrphaned records when the object of the upload is replaced or deleted, it is
+//best effort, without the mark CleanChunks counts from the start of the upload
+func (lib *AutumnLib) markOrphaned(key []byte, uploadID string) error {
+	now := fmt.Sprintf("%016x", time.Now().UnixNano())
+	return lib.kv.put(context.Background(), orphanedKey(key, uploadID), []byte(now), 0)
+}
+
+//orphanedTime returns when the upload is orphaned
+func (lib *AutumnLib) orphanedTime(ctx context.Context, key []byte, uploadID string) (time.Time, error) {
+	value, err := lib.kv.Get(ctx, orphanedKey(key, uploadID))
+	if wire_errors.IsNotFound(err) {
+		return uploadTime(uploadID), nil
+	} else if err != nil {
+		return time.Time{}, err
+	}
+	return parseTime(string(value)), nil
+}
+
+//GetObject returns a reader of the object and its size, chunks are read when
+//the reader needs them
+func (lib *AutumnLib) GetObject(ctx context.Context, key []byte) (io.Reader, uint64, error) {
+	value, err := lib.kv.Get(ctx, key)
+	if err != nil {
+		return nil, 0, err
+	}
+	m, ok := decodeManifest(value)
+	if !ok {
+		return bytes.NewReader(value), uint64(len(value)), nil
+	}
+	return &objectReader{lib: lib, ctx: ctx, key: key, m: m}, m.Length, nil
+}
+
+//HeadObject returns the size of the object
+func (lib *AutumnLib) HeadObject(ctx context.Context, key []byte) (uint64, error) {
+	value, err := lib.kv.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	if m, ok := decodeManifest(value); ok {
+		return m.Length, nil
+	}
+	return uint64(len(value)), nil
+}
+
+//DeleteObject deletes the object, its chunks are marked orphaned like those of a
+//replaced object
+func (lib *AutumnLib) DeleteObject(ctx context.Context, key []byte) error {
+	m, err := lib.getManifest(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err = lib.kv.Delete(ctx, key); err != nil {
+		return err
+	}
+	if m != nil {
+		return lib.markOrphaned(key, m.UploadID)
+	}
+	return nil
+}
+
+//CleanChunks deletes chunks having prefix which are not in the manifest of their
+//object. Uploads started in olderThan are not touched, so are orphaned ones whose
+//object was replaced or deleted in olderThan, so readers of the old object can
+//finish. It returns the number of uploads deleted
+func (lib *AutumnLib) CleanChunks(ctx context.Context, prefix []byte, olderThan time.Duration) (int, error) {
+	var cleaned int
+	start := prefix
+	for {
+		keys, err := lib.kv.Range(ctx, prefix, start, cleanPageSize)
+		if err != nil {
+			return cleaned, err
+		}
+		if len(keys) == 0 {
+			return cleaned, nil
+		}
+		start = append(append([]byte{}, keys[len(keys)-1]...), 0)
+		for _, k := range keys {
+			key, uploadID, ok := ParseChunkKey(k)
+			if !ok {
+				continue
+			}
+			//the rest of the upload is skipped
+			start = PrefixEnd(ChunkPrefix(key, uploadID))
+			if time.Since(uploadTime(uploadID)) < olderThan {
+				break
+			}
+			m, err := lib.getManifest(ctx, key)
+			if err != nil {
+				return cleaned, err
+			}
+			if m != nil && m.UploadID == uploadID {
+				break
+			}
+			orphaned, err := lib.orphanedTime(ctx, key, uploadID)
+			if err != nil {
+				return cleaned, err
+			}
+			if time.Since(orphaned) < olderThan {
+				break
+			}
+			if err = lib.dropChunks(key, uploadID); err != nil {
+				return cleaned, err
+			}
+			cleaned++
+			break
+		}
+	}
+}
+
+//objectReader reads chunks of an object one by one
+type objectReader struct {
+	lib  *AutumnLib
+	ctx  context.Context
+	key  []byte
+	m    *pspb.ObjectManifest
+	next uint32
+	buf  []byte
+}
+
+func (r *objectReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.next >= r.m.NumChunks {
+			return 0, io.EOF
+		}
+		chunk, err := r.lib.kv.Get(r.ctx, chunkKey(r.key, r.m.UploadID, r.next))
+		if err != nil {
+			return 0, errors.Wrapf(err, "read chunk %d of %s", r.next, r.key)
+		}
+		r.next++
+		r.buf = chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
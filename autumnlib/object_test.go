@@ -0,0 +1,281 @@
+package autumnlib
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+//memKV is an objectKV in memory
+type memKV struct {
+	sync.Mutex
+	kvs    map[string][]byte
+	ranges int                   //calls of Range
+	fail   func(key []byte) bool //puts of keys it returns true fail
+}
+
+func newTestLib() (*AutumnLib, *memKV) {
+	kv := &memKV{kvs: make(map[string][]byte)}
+	return &AutumnLib{kv: kv}, kv
+}
+
+func (kv *memKV) Get(ctx context.Context, key []byte) ([]byte, error) {
+	kv.Lock()
+	defer kv.Unlock()
+	value, ok := kv.kvs[string(key)]
+	if !ok {
+		return nil, wire_errors.NotFound
+	}
+	return value, nil
+}
+
+func (kv *memKV) put(ctx context.Context, key, value []byte, expiresAt uint64) error {
+	kv.Lock()
+	defer kv.Unlock()
+	if kv.fail != nil && kv.fail(key) {
+		return errors.New("put failed")
+	}
+	kv.kvs[string(key)] = append([]byte{}, value...)
+	return nil
+}
+
+func (kv *memKV) Delete(ctx context.Context, key []byte) error {
+	kv.Lock()
+	defer kv.Unlock()
+	delete(kv.kvs, string(key))
+	return nil
+}
+
+func (kv *memKV) keys(prefix []byte) [][]byte {
+	var out [][]byte
+	for k := range kv.kvs {
+		if bytes.HasPrefix([]byte(k), prefix) {
+			out = append(out, []byte(k))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return bytes.Compare(out[i], out[j]) < 0
+	})
+	return out
+}
+
+func (kv *memKV) Range(ctx context.Context, prefix []byte, start []byte, limit uint32) ([][]byte, error) {
+	kv.Lock()
+	defer kv.Unlock()
+	kv.ranges++
+	var out [][]byte
+	for _, k := range kv.keys(prefix) {
+		if bytes.Compare(k, start) >= 0 && uint32(len(out)) < limit {
+			out = append(out, k)
+		}
+	}
+	return out, nil
+}
+
+func (kv *memKV) DropPrefix(ctx context.Context, prefix []byte) error {
+	kv.Lock()
+	defer kv.Unlock()
+	for _, k := range kv.keys(prefix) {
+		delete(kv.kvs, string(k))
+	}
+	return nil
+}
+
+func TestParseChunkKey(t *testing.T) {
+	uploadID := newUploadID()
+	for _, key := range [][]byte{[]byte("a"), []byte(""), []byte("dir/obj\x00x")} {
+		for _, k := range [][]byte{chunkKey(key, uploadID, 0), chunkKey(key, uploadID, 12345), orphanedKey(key, uploadID)} {
+			objectKey, id, ok := ParseChunkKey(k)
+			require.True(t, ok, "%q", k)
+			require.Equal(t, key, objectKey)
+			require.Equal(t, uploadID, id)
+			require.True(t, IsChunkKey(k))
+		}
+	}
+
+	for _, k := range []string{
+		"",
+		"a",
+		"a/00000000",
+		string(ChunkPrefix([]byte("a"), uploadID)),
+		"a\x00" + uploadID + "/0000000x",
+		"a\x00" + uploadID + "/orphane0",
+		"a\x00" + uploadID + "x00000000",
+		"a\x01" + uploadID + "/00000000",
+		"a\x00" + uploadID[:23] + "z/00000000",
+	} {
+		_, _, ok := ParseChunkKey([]byte(k))
+		require.False(t, ok, "%q", k)
+	}
+	require.WithinDuration(t, time.Now(), uploadTime(uploadID), time.Minute)
+}
+
+func TestChunkPrefixOrder(t *testing.T) {
+	uploadID := newUploadID()
+	//chunks sort by index right after their object, the orphaned mark is the last
+	keys := [][]byte{[]byte("a")}
+	for _, i := range []uint32{0, 1, 9, 10, 100, 99999999} {
+		keys = append(keys, chunkKey([]byte("a"), uploadID, i))
+	}
+	keys = append(keys, orphanedKey([]byte("a"), uploadID), []byte("a\x01"), []byte("a/b"), []byte("a0"), []byte("b"))
+	for i := 1; i < len(keys); i++ {
+		require.True(t, bytes.Compare(keys[i-1], keys[i]) < 0, "%q %q", keys[i-1], keys[i])
+	}
+
+	//all keys of an upload have its prefix, PrefixEnd skips them
+	prefix := ChunkPrefix([]byte("a"), uploadID)
+	for _, k := range keys[1 : len(keys)-4] {
+		require.True(t, bytes.HasPrefix(k, prefix))
+		require.True(t, bytes.Compare(k, PrefixEnd(prefix)) < 0)
+	}
+	require.True(t, bytes.Compare(PrefixEnd(prefix), []byte("a\x01")) < 0)
+
+	//uploads of an object sort by the time they start
+	older := fmt.Sprintf("%016x%08x", time.Now().Add(-time.Hour).UnixNano(), rand.Uint32())
+	require.True(t, bytes.Compare(ChunkPrefix([]byte("a"), older), prefix) < 0)
+}
+
+func readObject(t *testing.T, lib *AutumnLib, key []byte) []byte {
+	r, n, err := lib.GetObject(context.Background(), key)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, n, uint64(len(data)))
+	return data
+}
+
+func TestPutObjectCommit(t *testing.T) {
+	lib, kv := newTestLib()
+	ctx := context.Background()
+	key := []byte("obj")
+
+	old := make([]byte, 2*ObjectChunkSize+10)
+	rand.Read(old)
+	require.NoError(t, lib.PutObject(ctx, key, bytes.NewReader(old), 0))
+	m, err := lib.getManifest(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), m.NumChunks)
+	require.Equal(t, uint64(len(old)), m.Length)
+	require.Equal(t, old, readObject(t, lib, key))
+
+	//a reader of the old object is not broken by a new one
+	r, _, err := lib.GetObject(ctx, key)
+	require.NoError(t, err)
+	head := make([]byte, 10)
+	_, err = io.ReadFull(r, head)
+	require.NoError(t, err)
+
+	data := make([]byte, ObjectChunkSize+1)
+	rand.Read(data)
+	require.NoError(t, lib.PutObject(ctx, key, bytes.NewReader(data), 0))
+	require.Equal(t, data, readObject(t, lib, key))
+	rest, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, old, append(head, rest...))
+	_, err = kv.Get(ctx, orphanedKey(key, m.UploadID))
+	require.NoError(t, err)
+
+	//the manifest is not written, readers see the current object and chunks of
+	//the failed upload are deleted
+	kv.fail = func(k []byte) bool { return bytes.Equal(k, key) }
+	before := len(kv.keys(key))
+	require.Error(t, lib.PutObject(ctx, key, bytes.NewReader(old), 0))
+	kv.fail = nil
+	require.Equal(t, data, readObject(t, lib, key))
+	require.Equal(t, before, len(kv.keys(key)))
+
+	//small values are not chunked, unless they look like a manifest
+	require.NoError(t, lib.PutObject(ctx, key, bytes.NewReader([]byte("small")), 0))
+	require.Equal(t, []byte("small"), kv.kvs[string(key)])
+	fake := append(append([]byte{}, manifestMagic...), "fake"...)
+	require.NoError(t, lib.PutObject(ctx, key, bytes.NewReader(fake), 0))
+	_, ok := decodeManifest(kv.kvs[string(key)])
+	require.True(t, ok)
+	require.Equal(t, fake, readObject(t, lib, key))
+	n, err := lib.HeadObject(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(fake)), n)
+
+	require.NoError(t, lib.DeleteObject(ctx, key))
+	_, _, err = lib.GetObject(ctx, key)
+	require.True(t, wire_errors.IsNotFound(err))
+}
+
+func TestCleanChunks(t *testing.T) {
+	defer func(size uint32) { cleanPageSize = size }(cleanPageSize)
+	cleanPageSize = 3
+
+	lib, kv := newTestLib()
+	ctx := context.Background()
+	past := func(d time.Duration) string {
+		return fmt.Sprintf("%016x%08x", time.Now().Add(-d).UnixNano(), rand.Uint32())
+	}
+	addUpload := func(key string, uploadID string, chunks uint32) {
+		for i := uint32(0); i < chunks; i++ {
+			kv.kvs[string(chunkKey([]byte(key), uploadID, i))] = []byte("chunk")
+		}
+	}
+
+	//an old upload which is still the object
+	current := past(48 * time.Hour)
+	addUpload("a", current, 5)
+	kv.kvs["a"] = encodeManifest(&pspb.ObjectManifest{UploadID: current, NumChunks: 5})
+	//uploads left by crashed clients, one of them is in progress
+	addUpload("a", past(47*time.Hour), 4)
+	addUpload("b", past(30*time.Hour), 7)
+	addUpload("c", past(time.Minute), 4)
+	//orphaned long ago
+	replaced := past(40 * time.Hour)
+	addUpload("d", replaced, 4)
+	kv.kvs[string(orphanedKey([]byte("d"), replaced))] = []byte(fmt.Sprintf("%016x", time.Now().Add(-30*time.Hour).UnixNano()))
+	//orphaned just now, a reader may still read it
+	deleted := past(40 * time.Hour)
+	addUpload("e", deleted, 4)
+	kv.kvs["e"] = encodeManifest(&pspb.ObjectManifest{UploadID: deleted, NumChunks: 4})
+	require.NoError(t, lib.DeleteObject(ctx, []byte("e")))
+	//chunks expired, only the mark is left
+	expired := past(40 * time.Hour)
+	kv.kvs[string(orphanedKey([]byte("f"), expired))] = []byte(fmt.Sprintf("%016x", time.Now().Add(-30*time.Hour).UnixNano()))
+	kv.kvs["g"] = []byte("small")
+
+	n, err := lib.CleanChunks(ctx, nil, 24*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+	//pages skip the rest of each upload
+	require.True(t, kv.ranges > 1)
+
+	var left []string
+	for _, k := range kv.keys(nil) {
+		if objectKey, _, ok := ParseChunkKey(k); ok {
+			left = append(left, string(objectKey))
+		} else {
+			left = append(left, "object "+string(k))
+		}
+	}
+	expected := []string{"object a", "a", "a", "a", "a", "a", "c", "c", "c", "c", "e", "e", "e", "e", "e", "object g"}
+	require.Equal(t, expected, left)
+	for _, k := range kv.keys([]byte("a\x00")) {
+		_, uploadID, _ := ParseChunkKey(k)
+		require.Equal(t, current, uploadID)
+	}
+
+	//CleanChunks only looks at prefix
+	kv.ranges = 0
+	n, err = lib.CleanChunks(ctx, []byte("e"), 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Empty(t, kv.keys([]byte("e")))
+	require.Equal(t, 16-5, len(kv.keys(nil)))
+}
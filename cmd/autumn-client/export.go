@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+
+	"github.com/journeymidnight/autumn/autumnlib"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/rangepartition/table"
+	"github.com/journeymidnight/autumn/rangepartition/y"
+	"github.com/journeymidnight/autumn/streamclient"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+)
+
+//an export is a directory of extent files written by streamclient.LocalStreamClient,
+//MANIFEST has the locations of tables in the order of keys
+const (
+	exportManifest  = "MANIFEST"
+	exportTableSize = 64 << 20
+)
+
+func export(c *cli.Context) error {
+	partID, err := strconv.ParseUint(c.Args().First(), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid partID: %v", err)
+	}
+	dir := c.Args().Get(1)
+	if len(dir) == 0 {
+		return errors.New("no export directory")
+	}
+	compression, ok := pspb.CompressionType_value[c.String("compression")]
+	if !ok {
+		return errors.Errorf("unknown compression %s", c.String("compression"))
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) > 0 {
+		return errors.Errorf("%s is not empty", dir)
+	}
+
+	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	client := autumnlib.NewAutumnLib(pmAddr)
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	stream, err := streamclient.NewLocalStreamClient(dir)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	//versions of a table are kept, the newest one is its seqNum
+	var manifest pspb.TableLocations
+	var b *table.Builder
+	var size int
+	var maxVersion uint64
+	finishTable := func() error {
+		if b == nil {
+			return nil
+		}
+		b.FinishBlock()
+		extentID, offset, err := b.FinishAll(0, 0, maxVersion)
+		if err != nil {
+			return err
+		}
+		manifest.Locs = append(manifest.Locs, &pspb.Location{ExtentID: extentID, Offset: offset})
+		b, size, maxVersion = nil, 0, 0
+		return nil
+	}
+
+	var n int
+	seq, err := client.Export(context.Background(), partID, func(e *pspb.ExportEntry) error {
+		if b == nil {
+			b = table.NewTableBuilder(stream, pspb.CompressionType(compression), 0)
+		}
+		vs := y.ValueStruct{Value: e.Value, ExpiresAt: e.ExpiresAt}
+		if e.Deleted {
+			vs.Meta = y.BitDelete
+		}
+		b.Add(y.KeyWithTs(e.Key, e.Version), vs)
+		n++
+		if e.Version > maxVersion {
+			maxVersion = e.Version
+		}
+		if size += len(e.Key) + len(e.Value); size >= exportTableSize {
+			return finishTable()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err = finishTable(); err != nil {
+		return err
+	}
+
+	//MANIFEST is written at last, so a failed export is never ingested
+	if err = ioutil.WriteFile(filepath.Join(dir, exportManifest), utils.MustMarshal(&manifest), 0644); err != nil {
+		return err
+	}
+	fmt.Printf("exported %d keys of partition %d at seq %d to %d tables in %s\n", n, partID, seq, len(manifest.Locs), dir)
+	return nil
+}
+
+func ingest(c *cli.Context) error {
+	dir := c.Args().First()
+	if len(dir) == 0 {
+		return errors.New("no export directory")
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, exportManifest))
+	if err != nil {
+		return err
+	}
+	var manifest pspb.TableLocations
+	if err = manifest.Unmarshal(data); err != nil {
+		return err
+	}
+
+	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	client := autumnlib.NewAutumnLib(pmAddr)
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	stream, err := streamclient.OpenLocalStreamClient(dir)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	//blocks of the tables are copied as they are, only their indexes are rewritten
+	ingester := client.NewIngester(context.Background())
+	var blocks int
+	for _, loc := range manifest.Locs {
+		tbl, err := table.OpenTable(stream, loc.ExtentID, loc.Offset)
+		if err != nil {
+			ingester.Abort()
+			return err
+		}
+		smallest, biggest := y.ParseKey(tbl.Smallest()), y.ParseKey(tbl.Biggest())
+		tbl.DecrRef()
+		index, _, err := table.ReadTableIndex(stream, loc.ExtentID, loc.Offset)
+		if err != nil {
+			ingester.Abort()
+			return err
+		}
+		//AddTable aborts the ingest if it fails
+		err = ingester.AddTable(smallest, biggest, index, func(offset *pspb.BlockOffset) (*pb.Block, error) {
+			data, _, err := stream.Read(context.Background(), offset.ExtentID, offset.Offset, 1)
+			if err != nil {
+				return nil, err
+			}
+			return data[0], nil
+		})
+		if err != nil {
+			return err
+		}
+		blocks += len(index.Offsets)
+	}
+	if err = ingester.Close(); err != nil {
+		return err
+	}
+	fmt.Printf("ingested %d tables of %d blocks from %s\n", len(manifest.Locs), blocks, dir)
+	return nil
+}
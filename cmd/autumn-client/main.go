@@ -2,23 +2,26 @@ package main
 
 import (
 	"context"
-	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/signal"
 	"path"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/journeymidnight/autumn/autumnlib"
 	"github.com/journeymidnight/autumn/manager/pmclient"
 	"github.com/journeymidnight/autumn/manager/smclient"
+	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/node"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/xlog"
@@ -48,7 +51,7 @@ func benchmark(pmAddrs []string, op BenchType, threadNum int, duration int, size
 	if err := pm.Connect(); err != nil {
 		return err
 	}
-	client := NewAutumnLib(pmAddrs)
+	client := autumnlib.NewAutumnLib(pmAddrs)
 	//defer client.Close()
 
 	if err := client.Connect(); err != nil {
@@ -200,6 +203,10 @@ func benchmark(pmAddrs []string, op BenchType, threadNum int, duration int, size
 func bootstrap(c *cli.Context) error {
 	smAddrs := utils.SplitAndTrim(c.String("smAddr"), ",")
 	pmAddrs := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	compression, ok := pspb.CompressionType_value[c.String("compression")]
+	if !ok {
+		return errors.Errorf("unknown compression %s", c.String("compression"))
+	}
 
 	smc := smclient.NewSMClient(smAddrs)
 	if err := smc.Connect(); err != nil {
@@ -216,7 +223,6 @@ func bootstrap(c *cli.Context) error {
 	if len(pss) == 0 {
 		return errors.New("no Partition Servers...")
 	}
-	//choose the first one
 
 	log, _, err := smc.CreateStream(context.Background(),3,0)
 	if err != nil {
@@ -227,17 +233,19 @@ func bootstrap(c *cli.Context) error {
 		return err
 	}
 
-	partID, err := pmc.Bootstrap(log.StreamID, row.StreamID, pss[0].PSID)
+	//PM chooses the least loaded PS
+	partID, psID, err := pmc.Bootstrap(log.StreamID, row.StreamID, 0, pspb.CompressionType(compression), uint32(c.Uint("prefix-bloom")),
+		uint32(c.Uint("max-versions")), c.Duration("retention"))
 	if err != nil {
 		return err
 	}
-	fmt.Printf("bootstrap succeed, created new range partition %d on %d\n", partID, pss[0].PSID)
+	fmt.Printf("bootstrap succeed, created new range partition %d on %d\n", partID, psID)
 	return nil
 }
 
 func del(c *cli.Context) error {
 	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
-	client := NewAutumnLib(pmAddr)
+	client := autumnlib.NewAutumnLib(pmAddr)
 	//defer client.Close()
 	if err := client.Connect(); err != nil {
 		return err
@@ -247,13 +255,151 @@ func del(c *cli.Context) error {
 		return errors.New("no key")
 	}
 
-	return client.Delete(context.Background(), []byte(key))
+	return client.DeleteObject(context.Background(), []byte(key))
+
+}
+
+func cleanchunks(c *cli.Context) error {
+	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	client := autumnlib.NewAutumnLib(pmAddr)
+	//defer client.Close()
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	n, err := client.CleanChunks(context.Background(), []byte(c.Args().First()), c.Duration("older-than"))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("deleted chunks of %d uploads\n", n)
+	return nil
+}
+
+func delrange(c *cli.Context) error {
+	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	client := autumnlib.NewAutumnLib(pmAddr)
+	//defer client.Close()
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	start := []byte(c.Args().Get(0))
+	if c.Bool("prefix") {
+		return client.DropPrefix(context.Background(), start)
+	}
+	return client.DeleteRange(context.Background(), start, []byte(c.Args().Get(1)))
+}
+
+func split(c *cli.Context) error {
+	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	client := autumnlib.NewAutumnLib(pmAddr)
+	//defer client.Close()
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	partID, err := strconv.ParseUint(c.Args().First(), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid partID: %v", err)
+	}
+	//splitKey is optional
+	newPartID, splitKey, err := client.SplitPart(context.Background(), partID, []byte(c.Args().Get(1)))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("split partition %d at [%s], new partition is %d\n", partID, splitKey, newPartID)
+	return nil
+}
+
+func merge(c *cli.Context) error {
+	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	client := autumnlib.NewAutumnLib(pmAddr)
+	//defer client.Close()
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	partID, err := strconv.ParseUint(c.Args().First(), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid partID: %v", err)
+	}
+	rightPartID, err := client.MergePart(context.Background(), partID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("merged partition %d into %d\n", rightPartID, partID)
+	return nil
+}
+
+func reassign(c *cli.Context) error {
+	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	client := autumnlib.NewAutumnLib(pmAddr)
+	//defer client.Close()
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	if c.NArg() != 2 {
+		return errors.New("usage: reassign <PARTID> <PSID>")
+	}
+	partID, err := strconv.ParseUint(c.Args().Get(0), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid partID: %v", err)
+	}
+	psID, err := strconv.ParseUint(c.Args().Get(1), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid PSID: %v", err)
+	}
+	if err = client.Reassign(context.Background(), partID, psID); err != nil {
+		return err
+	}
+	fmt.Printf("moved partition %d to PS %d\n", partID, psID)
+	return nil
+}
 
+func stats(c *cli.Context) error {
+	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	client := autumnlib.NewAutumnLib(pmAddr)
+	//defer client.Close()
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	partID, err := strconv.ParseUint(c.Args().First(), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid partID: %v", err)
+	}
+	res, err := client.PartStats(context.Background(), partID)
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func balance(c *cli.Context) error {
+	pmAddrs := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	pmc := pmclient.NewAutumnPMClient(pmAddrs)
+	if err := pmc.Connect(); err != nil {
+		return err
+	}
+	dryRun := c.Bool("dry-run")
+	moves, err := pmc.Balance(dryRun)
+	for _, move := range moves {
+		fmt.Printf("move partition %d from PS %d to PS %d, load %.2f\n", move.PartID, move.From, move.To, move.Load)
+	}
+	if err != nil {
+		return err
+	}
+	if len(moves) == 0 {
+		fmt.Println("partitions are balanced")
+	} else if dryRun {
+		fmt.Println("dry run, nothing is moved")
+	}
+	return nil
 }
 
 func get(c *cli.Context) error {
 	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
-	client := NewAutumnLib(pmAddr)
+	client := autumnlib.NewAutumnLib(pmAddr)
 	//defer client.Close()
 
 	if err := client.Connect(); err != nil {
@@ -264,22 +410,80 @@ func get(c *cli.Context) error {
 		return errors.New("no key")
 	}
 
-	value, err := client.Get(context.Background(), []byte(key))
+	reader, _, err := client.GetObject(context.Background(), []byte(key))
 	if err != nil {
 		return errors.Errorf(("get key:%s failed: reason:%s"), key, err)
 	}
-	//print the raw data to stdout, fmt.Println does not work
-	binary.Write(os.Stdout, binary.LittleEndian, value)
+	//print the raw data to stdout, chunks of big objects are read one by one
+	if _, err = io.Copy(os.Stdout, reader); err != nil {
+		return errors.Errorf(("get key:%s failed: reason:%s"), key, err)
+	}
+
+	return nil
+}
+
+func versions(c *cli.Context) error {
+	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	client := autumnlib.NewAutumnLib(pmAddr)
+	//defer client.Close()
+
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	key := c.Args().First()
+	if len(key) == 0 {
+		return errors.New("no key")
+	}
 
+	vers, truncated, err := client.GetVersions(context.Background(), []byte(key), c.Uint64("before"), uint32(c.Uint("limit")), false)
+	if err != nil {
+		return errors.Errorf(("get versions of key:%s failed: reason:%s"), key, err)
+	}
+	for _, v := range vers {
+		switch {
+		case v.Deleted:
+			fmt.Printf("%d deleted\n", v.Version)
+		case v.ExpiresAt > 0:
+			fmt.Printf("%d expires at %s\n", v.Version, time.Unix(int64(v.ExpiresAt), 0).Format(time.RFC3339))
+		default:
+			fmt.Printf("%d\n", v.Version)
+		}
+	}
+	if truncated {
+		fmt.Printf("more versions before %d\n", vers[len(vers)-1].Version)
+	}
 	return nil
 }
 
+func watch(c *cli.Context) error {
+	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
+	client := autumnlib.NewAutumnLib(pmAddr)
+	//defer client.Close()
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	prefix := c.Args().First()
+
+	//print events until interrupted
+	var lock sync.Mutex
+	return client.Watch(context.Background(), []byte(prefix), nil, func(partID uint64, ev *pspb.WatchEvent) error {
+		lock.Lock()
+		defer lock.Unlock()
+		if ev.Delete {
+			fmt.Printf("partition %d seq %d: DELETE %s\n", partID, ev.Seq, ev.Key)
+		} else {
+			fmt.Printf("partition %d seq %d: PUT %s, %d bytes\n", partID, ev.Seq, ev.Key, len(ev.Value))
+		}
+		return nil
+	})
+}
+
 func autumnRange(c *cli.Context) error {
 	pmAddr := utils.SplitAndTrim(c.String("pmAddr"), ",")
 	if len(pmAddr) == 0 {
 		return errors.Errorf("pmAddr is nil")
 	}
-	client := NewAutumnLib(pmAddr)
+	client := autumnlib.NewAutumnLib(pmAddr)
 	//defer client.Close()
 
 	if err := client.Connect(); err != nil {
@@ -291,7 +495,7 @@ func autumnRange(c *cli.Context) error {
 			return errors.New("no key")
 		}
 	*/
-	out, err := client.Range(context.Background(), []byte(prefix), []byte(prefix))
+	out, err := client.Range(context.Background(), []byte(prefix), []byte(prefix), uint32(c.Int("limit")))
 	if err != nil {
 		return err
 	}
@@ -307,7 +511,7 @@ func put(c *cli.Context) error {
 	if len(pmAddr) == 0 {
 		return errors.Errorf("pmAddr is nil")
 	}
-	client := NewAutumnLib(pmAddr)
+	client := autumnlib.NewAutumnLib(pmAddr)
 	//defer client.Close()
 
 	if err := client.Connect(); err != nil {
@@ -321,11 +525,12 @@ func put(c *cli.Context) error {
 	if len(fileName) == 0 {
 		return errors.New("no fileName")
 	}
-	value, err := ioutil.ReadFile(fileName)
+	f, err := os.Open(fileName)
 	if err != nil {
 		return errors.Errorf("read file %s: err: %s", fileName, err.Error())
 	}
-	if err := client.Put(context.Background(), []byte(key), value); err != nil {
+	defer f.Close()
+	if err := client.PutObject(context.Background(), []byte(key), f, c.Duration("ttl")); err != nil {
 		return errors.Errorf(("put key:%s failed: reason:%s"), key, err)
 	}
 	fmt.Println("success")
@@ -370,19 +575,24 @@ func main() {
 
 		{
 			Name:  "bootstrap",
-			Usage: "bootstrap --pmAddr <addrs> --smAddr <addrs>",
+			Usage: "bootstrap --pmAddr <addrs> --smAddr <addrs> --compression <none|snappy|zstd> --prefix-bloom <N> --max-versions <N> --retention <duration>",
 			Flags: []cli.Flag{
 				&cli.StringFlag{Name: "smAddr", Value: "127.0.0.1:3401"},
 				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+				&cli.StringFlag{Name: "compression", Value: "none", Usage: "compression of table blocks: none, snappy or zstd"},
+				&cli.UintFlag{Name: "prefix-bloom", Value: 0, Usage: "length of key prefixes in bloom filters of tables, 0 means none"},
+				&cli.UintFlag{Name: "max-versions", Value: 1, Usage: "versions of a key kept by compaction"},
+				&cli.DurationFlag{Name: "retention", Value: 0, Usage: "compaction also keeps versions newer than retention, e.g. 720h"},
 			},
 			Action: bootstrap,
 		},
 
 		{
 			Name:  "put",
-			Usage: "put --pmAddr <addrs> <KEY> <FILE>",
+			Usage: "put --pmAddr <addrs> --ttl <duration> <KEY> <FILE>",
 			Flags: []cli.Flag{
 				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+				&cli.DurationFlag{Name: "ttl", Value: 0, Usage: "expire the key after ttl, e.g. 30s, 0 means never"},
 			},
 			Action: put,
 		},
@@ -394,6 +604,16 @@ func main() {
 			},
 			Action: get,
 		},
+		{
+			Name:  "versions",
+			Usage: "versions --pmAddr <addrs> --before <VERSION> --limit <N> <KEY>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+				&cli.Uint64Flag{Name: "before", Value: 0, Usage: "list versions older than it, 0 means from the latest"},
+				&cli.UintFlag{Name: "limit", Value: 100},
+			},
+			Action: versions,
+		},
 		{
 			Name:  "del",
 			Usage: "del --pmAddr <addrs> <KEY>",
@@ -402,6 +622,65 @@ func main() {
 			},
 			Action: del,
 		},
+		{
+			Name:  "delrange",
+			Usage: "delrange --pmAddr <addrs> [--prefix] <START> [END]",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+				&cli.BoolFlag{Name: "prefix", Usage: "delete keys having START as prefix"},
+			},
+			Action: delrange,
+		},
+		{
+			Name:  "cleanchunks",
+			Usage: "cleanchunks --pmAddr <addrs> --older-than <duration> [PREFIX]",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+				&cli.DurationFlag{Name: "older-than", Value: 24 * time.Hour, Usage: "uploads started or replaced in this duration are not touched"},
+			},
+			Action: cleanchunks,
+		},
+		{
+			Name:  "split",
+			Usage: "split --pmAddr <addrs> <PARTID> [SPLITKEY]",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+			},
+			Action: split,
+		},
+		{
+			Name:  "merge",
+			Usage: "merge --pmAddr <addrs> <PARTID>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+			},
+			Action: merge,
+		},
+		{
+			Name:  "reassign",
+			Usage: "reassign --pmAddr <addrs> <PARTID> <PSID>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+			},
+			Action: reassign,
+		},
+		{
+			Name:  "stats",
+			Usage: "stats --pmAddr <addrs> <PARTID>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+			},
+			Action: stats,
+		},
+		{
+			Name:  "balance",
+			Usage: "balance --pmAddr <addrs> [--dry-run]",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+				&cli.BoolFlag{Name: "dry-run"},
+			},
+			Action: balance,
+		},
 		{
 			Name:  "wbench",
 			Usage: "wbench --pmAddr <addrs> --thread <num> --duration <duration>",
@@ -420,12 +699,36 @@ func main() {
 		},
 		{
 			Name:  "ls",
-			Usage: "ls --pmAddr <addrs> <prefix>",
+			Usage: "ls --pmAddr <addrs> --limit <num> <prefix>",
 			Flags: []cli.Flag{
 				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+				&cli.IntFlag{Name: "limit", Value: 0, Usage: "max number of keys, 0 means no limit"},
 			},
 			Action: autumnRange,
 		},
+		{
+			Name:   "watch",
+			Usage:  "watch --pmAddr <addrs> <prefix>",
+			Flags:  []cli.Flag{&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"}},
+			Action: watch,
+		},
+		{
+			Name:  "export",
+			Usage: "export --pmAddr <addrs> --compression <none|snappy|zstd> <PARTID> <DIR>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+				&cli.StringFlag{Name: "compression", Value: "snappy", Usage: "compression of table blocks: none, snappy or zstd"},
+			},
+			Action: export,
+		},
+		{
+			Name:  "ingest",
+			Usage: "ingest --pmAddr <addrs> <DIR>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "pmAddr", Value: "127.0.0.1:3401"},
+			},
+			Action: ingest,
+		},
 		{
 			Name: "format",
 			Usage: "format --walDir <dir> --listenUrl <addr> --smAddr <addrs> <dir list> ",
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/journeymidnight/autumn/autumnlib"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+)
+
+const defaultMaxKeys = 1000
+
+//keys listKeys reads at a time
+var listPageSize = 1000
+
+//objectStore is where the gateway keeps objects, AutumnLib itself except in tests
+type objectStore interface {
+	HeadObject(ctx context.Context, key []byte) (uint64, error)
+	GetObject(ctx context.Context, key []byte) (io.Reader, uint64, error)
+	PutObject(ctx context.Context, key []byte, r io.Reader, ttl time.Duration) error
+	DeleteObject(ctx context.Context, key []byte) error
+	Range(ctx context.Context, prefix []byte, start []byte, limit uint32) ([][]byte, error)
+}
+
+//Gateway serves objects over HTTP, the key of an object is the path without
+//the leading "/". GET / lists keys
+type Gateway struct {
+	lib           objectStore
+	maxObjectSize int64 //bodies bigger than it are rejected
+}
+
+func NewGateway(lib *autumnlib.AutumnLib, maxObjectSize int64) *Gateway {
+	return &Gateway{
+		lib:           lib,
+		maxObjectSize: maxObjectSize,
+	}
+}
+
+//ListResult is the response of GET /?prefix=&delimiter=&marker=&max-keys=
+type ListResult struct {
+	Prefix         string   `json:"prefix"`
+	Delimiter      string   `json:"delimiter,omitempty"`
+	Keys           []string `json:"keys"`
+	CommonPrefixes []string `json:"commonPrefixes,omitempty"`
+	Truncated      bool     `json:"truncated"`
+	NextMarker     string   `json:"nextMarker,omitempty"` //marker of the next request if Truncated
+}
+
+func (gw *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	if len(key) == 0 {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		gw.list(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		gw.get(w, r, key)
+	case http.MethodPut:
+		gw.put(w, r, key)
+	case http.MethodDelete:
+		gw.delete(w, r, key)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+//writeError maps errors of AutumnLib to http status
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	if wire_errors.IsNotFound(err) {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	xlog.Logger.Warnf("%s %s: %v", r.Method, r.URL.Path, err)
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+func (gw *Gateway) get(w http.ResponseWriter, r *http.Request, key string) {
+	if r.Method == http.MethodHead {
+		size, err := gw.lib.HeadObject(r.Context(), []byte(key))
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.FormatUint(size, 10))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	reader, size, err := gw.lib.GetObject(r.Context(), []byte(key))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatUint(size, 10))
+	w.WriteHeader(http.StatusOK)
+	//chunks are read while writing, the status has been sent if it fails
+	if _, err = io.Copy(w, reader); err != nil {
+		xlog.Logger.Warnf("%s %s: %v", r.Method, r.URL.Path, err)
+	}
+}
+
+//limitedReader fails if the body is bigger than max
+type limitedReader struct {
+	r   io.Reader
+	n   int64
+	max int64
+}
+
+var errTooLarge = errors.New("object too large")
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	if l.n > l.max {
+		return n, errTooLarge
+	}
+	return n, err
+}
+
+//put streams the body to chunks, it may be chunked so ContentLength is -1
+func (gw *Gateway) put(w http.ResponseWriter, r *http.Request, key string) {
+	if r.ContentLength > gw.maxObjectSize {
+		http.Error(w, errTooLarge.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	body := &limitedReader{r: r.Body, max: gw.maxObjectSize}
+	if err := gw.lib.PutObject(r.Context(), []byte(key), body, 0); err != nil {
+		if err == errTooLarge {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (gw *Gateway) delete(w http.ResponseWriter, r *http.Request, key string) {
+	if err := gw.lib.DeleteObject(r.Context(), []byte(key)); err != nil && !wire_errors.IsNotFound(err) {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (gw *Gateway) list(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	maxKeys := defaultMaxKeys
+	if s := q.Get("max-keys"); len(s) > 0 {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			http.Error(w, "invalid max-keys", http.StatusBadRequest)
+			return
+		}
+		maxKeys = n
+	}
+	res, err := gw.listKeys(r, q.Get("prefix"), q.Get("delimiter"), q.Get("marker"), maxKeys)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+//listKeys returns keys having prefix after marker. Keys which have delimiter after
+//prefix are rolled up to one common prefix, which counts as one key
+func (gw *Gateway) listKeys(r *http.Request, prefix, delimiter, marker string, maxKeys int) (*ListResult, error) {
+	res := &ListResult{Prefix: prefix, Delimiter: delimiter, Keys: []string{}}
+	var start []byte
+	if len(marker) > 0 {
+		//skip all keys of the common prefix
+		if len(delimiter) > 0 && strings.HasSuffix(marker, delimiter) && strings.HasPrefix(marker, prefix) {
+			start = autumnlib.PrefixEnd([]byte(marker))
+			if start == nil {
+				return res, nil
+			}
+		} else {
+			start = append([]byte(marker), 0)
+		}
+	}
+
+	count := 0
+	var last string //the last key or common prefix returned
+	for {
+		keys, err := gw.lib.Range(r.Context(), []byte(prefix), start, uint32(listPageSize))
+		if err != nil {
+			return nil, err
+		}
+		next := start
+		skipped := false
+		for _, k := range keys {
+			//chunks of big objects are skipped with the rest of their upload
+			if objectKey, uploadID, ok := autumnlib.ParseChunkKey(k); ok {
+				next = autumnlib.PrefixEnd(autumnlib.ChunkPrefix(objectKey, uploadID))
+				skipped = true
+				break
+			}
+			if count >= maxKeys {
+				res.Truncated = true
+				res.NextMarker = last
+				return res, nil
+			}
+			count++
+			key := string(k)
+			if len(delimiter) > 0 {
+				if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+					last = key[:len(prefix)+i+len(delimiter)]
+					res.CommonPrefixes = append(res.CommonPrefixes, last)
+					if next = autumnlib.PrefixEnd([]byte(last)); next == nil {
+						return res, nil
+					}
+					skipped = true
+					break
+				}
+			}
+			last = key
+			res.Keys = append(res.Keys, key)
+			next = append([]byte(key), 0)
+		}
+		//a short page is the last one, unless the scan skipped the rest of it
+		if !skipped && len(keys) < listPageSize {
+			return res, nil
+		}
+		start = next
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/journeymidnight/autumn/autumnlib"
+	"github.com/stretchr/testify/require"
+)
+
+//memStore is an objectStore in memory, only listing is supported
+type memStore struct {
+	objectStore
+	keys   []string //sorted
+	ranges int      //calls of Range
+}
+
+func (s *memStore) Range(ctx context.Context, prefix []byte, start []byte, limit uint32) ([][]byte, error) {
+	s.ranges++
+	var out [][]byte
+	for _, k := range s.keys {
+		if bytes.HasPrefix([]byte(k), prefix) && bytes.Compare([]byte(k), start) >= 0 && uint32(len(out)) < limit {
+			out = append(out, []byte(k))
+		}
+	}
+	return out, nil
+}
+
+//newListGateway returns a gateway whose store has objects in keys, objects in
+//chunked have n chunks each
+func newListGateway(keys []string, chunked map[string]int) (*Gateway, *memStore) {
+	s := &memStore{}
+	for _, k := range keys {
+		s.keys = append(s.keys, k)
+		for i := 0; i < chunked[k]; i++ {
+			uploadID := fmt.Sprintf("%016x%08x", 1, 2)
+			s.keys = append(s.keys, string(autumnlib.ChunkPrefix([]byte(k), uploadID))+fmt.Sprintf("%08d", i))
+		}
+	}
+	sort.Strings(s.keys)
+	return &Gateway{lib: s}, s
+}
+
+func listKeys(t *testing.T, gw *Gateway, prefix, delimiter, marker string, maxKeys int) *ListResult {
+	res, err := gw.listKeys(httptest.NewRequest(http.MethodGet, "/", nil), prefix, delimiter, marker, maxKeys)
+	require.NoError(t, err)
+	return res
+}
+
+var listTestKeys = []string{"a/1", "a/2", "a/3", "b", "c/x/1", "c/y", "d"}
+
+func TestListKeysSkipChunks(t *testing.T) {
+	defer func(n int) { listPageSize = n }(listPageSize)
+	listPageSize = 3
+	gw, s := newListGateway(listTestKeys, map[string]int{"b": 10, "d": 1})
+
+	res := listKeys(t, gw, "", "", "", 100)
+	require.Equal(t, listTestKeys, res.Keys)
+	require.Empty(t, res.CommonPrefixes)
+	require.False(t, res.Truncated)
+	//the first chunk of b skips the rest of them, pages of chunks are not read
+	require.Equal(t, 5, s.ranges)
+
+	res = listKeys(t, gw, "b", "", "", 100)
+	require.Equal(t, []string{"b"}, res.Keys)
+}
+
+func TestListKeysDelimiter(t *testing.T) {
+	defer func(n int) { listPageSize = n }(listPageSize)
+	listPageSize = 3
+	gw, _ := newListGateway(listTestKeys, map[string]int{"b": 10, "c/y": 2})
+
+	res := listKeys(t, gw, "", "/", "", 100)
+	require.Equal(t, []string{"b", "d"}, res.Keys)
+	require.Equal(t, []string{"a/", "c/"}, res.CommonPrefixes)
+	require.False(t, res.Truncated)
+
+	res = listKeys(t, gw, "c/", "/", "", 100)
+	require.Equal(t, []string{"c/y"}, res.Keys)
+	require.Equal(t, []string{"c/x/"}, res.CommonPrefixes)
+
+	//the delimiter right after the prefix
+	res = listKeys(t, gw, "a", "/", "", 100)
+	require.Empty(t, res.Keys)
+	require.Equal(t, []string{"a/"}, res.CommonPrefixes)
+}
+
+func TestListKeysMarker(t *testing.T) {
+	defer func(n int) { listPageSize = n }(listPageSize)
+	listPageSize = 3
+	gw, _ := newListGateway(listTestKeys, map[string]int{"b": 10, "d": 1})
+
+	res := listKeys(t, gw, "", "", "a/2", 100)
+	require.Equal(t, listTestKeys[2:], res.Keys)
+
+	//a common prefix as marker skips all its keys
+	res = listKeys(t, gw, "", "/", "a/", 100)
+	require.Equal(t, []string{"b", "d"}, res.Keys)
+	require.Equal(t, []string{"c/"}, res.CommonPrefixes)
+
+	//a marker which is not a common prefix skips only itself
+	res = listKeys(t, gw, "", "", "a/", 100)
+	require.Equal(t, listTestKeys, res.Keys)
+
+	res = listKeys(t, gw, "", "", "d", 100)
+	require.Empty(t, res.Keys)
+	require.False(t, res.Truncated)
+}
+
+func TestListKeysPaging(t *testing.T) {
+	defer func(n int) { listPageSize = n }(listPageSize)
+	listPageSize = 3
+	gw, _ := newListGateway(listTestKeys, map[string]int{"b": 10, "d": 1})
+
+	for _, delimiter := range []string{"", "/"} {
+		expected := listKeys(t, gw, "", delimiter, "", 100)
+		for maxKeys := 1; maxKeys <= 3; maxKeys++ {
+			var keys, prefixes []string
+			marker := ""
+			for {
+				res := listKeys(t, gw, "", delimiter, marker, maxKeys)
+				require.True(t, len(res.Keys)+len(res.CommonPrefixes) <= maxKeys)
+				keys = append(keys, res.Keys...)
+				prefixes = append(prefixes, res.CommonPrefixes...)
+				if !res.Truncated {
+					break
+				}
+				require.NotEmpty(t, res.NextMarker)
+				marker = res.NextMarker
+			}
+			require.Equal(t, expected.Keys, keys)
+			require.Equal(t, expected.CommonPrefixes, prefixes)
+		}
+	}
+
+	//the listing is served as json
+	w := httptest.NewRecorder()
+	gw.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?delimiter=/&max-keys=2", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	res := &ListResult{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), res))
+	require.Equal(t, []string{"b"}, res.Keys)
+	require.Equal(t, []string{"a/"}, res.CommonPrefixes)
+	require.True(t, res.Truncated)
+	require.Equal(t, "b", res.NextMarker)
+
+	w = httptest.NewRecorder()
+	gw.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?max-keys=0", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
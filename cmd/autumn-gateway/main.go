@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/journeymidnight/autumn/autumnlib"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+)
+
+func main() {
+
+	var listen string
+	var pmAddr string
+	var maxObjectSize uint
+
+	app := &cli.App{
+		HelpName: "",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "listen",
+				Usage:       "gateway http listen url",
+				Destination: &listen,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "pmAddr",
+				Destination: &pmAddr,
+				Required:    true,
+			},
+			&cli.UintFlag{
+				Name:        "max-object-size",
+				Usage:       "MB, bigger objects are rejected",
+				Value:       5120,
+				Destination: &maxObjectSize,
+			},
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		panic(err.Error())
+	}
+
+	pmAddrs := utils.SplitAndTrim(pmAddr, ",")
+	xlog.InitLog([]string{fmt.Sprintf("gateway.log")}, zap.DebugLevel)
+
+	lib := autumnlib.NewAutumnLib(pmAddrs)
+	utils.Check(lib.Connect())
+
+	server := &http.Server{
+		Addr:    listen,
+		Handler: NewGateway(lib, int64(maxObjectSize)<<20),
+	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			xlog.Logger.Fatal(err)
+		}
+	}()
+
+	xlog.Logger.Infof("gateway is ready!")
+
+	sc := make(chan os.Signal, 1)
+	signal.Notify(sc,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT, syscall.SIGUSR1)
+
+	<-sc
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	server.Shutdown(ctx)
+}
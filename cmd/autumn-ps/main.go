@@ -19,6 +19,8 @@ func main() {
 	var dir string
 	var smAddr string
 	var pmAddr string
+	var blobThreshold, blobDataShard, blobParityShard uint
+	var blockCacheSize uint
 
 	app := &cli.App{
 		HelpName: "",
@@ -45,6 +47,28 @@ func main() {
 				Destination: &pmAddr,
 				Required:    true,
 			},
+			&cli.UintFlag{
+				Name:        "blob-threshold",
+				Usage:       "values bigger than it are written to blob streams, 0 means never",
+				Destination: &blobThreshold,
+			},
+			&cli.UintFlag{
+				Name:        "blob-data-shard",
+				Usage:       "data shards of new blob streams",
+				Value:       3,
+				Destination: &blobDataShard,
+			},
+			&cli.UintFlag{
+				Name:        "blob-parity-shard",
+				Usage:       "parity shards of new blob streams, 0 means replication",
+				Destination: &blobParityShard,
+			},
+			&cli.UintFlag{
+				Name:        "block-cache-size",
+				Usage:       "MB of table blocks cached in memory, shared by all partitions, 0 means no cache",
+				Value:       256,
+				Destination: &blockCacheSize,
+			},
 		},
 	}
 
@@ -59,6 +83,10 @@ func main() {
 	//FIXME: sm address
 	//
 	ps := partitionserver.NewPartitionServer(smAddrs, pmAddrs, dir, "127.0.0.1:9951")
+	ps.BlobThreshold = uint32(blobThreshold)
+	ps.BlobDataShard = uint32(blobDataShard)
+	ps.BlobParityShard = uint32(blobParityShard)
+	ps.BlockCacheSize = int64(blockCacheSize) << 20
 
 	ps.Init()
 
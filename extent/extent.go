@@ -290,6 +290,12 @@ func (ex *Extent) Close() {
 	ex.file.Close()
 }
 
+//Remove closes the extent and deletes its file
+func (ex *Extent) Remove() error {
+	ex.Close()
+	return os.Remove(ex.fileName)
+}
+
 
 func (ex *Extent) resetWriter() error {
 	if ex.writer != nil {
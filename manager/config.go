@@ -22,6 +22,8 @@ type Config struct {
 	ClusterToken        string
 
 	GrpcUrl string // --listen-stream-manager-grpc
+
+	BalanceDryRun bool // --balance-dry-run
 	//GrpcUrlPM string
 }
 
@@ -136,6 +138,11 @@ func NewConfig() *Config {
 				Destination: &config.GrpcUrl,
 				Required:    true,
 			},
+			&cli.BoolFlag{
+				Name:        "balance-dry-run",
+				Usage:       "PM only logs the partition moves proposed by the balancer",
+				Destination: &config.BalanceDryRun,
+			},
 			/*
 				&cli.StringFlag{
 					Name:        "listen-grpc-pm",
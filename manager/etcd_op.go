@@ -31,9 +31,12 @@ func EtcdSetKVS(c *clientv3.Client, cmps []clientv3.Cmp, ops []clientv3.Op) erro
 	txn := clientv3.NewKV(c).Txn(ctx)
 	txn.If(cmps...)
 	res, err := txn.Then(ops...).Commit()
-	if res.Succeeded == false || err != nil {
+	if err != nil {
 		return errors.Wrap(err, "SetKVs failed")
 	}
+	if res.Succeeded == false {
+		return errors.New("SetKVs failed: compare failed")
+	}
 	return nil
 }
 
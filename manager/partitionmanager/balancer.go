@@ -0,0 +1,191 @@
+package partitionmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+)
+
+const (
+	balanceInterval = 5 * time.Minute
+	//a PS is over its target if its load is bigger than (1+balanceTolerance) * average
+	balanceTolerance = 0.2
+	maxMovesPerRound = 4
+)
+
+//loadScore weights a partition, 4KB written per second costs as much as one
+//request per second, and each 64MB of data as much as one request per second
+func loadScore(l *pspb.PartitionLoad) float64 {
+	if l == nil {
+		return 0
+	}
+	return float64(l.Qps) + float64(l.WriteBytes)/(4<<10) + float64(l.MemtableSize+l.TableSize)/(64<<20)
+}
+
+type psLoad struct {
+	psID  uint64
+	load  float64
+	parts map[uint64]float64 //partID => load
+}
+
+//loads returns loads of all alive PSes
+func (pm *PartitionManager) loads() map[uint64]*psLoad {
+	ret := make(map[uint64]*psLoad)
+	pm.pslock.RLock()
+	for psID := range pm.psNodes {
+		if pm.psAlive(psID) {
+			ret[psID] = &psLoad{psID: psID, parts: make(map[uint64]float64)}
+		}
+	}
+	pm.pslock.RUnlock()
+
+	pm.partLock.RLock()
+	pm.leaseLock.RLock()
+	for partID, meta := range pm.partMeta {
+		ps, ok := ret[meta.Parent]
+		if !ok {
+			continue
+		}
+		load := loadScore(pm.partLoad[partID])
+		ps.parts[partID] = load
+		ps.load += load
+	}
+	pm.leaseLock.RUnlock()
+	pm.partLock.RUnlock()
+	return ret
+}
+
+//leastLoadedPS returns the alive PS which has the least load, or the least
+//partitions if loads are the same
+func (pm *PartitionManager) leastLoadedPS() (uint64, error) {
+	var best *psLoad
+	for _, ps := range pm.loads() {
+		if best == nil || ps.load < best.load ||
+			(ps.load == best.load && len(ps.parts) < len(best.parts)) ||
+			(ps.load == best.load && len(ps.parts) == len(best.parts) && ps.psID < best.psID) {
+			best = ps
+		}
+	}
+	if best == nil {
+		return 0, errors.New("no PS is alive")
+	}
+	return best.psID, nil
+}
+
+//planMoves moves partitions from the most loaded PS to the least loaded PS until
+//every PS is under its target. A partition is moved only if the load of both PSes
+//will be less than the load of the source.
+func (pm *PartitionManager) planMoves() []*pspb.PartitionMove {
+	loads := pm.loads()
+	if len(loads) < 2 {
+		return nil
+	}
+	var total float64
+	for _, ps := range loads {
+		total += ps.load
+	}
+	target := total / float64(len(loads)) * (1 + balanceTolerance)
+
+	var moves []*pspb.PartitionMove
+	skip := make(map[uint64]bool) //PSes which can not be balanced any more
+	for len(moves) < maxMovesPerRound {
+		var hot, cold *psLoad
+		for _, ps := range loads {
+			if !skip[ps.psID] && (hot == nil || ps.load > hot.load) {
+				hot = ps
+			}
+			if cold == nil || ps.load < cold.load {
+				cold = ps
+			}
+		}
+		if hot == nil || hot.load <= target || hot == cold {
+			break
+		}
+
+		//the biggest partition which makes both PSes less loaded than hot
+		var partID uint64
+		var partLoad float64
+		for id, load := range hot.parts {
+			if load > 0 && load < hot.load-cold.load && (load > partLoad || (load == partLoad && id < partID)) {
+				partID, partLoad = id, load
+			}
+		}
+		if partID == 0 {
+			skip[hot.psID] = true
+			continue
+		}
+
+		moves = append(moves, &pspb.PartitionMove{
+			PartID: partID,
+			From:   hot.psID,
+			To:     cold.psID,
+			Load:   partLoad,
+		})
+		delete(hot.parts, partID)
+		hot.load -= partLoad
+		cold.parts[partID] = partLoad
+		cold.load += partLoad
+	}
+	return moves
+}
+
+//balance moves partitions in moves one by one, it returns the moves which succeed
+func (pm *PartitionManager) balance(moves []*pspb.PartitionMove) ([]*pspb.PartitionMove, error) {
+	var done []*pspb.PartitionMove
+	for _, move := range moves {
+		xlog.Logger.Infof("balance: move partition %d from PS %d to PS %d, load %.2f",
+			move.PartID, move.From, move.To, move.Load)
+		if err := pm.reassign(move.PartID, move.To, true); err != nil {
+			return done, err
+		}
+		done = append(done, move)
+	}
+	return done, nil
+}
+
+func (pm *PartitionManager) Balance(ctx context.Context, req *pspb.BalanceRequest) (*pspb.BalanceResponse, error) {
+	if !pm.AmLeader() {
+		code, desCode := wire_errors.ConvertToPBCode(wire_errors.NotLeader)
+		return &pspb.BalanceResponse{Code: code, CodeDes: desCode}, nil
+	}
+
+	moves := pm.planMoves()
+	if req.DryRun {
+		return &pspb.BalanceResponse{Code: pb.Code_OK, Moves: moves}, nil
+	}
+	done, err := pm.balance(moves)
+	code, desCode := wire_errors.ConvertToPBCode(err)
+	return &pspb.BalanceResponse{Code: code, CodeDes: desCode, Moves: done}, nil
+}
+
+func (pm *PartitionManager) balanceLoop(stopper *utils.Stopper) {
+	ticker := time.NewTicker(balanceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopper.ShouldStop():
+			return
+		case <-ticker.C:
+			if !pm.AmLeader() {
+				continue
+			}
+			moves := pm.planMoves()
+			if pm.config.BalanceDryRun {
+				for _, move := range moves {
+					xlog.Logger.Infof("balance dry run: move partition %d from PS %d to PS %d, load %.2f",
+						move.PartID, move.From, move.To, move.Load)
+				}
+				continue
+			}
+			if _, err := pm.balance(moves); err != nil {
+				xlog.Logger.Warnf("balance: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package partitionmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadScore(t *testing.T) {
+	require.Equal(t, float64(0), loadScore(nil))
+	require.Equal(t, float64(10), loadScore(&pspb.PartitionLoad{Qps: 10}))
+	require.Equal(t, float64(1), loadScore(&pspb.PartitionLoad{WriteBytes: 4 << 10}))
+	require.Equal(t, float64(1), loadScore(&pspb.PartitionLoad{MemtableSize: 32 << 20, TableSize: 32 << 20}))
+	require.Equal(t, float64(13), loadScore(&pspb.PartitionLoad{Qps: 10, WriteBytes: 8 << 10, TableSize: 64 << 20}))
+}
+
+func TestPlanMovesTolerance(t *testing.T) {
+	now := time.Now()
+
+	//one PS can not be balanced
+	pm := newLoadPM(now)
+	pm.addPS(1, now)
+	pm.addPart(1, 1, 100)
+	require.Empty(t, pm.planMoves())
+
+	//average is 10, PS 1 is at its target
+	pm = newLoadPM(now)
+	pm.addPS(1, now)
+	pm.addPS(2, now)
+	pm.addPart(1, 1, 4)
+	pm.addPart(2, 1, 8)
+	pm.addPart(3, 2, 8)
+	require.Empty(t, pm.planMoves())
+
+	//PS 1 is over its target, partition 2 is too big to make PS 2 less loaded than PS 1
+	pm.partLoad[2].Qps = 9
+	moves := pm.planMoves()
+	require.Equal(t, []*pspb.PartitionMove{{PartID: 1, From: 1, To: 2, Load: 4}}, moves)
+
+	//the only partition is as loaded as the PS
+	pm = newLoadPM(now)
+	pm.addPS(1, now)
+	pm.addPS(2, now)
+	pm.addPart(1, 1, 20)
+	require.Empty(t, pm.planMoves())
+
+	//partitions without load are not moved
+	pm.addPart(2, 1, 0)
+	require.Empty(t, pm.planMoves())
+}
+
+func TestPlanMovesPerRound(t *testing.T) {
+	now := time.Now()
+	pm := newLoadPM(now.Add(-time.Hour))
+	pm.addPS(1, now)
+	pm.addPS(2, now)
+	for partID := uint64(1); partID <= 20; partID++ {
+		pm.addPart(partID, 1, 1)
+	}
+	//a dead PS is not a target, its partitions are not counted
+	pm.addPS(3, now.Add(-psDeadTimeout))
+	pm.addPart(21, 3, 100)
+
+	moves := pm.planMoves()
+	require.Equal(t, maxMovesPerRound, len(moves))
+	for i, move := range moves {
+		//partitions of the same load are moved by id
+		require.Equal(t, &pspb.PartitionMove{PartID: uint64(i + 1), From: 1, To: 2, Load: 1}, move)
+	}
+
+	//the biggest partition which fits goes first
+	pm = newLoadPM(now)
+	pm.addPS(1, now)
+	pm.addPS(2, now)
+	pm.addPart(1, 1, 2)
+	pm.addPart(2, 1, 6)
+	pm.addPart(3, 1, 12)
+	pm.addPart(4, 2, 10)
+	moves = pm.planMoves()
+	require.Equal(t, []*pspb.PartitionMove{{PartID: 2, From: 1, To: 2, Load: 6}}, moves)
+}
@@ -0,0 +1,117 @@
+package partitionmanager
+
+import (
+	"sort"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+)
+
+//a PS which has not renewed its lease for psDeadTimeout is dead, its partitions
+//are moved to other PSes
+const psDeadTimeout = 2 * leaseTTL
+
+const failoverInterval = leaseTTL / 2
+
+//lastLease returns the last time PS psID renewed its lease, if it did not renew
+//since this PM became the leader, the leader's start time is returned
+func (pm *PartitionManager) lastLease(psID uint64) time.Time {
+	pm.leaseLock.RLock()
+	defer pm.leaseLock.RUnlock()
+	last, ok := pm.psLease[psID]
+	if !ok || last.Before(pm.leaderSince) {
+		return pm.leaderSince
+	}
+	return last
+}
+
+func (pm *PartitionManager) psAlive(psID uint64) bool {
+	return time.Since(pm.lastLease(psID)) < psDeadTimeout
+}
+
+//planFailover moves all partitions on dead PSes to alive PSes which have the
+//least partitions
+func (pm *PartitionManager) planFailover() []*pspb.PartitionMove {
+	pm.pslock.RLock()
+	var alive, dead []uint64
+	for psID := range pm.psNodes {
+		if pm.psAlive(psID) {
+			alive = append(alive, psID)
+		} else {
+			dead = append(dead, psID)
+		}
+	}
+	pm.pslock.RUnlock()
+	if len(dead) == 0 {
+		return nil
+	}
+
+	count := make(map[uint64]int)
+	for _, psID := range alive {
+		count[psID] = 0
+	}
+	isDead := make(map[uint64]bool)
+	for _, psID := range dead {
+		isDead[psID] = true
+	}
+	var moves []*pspb.PartitionMove
+	pm.partLock.RLock()
+	for partID, meta := range pm.partMeta {
+		if _, ok := count[meta.Parent]; ok {
+			count[meta.Parent]++
+		} else if isDead[meta.Parent] {
+			moves = append(moves, &pspb.PartitionMove{PartID: partID, From: meta.Parent})
+		}
+	}
+	pm.partLock.RUnlock()
+	if len(moves) == 0 {
+		return nil
+	}
+	if len(alive) == 0 {
+		xlog.Logger.Warnf("no PS is alive, %d partitions are not served", len(moves))
+		return nil
+	}
+
+	//PS of the smallest id wins a tie
+	sort.Slice(alive, func(i, j int) bool { return alive[i] < alive[j] })
+	sort.Slice(moves, func(i, j int) bool { return moves[i].PartID < moves[j].PartID })
+	for _, move := range moves {
+		target := alive[0]
+		for _, psID := range alive[1:] {
+			if count[psID] < count[target] {
+				target = psID
+			}
+		}
+		move.To = target
+		count[target]++
+	}
+	return moves
+}
+
+//failover reassigns partitions on dead PSes, the new owner replays the log
+//stream of each partition
+func (pm *PartitionManager) failover() {
+	for _, move := range pm.planFailover() {
+		xlog.Logger.Infof("failover: move partition %d from PS %d to PS %d", move.PartID, move.From, move.To)
+		if err := pm.reassign(move.PartID, move.To, false); err != nil {
+			xlog.Logger.Warnf("failover partition %d: %v", move.PartID, err)
+		}
+	}
+}
+
+func (pm *PartitionManager) failoverLoop(stopper *utils.Stopper) {
+	ticker := time.NewTicker(failoverInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopper.ShouldStop():
+			return
+		case <-ticker.C:
+			if pm.AmLeader() {
+				pm.failover()
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package partitionmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastLease(t *testing.T) {
+	now := time.Now()
+	pm := newLoadPM(now.Add(-time.Hour))
+	pm.addPS(1, now.Add(-time.Second))
+	pm.addPS(2, now.Add(-psDeadTimeout))
+	pm.addPS(3, time.Time{})
+
+	require.Equal(t, now.Add(-time.Second), pm.lastLease(1))
+	require.True(t, pm.psAlive(1))
+	require.Equal(t, now.Add(-psDeadTimeout), pm.lastLease(2))
+	require.False(t, pm.psAlive(2))
+	require.Equal(t, now.Add(-time.Hour), pm.lastLease(3))
+	require.False(t, pm.psAlive(3))
+
+	//a new leader gives every PS psDeadTimeout to renew, leases renewed with
+	//the old leader do not count
+	pm.leaderSince = now.Add(-time.Second)
+	for psID := uint64(2); psID <= 3; psID++ {
+		require.Equal(t, pm.leaderSince, pm.lastLease(psID))
+		require.True(t, pm.psAlive(psID))
+	}
+	pm.leaderSince = now.Add(-psDeadTimeout)
+	require.False(t, pm.psAlive(3))
+}
+
+func TestPlanFailover(t *testing.T) {
+	now := time.Now()
+	pm := newLoadPM(now.Add(-time.Hour))
+	pm.addPS(1, now)
+	pm.addPS(2, now)
+	pm.addPart(1, 1, 0)
+	pm.addPart(2, 1, 0)
+	require.Empty(t, pm.planFailover())
+
+	//partitions go to the PS which has the least partitions, the smaller id on a tie
+	pm.addPS(3, now.Add(-psDeadTimeout))
+	pm.addPart(5, 3, 0)
+	pm.addPart(3, 3, 0)
+	pm.addPart(4, 3, 0)
+	require.Equal(t, []*pspb.PartitionMove{
+		{PartID: 3, From: 3, To: 2},
+		{PartID: 4, From: 3, To: 2},
+		{PartID: 5, From: 3, To: 1},
+	}, pm.planFailover())
+
+	//the grace period of a new leader
+	pm.leaderSince = now
+	pm.psLease[3] = now.Add(-time.Hour)
+	require.Empty(t, pm.planFailover())
+}
+
+func TestPlanFailoverNoAlivePS(t *testing.T) {
+	now := time.Now()
+	pm := newLoadPM(now.Add(-time.Hour))
+	pm.addPS(1, now.Add(-psDeadTimeout))
+	pm.addPS(2, time.Time{})
+	pm.addPart(1, 1, 0)
+	pm.addPart(2, 2, 0)
+	require.Empty(t, pm.planFailover())
+
+	//dead PSes without partitions need no failover
+	pm = newLoadPM(now.Add(-time.Hour))
+	pm.addPS(1, now)
+	pm.addPS(2, time.Time{})
+	pm.addPart(1, 1, 0)
+	require.Empty(t, pm.planFailover())
+}
@@ -0,0 +1,46 @@
+package partitionmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/wire_errors"
+)
+
+//leaseTTL is how long a PS can serve its partitions without renewing. If a
+//partition is taken from a PS which can not be reached, PM waits leaseTTL
+//before the partition is opened by others
+const leaseTTL = 10 * time.Second
+
+//RenewLease returns all partitions on the PS with their psversions
+func (pm *PartitionManager) RenewLease(ctx context.Context, req *pspb.RenewLeaseRequest) (*pspb.RenewLeaseResponse, error) {
+	if !pm.AmLeader() {
+		code, desCode := wire_errors.ConvertToPBCode(wire_errors.NotLeader)
+		return &pspb.RenewLeaseResponse{Code: code, CodeDes: desCode}, nil
+	}
+
+	//PS counts the ttl from an earlier time
+	now := time.Now()
+	pm.leaseLock.Lock()
+	pm.psLease[req.PSID] = now
+	for _, load := range req.Loads {
+		pm.partLoad[load.PartID] = load
+	}
+	pm.leaseLock.Unlock()
+
+	pm.partLock.RLock()
+	defer pm.partLock.RUnlock()
+	var leases []*pspb.PartitionLease
+	for partID, meta := range pm.partMeta {
+		if meta.Parent == req.PSID {
+			leases = append(leases, &pspb.PartitionLease{PartID: partID, Psversion: meta.Psversion})
+		}
+	}
+	return &pspb.RenewLeaseResponse{
+		Code:   pb.Code_OK,
+		Leases: leases,
+		Ttl:    int64(leaseTTL / time.Millisecond),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package partitionmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/stretchr/testify/require"
+)
+
+//newLoadPM returns a PM without etcd which became the leader at leaderSince
+func newLoadPM(leaderSince time.Time) *PartitionManager {
+	return &PartitionManager{
+		psNodes:     make(map[uint64]*pspb.PSDetail),
+		partMeta:    make(map[uint64]*pspb.PartitionMeta),
+		psLease:     make(map[uint64]time.Time),
+		partLoad:    make(map[uint64]*pspb.PartitionLoad),
+		leaderSince: leaderSince,
+		isLeader:    1,
+	}
+}
+
+//addPS adds a PS which renewed its lease at lease, zero lease means never
+func (pm *PartitionManager) addPS(psID uint64, lease time.Time) {
+	pm.psNodes[psID] = &pspb.PSDetail{PSID: psID}
+	if !lease.IsZero() {
+		pm.psLease[psID] = lease
+	}
+}
+
+//addPart adds a partition on PS psID whose load is qps
+func (pm *PartitionManager) addPart(partID, psID, qps uint64) {
+	pm.partMeta[partID] = &pspb.PartitionMeta{PartID: partID, Parent: psID}
+	pm.partLoad[partID] = &pspb.PartitionLoad{PartID: partID, Qps: qps}
+}
+
+func TestRenewLease(t *testing.T) {
+	pm := newLoadPM(time.Now().Add(-time.Hour))
+	pm.addPS(1, time.Time{})
+	pm.addPS(2, time.Time{})
+	pm.addPart(1, 1, 0)
+	pm.addPart(2, 2, 0)
+	pm.partMeta[1].Psversion = 3
+	require.False(t, pm.psAlive(1))
+
+	res, err := pm.RenewLease(context.Background(), &pspb.RenewLeaseRequest{
+		PSID:  1,
+		Loads: []*pspb.PartitionLoad{{PartID: 1, Qps: 7}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, pb.Code_OK, res.Code)
+	require.Equal(t, []*pspb.PartitionLease{{PartID: 1, Psversion: 3}}, res.Leases)
+	require.Equal(t, int64(leaseTTL/time.Millisecond), res.Ttl)
+	require.True(t, pm.psAlive(1))
+	require.False(t, pm.psAlive(2))
+	require.Equal(t, uint64(7), pm.partLoad[1].Qps)
+
+	//only the leader grants leases
+	pm.isLeader = 0
+	res, err = pm.RenewLease(context.Background(), &pspb.RenewLeaseRequest{PSID: 2})
+	require.NoError(t, err)
+	require.NotEqual(t, pb.Code_OK, res.Code)
+	require.False(t, pm.psAlive(2))
+}
@@ -52,6 +52,11 @@ type PartitionManager struct {
 	partLock utils.SafeMutex
 	partMeta map[uint64]*pspb.PartitionMeta
 
+	leaseLock   utils.SafeMutex      //protect psLease, leaderSince, partLoad
+	psLease     map[uint64]time.Time //the last time each PS renewed its lease
+	leaderSince time.Time
+	partLoad    map[uint64]*pspb.PartitionLoad //reported by PS when renewing lease
+
 	allocIdLock utils.SafeMutex
 }
 
@@ -137,8 +142,25 @@ func parseParts(kvs []*mvccpb.KeyValue) map[uint64]*pspb.PartitionMeta {
 			ret[partID].Locs = &tables
 		case "discard":
 			ret[partID].Discard = kv.Value
+		case "merged":
+			var merged pspb.MergedStreams
+			if err = merged.Unmarshal(kv.Value); err != nil {
+				xlog.Logger.Errorf(err.Error())
+				continue
+			}
+			ret[partID].Merged = &merged
 		case "parent":
 			ret[partID].Parent = binary.BigEndian.Uint64(kv.Value)
+		case "compression":
+			ret[partID].Compression = pspb.CompressionType(binary.BigEndian.Uint64(kv.Value))
+		case "prefixBloomLen":
+			ret[partID].PrefixBloomLen = uint32(binary.BigEndian.Uint64(kv.Value))
+		case "maxVersions":
+			ret[partID].MaxVersions = uint32(binary.BigEndian.Uint64(kv.Value))
+		case "versionRetention":
+			ret[partID].VersionRetention = binary.BigEndian.Uint64(kv.Value)
+		case "psversion":
+			ret[partID].Psversion = binary.BigEndian.Uint64(kv.Value)
 		case "range":
 			var rg pspb.Range
 			if err = rg.Unmarshal(kv.Value); err != nil {
@@ -189,6 +211,13 @@ func (pm *PartitionManager) runAsLeader() {
 	}
 	pm.partMeta = parseParts(kvs)
 
+	//leases given by the last leader are unknown, they expire in leaseTTL
+	pm.leaseLock.Lock()
+	pm.psLease = make(map[uint64]time.Time)
+	pm.leaderSince = time.Now()
+	pm.partLoad = make(map[uint64]*pspb.PartitionLoad)
+	pm.leaseLock.Unlock()
+
 	atomic.StoreInt32(&pm.isLeader, 1)
 }
 
@@ -241,11 +270,19 @@ func (pm *PartitionManager) LeaderLoop() {
 		pm.leaderKey = e.Key()
 		xlog.Logger.Infof("elected %d as leader", pm.ID)
 		pm.runAsLeader()
+		stopper := utils.NewStopper()
+		stopper.RunWorker(func() {
+			pm.failoverLoop(stopper)
+		})
+		stopper.RunWorker(func() {
+			pm.balanceLoop(stopper)
+		})
 
 		select {
 		case <-s.Done():
 			s.Close()
 			atomic.StoreInt32(&pm.isLeader, 0)
+			stopper.Stop()
 			xlog.Logger.Info("%d's leadershipt expire", pm.ID)
 		}
 	}
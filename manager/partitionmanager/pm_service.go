@@ -1,6 +1,7 @@
 package partitionmanager
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"fmt"
@@ -81,6 +82,132 @@ func (pm *PartitionManager) SetRowStreamTables(ctx context.Context, req *pspb.Se
 
 }
 
+//SetDiscard saves discard stats of a partition, they are used by value log gc
+func (pm *PartitionManager) SetDiscard(ctx context.Context, req *pspb.SetDiscardRequest) (*pspb.SetDiscardResponse, error) {
+	errDone := func(err error) (*pspb.SetDiscardResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.SetDiscardResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !pm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+	if req.Discard == nil {
+		return errDone(errors.Errorf("invalid request"))
+	}
+
+	//discard stats of other partitions are changed if this one stops reading
+	//their logs, they must not be changed by others meanwhile
+	pm.partLock.Lock()
+	defer pm.partLock.Unlock()
+	meta, ok := pm.partMeta[req.PartID]
+	if !ok {
+		return errDone(errors.Errorf("no such partition %d", req.PartID))
+	}
+
+	//sharing of logs is decided by PM, PS can only stop reading logs of others
+	cur := decodeDiscard(meta.Discard)
+	stats := proto.Clone(req.Discard).(*pspb.DiscardStats)
+	stats.SharedExtent, stats.SharedBy, stats.SharedFrom = cur.SharedExtent, cur.SharedBy, nil
+	var released []uint64
+	for _, id := range cur.SharedFrom {
+		if containsID(req.Discard.SharedFrom, id) {
+			stats.SharedFrom = append(stats.SharedFrom, id)
+		} else {
+			released = append(released, id)
+		}
+	}
+	discards := map[uint64][]byte{req.PartID: utils.MustMarshal(stats)}
+	for _, id := range released {
+		shared, ok := pm.partMeta[id]
+		if !ok {
+			continue
+		}
+		//gc of the shared log is allowed after all partitions stop reading it
+		parentStats := decodeDiscard(shared.Discard)
+		parentStats.SharedBy = removeID(parentStats.SharedBy, req.PartID)
+		if len(parentStats.SharedBy) == 0 {
+			parentStats.SharedExtent = 0
+		}
+		discards[id] = utils.MustMarshal(parentStats)
+	}
+
+	var ops []clientv3.Op
+	for id, data := range discards {
+		ops = append(ops, clientv3.OpPut(fmt.Sprintf("PART/%d/discard", id), string(data)))
+	}
+	err := manager.EtcdSetKVS(pm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(pm.leaderKey), "=", pm.memberValue),
+	}, ops)
+	if err != nil {
+		return errDone(err)
+	}
+
+	for id, data := range discards {
+		pm.partMeta[id].Discard = data
+	}
+
+	return &pspb.SetDiscardResponse{
+		Code:    pb.Code_OK,
+		Discard: stats,
+	}, nil
+}
+
+func decodeDiscard(data []byte) *pspb.DiscardStats {
+	stats := &pspb.DiscardStats{}
+	if len(data) > 0 {
+		utils.Check(stats.Unmarshal(data))
+	}
+	return stats
+}
+
+func containsID(ids []uint64, id uint64) bool {
+	for _, x := range ids {
+		if x == id {
+			return true
+		}
+	}
+	return false
+}
+
+func removeID(ids []uint64, id uint64) []uint64 {
+	var ret []uint64
+	for _, x := range ids {
+		if x != id {
+			ret = append(ret, x)
+		}
+	}
+	return ret
+}
+
+//GetSharedTables returns tables of the other partitions, after split the new partition
+//reads tables in the row stream of the parent until they are compacted
+func (pm *PartitionManager) GetSharedTables(ctx context.Context, req *pspb.GetSharedTablesRequest) (*pspb.GetSharedTablesResponse, error) {
+	if !pm.AmLeader() {
+		code, desCode := wire_errors.ConvertToPBCode(wire_errors.NotLeader)
+		return &pspb.GetSharedTablesResponse{Code: code, CodeDes: desCode}, nil
+	}
+
+	pm.partLock.RLock()
+	defer pm.partLock.RUnlock()
+	var locs []*pspb.Location
+	for partID, meta := range pm.partMeta {
+		if partID == req.PartID || meta.Locs == nil {
+			continue
+		}
+		for _, loc := range meta.Locs.Locs {
+			locs = append(locs, proto.Clone(loc).(*pspb.Location))
+		}
+	}
+	return &pspb.GetSharedTablesResponse{
+		Code: pb.Code_OK,
+		Locs: locs,
+	}, nil
+}
+
 func (pm *PartitionManager) allocUniqID(count uint64) (uint64, uint64, error) {
 
 	pm.allocIdLock.Lock()
@@ -178,6 +305,14 @@ func (pm *PartitionManager) Bootstrap(ctx context.Context, req *pspb.BootstrapRe
 		return nil, errors.Errorf("not a leader")
 	}
 
+	parent := req.Parent
+	if parent == 0 {
+		var err error
+		if parent, err = pm.leastLoadedPS(); err != nil {
+			return nil, err
+		}
+	}
+
 	//alloc new partID
 	partID, _, err := pm.allocUniqID(2)
 	if err != nil {
@@ -185,35 +320,57 @@ func (pm *PartitionManager) Bootstrap(ctx context.Context, req *pspb.BootstrapRe
 	}
 
 	pm.partLock.Lock()
-	defer pm.partLock.Unlock()
 
 	//FIXME: check req
 
 	rg := &pspb.Range{StartKey: []byte(""), EndKey: []byte("")}
 	rangeValue, err := rg.Marshal()
 	if err != nil {
+		pm.partLock.Unlock()
 		return nil, err
 	}
 	ops := []clientv3.Op{
 		clientv3.OpPut(fmt.Sprintf("PART/%d/logStream", partID), uint64ToBig(req.LogID)),
 		clientv3.OpPut(fmt.Sprintf("PART/%d/rowStream", partID), uint64ToBig(req.RowID)),
-		clientv3.OpPut(fmt.Sprintf("PART/%d/parent", partID), uint64ToBig(req.Parent)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/parent", partID), uint64ToBig(parent)),
 		clientv3.OpPut(fmt.Sprintf("PART/%d/range", partID), string(rangeValue)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/compression", partID), uint64ToBig(uint64(req.Compression))),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/prefixBloomLen", partID), uint64ToBig(uint64(req.PrefixBloomLen))),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/maxVersions", partID), uint64ToBig(uint64(req.MaxVersions))),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/versionRetention", partID), uint64ToBig(req.VersionRetention)),
 	}
 
-	//FIXME: update PSVERSION
 	err = manager.EtcdSetKVS(pm.client, []clientv3.Cmp{
 		clientv3.Compare(clientv3.Value(pm.leaderKey), "=", pm.memberValue),
 	}, ops)
+	if err != nil {
+		pm.partLock.Unlock()
+		return nil, err
+	}
 
 	pm.partMeta[partID] = &pspb.PartitionMeta{
-		LogStream: req.LogID,
-		RowStream: req.RowID,
-		Parent:    req.Parent,
-		Rg:        rg,
-		PartID:    partID,
+		LogStream:        req.LogID,
+		RowStream:        req.RowID,
+		Parent:           parent,
+		Rg:               rg,
+		PartID:           partID,
+		Compression:      req.Compression,
+		PrefixBloomLen:   req.PrefixBloomLen,
+		MaxVersions:      req.MaxVersions,
+		VersionRetention: req.VersionRetention,
+	}
+	pm.partLock.Unlock()
+
+	//PS also opens it when it restarts
+	pm.pslock.RLock()
+	detail, ok := pm.psNodes[parent]
+	pm.pslock.RUnlock()
+	if ok {
+		if err = openPartOnPS(detail.Address, partID, 0); err != nil {
+			xlog.Logger.Warnf("open partition %d on PS %d: %v", partID, parent, err)
+		}
 	}
-	return &pspb.BootstrapResponse{PartID: partID}, nil
+	return &pspb.BootstrapResponse{PartID: partID, Parent: parent}, nil
 }
 
 func (pm *PartitionManager) GetRegions(ctx context.Context, req *pspb.GetRegionsRequest) (*pspb.GetRegionsResponse, error) {
@@ -239,8 +396,9 @@ func (pm *PartitionManager) GetRegions(ctx context.Context, req *pspb.GetRegions
 		utils.AssertTrue(meta.Rg != nil)
 		region := &pspb.RegionInfo{
 			Rg:     meta.Rg,
-			PartID: partID,
-			PSID:   meta.Parent,
+			PartID:    partID,
+			PSID:      meta.Parent,
+			Psversion: meta.Psversion,
 		}
 		pm.pslock.RLock()
 		detail, ok := pm.psNodes[meta.Parent]
@@ -262,3 +420,410 @@ func (pm *PartitionManager) GetRegions(ctx context.Context, req *pspb.GetRegions
 	}, nil
 
 }
+
+//SplitPartition is called by the PS which has closed the partition, the parent keeps
+//[startKey, splitKey) and a new partition owns [splitKey, endKey) on the same PS,
+//both of them open the parent's tables
+func (pm *PartitionManager) SplitPartition(ctx context.Context, req *pspb.SplitPartitionRequest) (*pspb.SplitPartitionResponse, error) {
+	errDone := func(err error) (*pspb.SplitPartitionResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.SplitPartitionResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !pm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	pm.partLock.RLock()
+	parent, ok := pm.partMeta[req.PartID]
+	if ok {
+		parent = proto.Clone(parent).(*pspb.PartitionMeta)
+	}
+	pm.partLock.RUnlock()
+	if !ok {
+		return errDone(errors.Errorf("no such partition %d", req.PartID))
+	}
+
+	//the new partition would read merged streams which the parent deletes
+	if parent.Merged != nil {
+		return errDone(errors.Errorf("partition %d still reads streams of merged partitions", req.PartID))
+	}
+	if bytes.Compare(req.SplitKey, parent.Rg.StartKey) <= 0 ||
+		(len(parent.Rg.EndKey) > 0 && bytes.Compare(req.SplitKey, parent.Rg.EndKey) >= 0) {
+		return errDone(errors.Errorf("split key is out of range"))
+	}
+
+	newPartID, _, err := pm.allocUniqID(1)
+	if err != nil {
+		return errDone(err)
+	}
+
+	oldRange, err := parent.Rg.Marshal()
+	utils.Check(err)
+	leftRg := &pspb.Range{StartKey: parent.Rg.StartKey, EndKey: req.SplitKey}
+	rightRg := &pspb.Range{StartKey: req.SplitKey, EndKey: parent.Rg.EndKey}
+	leftRange, err := leftRg.Marshal()
+	utils.Check(err)
+	rightRange, err := rightRg.Marshal()
+	utils.Check(err)
+
+	locs := req.Locs
+	if locs == nil {
+		locs = &pspb.TableLocations{}
+	}
+	tables, err := locs.Marshal()
+	utils.Check(err)
+
+	rangeKey := fmt.Sprintf("PART/%d/range", req.PartID)
+	ops := []clientv3.Op{
+		clientv3.OpPut(rangeKey, string(leftRange)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/tables", req.PartID), string(tables)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/logStream", newPartID), uint64ToBig(req.LogID)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/rowStream", newPartID), uint64ToBig(req.RowID)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/parent", newPartID), uint64ToBig(parent.Parent)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/range", newPartID), string(rightRange)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/tables", newPartID), string(tables)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/compression", newPartID), uint64ToBig(uint64(parent.Compression))),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/prefixBloomLen", newPartID), uint64ToBig(uint64(parent.PrefixBloomLen))),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/maxVersions", newPartID), uint64ToBig(uint64(parent.MaxVersions))),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/versionRetention", newPartID), uint64ToBig(parent.VersionRetention)),
+	}
+	//big values of the new partition may be in blob streams of the parent
+	var blobs *pspb.BlobStreams
+	if req.BlobID != 0 {
+		blobs = &pspb.BlobStreams{}
+		if parent.Blobs != nil {
+			blobs.Blob = append(blobs.Blob, parent.Blobs.Blob...)
+		}
+		blobs.Blob = append(blobs.Blob, req.BlobID)
+		data, err := blobs.Marshal()
+		utils.Check(err)
+		ops = append(ops, clientv3.OpPut(fmt.Sprintf("PART/%d/blobStreams", newPartID), string(data)))
+	}
+	//gc of the parent must not truncate extents read by the new partition, which
+	//also reads logs read by the parent. SetDiscard changes discard stats under
+	//partLock too
+	pm.partLock.Lock()
+	defer pm.partLock.Unlock()
+	discards := make(map[uint64][]byte)
+	if req.SharedExtent != 0 {
+		meta, ok := pm.partMeta[req.PartID]
+		if !ok {
+			return errDone(errors.Errorf("no such partition %d", req.PartID))
+		}
+		stats := decodeDiscard(meta.Discard)
+		if req.SharedExtent > stats.SharedExtent {
+			stats.SharedExtent = req.SharedExtent
+		}
+		stats.SharedBy = append(stats.SharedBy, newPartID)
+		discards[req.PartID] = utils.MustMarshal(stats)
+
+		child := &pspb.DiscardStats{SharedFrom: []uint64{req.PartID}}
+		for _, id := range stats.SharedFrom {
+			shared, ok := pm.partMeta[id]
+			if !ok {
+				continue
+			}
+			sharedStats := decodeDiscard(shared.Discard)
+			sharedStats.SharedBy = append(sharedStats.SharedBy, newPartID)
+			discards[id] = utils.MustMarshal(sharedStats)
+			child.SharedFrom = append(child.SharedFrom, id)
+		}
+		discards[newPartID] = utils.MustMarshal(child)
+	}
+	for id, data := range discards {
+		ops = append(ops, clientv3.OpPut(fmt.Sprintf("PART/%d/discard", id), string(data)))
+	}
+
+	//the range must not be changed by another split
+	err = manager.EtcdSetKVS(pm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(pm.leaderKey), "=", pm.memberValue),
+		clientv3.Compare(clientv3.Value(rangeKey), "=", string(oldRange)),
+	}, ops)
+	if err != nil {
+		return errDone(err)
+	}
+
+	if meta, ok := pm.partMeta[req.PartID]; ok {
+		meta.Rg = leftRg
+		meta.Locs = proto.Clone(locs).(*pspb.TableLocations)
+	}
+	pm.partMeta[newPartID] = &pspb.PartitionMeta{
+		LogStream:        req.LogID,
+		RowStream:        req.RowID,
+		Parent:           parent.Parent,
+		Rg:               rightRg,
+		Locs:             proto.Clone(locs).(*pspb.TableLocations),
+		PartID:           newPartID,
+		Blobs:            blobs,
+		Compression:      parent.Compression,
+		PrefixBloomLen:   parent.PrefixBloomLen,
+		MaxVersions:      parent.MaxVersions,
+		VersionRetention: parent.VersionRetention,
+	}
+	for id, data := range discards {
+		if meta, ok := pm.partMeta[id]; ok {
+			meta.Discard = data
+		}
+	}
+
+	return &pspb.SplitPartitionResponse{
+		Code:      pb.Code_OK,
+		NewPartID: newPartID,
+	}, nil
+}
+
+//MergePartition is called by the PS which has closed both partitions, the survivor
+//gets the whole range and tables of both partitions, it keeps its own streams.
+//Both logs have been flushed into tables when they were closed, streams of the
+//right partition are kept in merged until the survivor replays its log and
+//compacts its tables. The right partition must not share logs with partitions it
+//is split from or split from it
+//psversionCmp compares the psversion of partID in etcd, a partition which has
+//never been reassigned has no psversion key
+func psversionCmp(partID uint64, psversion uint64) clientv3.Cmp {
+	key := fmt.Sprintf("PART/%d/psversion", partID)
+	if psversion == 0 {
+		return clientv3.Compare(clientv3.CreateRevision(key), "=", 0)
+	}
+	return clientv3.Compare(clientv3.Value(key), "=", uint64ToBig(psversion))
+}
+
+func (pm *PartitionManager) MergePartition(ctx context.Context, req *pspb.MergePartitionRequest) (*pspb.MergePartitionResponse, error) {
+	errDone := func(err error) (*pspb.MergePartitionResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.MergePartitionResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !pm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	pm.partLock.RLock()
+	left, okLeft := pm.partMeta[req.PartID]
+	right, okRight := pm.partMeta[req.RightPartID]
+	if okLeft && okRight {
+		left = proto.Clone(left).(*pspb.PartitionMeta)
+		right = proto.Clone(right).(*pspb.PartitionMeta)
+	}
+	pm.partLock.RUnlock()
+	if !okLeft || !okRight {
+		return errDone(errors.Errorf("no such partition"))
+	}
+
+	if len(left.Rg.EndKey) == 0 || !bytes.Equal(left.Rg.EndKey, right.Rg.StartKey) {
+		return errDone(errors.Errorf("ranges of partition %d and %d are not contiguous", req.PartID, req.RightPartID))
+	}
+	if left.Parent != right.Parent {
+		return errDone(errors.Errorf("partition %d and %d are not on the same PS", req.PartID, req.RightPartID))
+	}
+	//the log of the right one is deleted, and the survivor must not read logs of
+	//others without being in their sharedBy
+	if stats := decodeDiscard(right.Discard); stats.SharedExtent != 0 {
+		return errDone(errors.Errorf("log stream of partition %d is shared with partitions split from it", req.RightPartID))
+	} else if len(stats.SharedFrom) > 0 {
+		return errDone(errors.Errorf("partition %d still reads log streams of partitions %v", req.RightPartID, stats.SharedFrom))
+	}
+
+	leftRange, err := left.Rg.Marshal()
+	utils.Check(err)
+	rightRange, err := right.Rg.Marshal()
+	utils.Check(err)
+	rg := &pspb.Range{StartKey: left.Rg.StartKey, EndKey: right.Rg.EndKey}
+	rangeValue, err := rg.Marshal()
+	utils.Check(err)
+
+	locs := req.Locs
+	if locs == nil {
+		locs = &pspb.TableLocations{}
+	}
+	tables, err := locs.Marshal()
+	utils.Check(err)
+
+	//big values of the right partition may be in its blob streams, the last one
+	//of the right is written by the survivor
+	blobs := &pspb.BlobStreams{}
+	seen := make(map[uint64]bool)
+	for _, meta := range []*pspb.PartitionMeta{left, right} {
+		if meta.Blobs == nil {
+			continue
+		}
+		for _, id := range meta.Blobs.Blob {
+			if !seen[id] {
+				seen[id] = true
+				blobs.Blob = append(blobs.Blob, id)
+			}
+		}
+	}
+
+	//streams merged into either of them are not deleted yet
+	merged := &pspb.MergedStreams{}
+	for _, meta := range []*pspb.PartitionMeta{left, right} {
+		if meta.Merged != nil {
+			merged.Logs = append(merged.Logs, meta.Merged.Logs...)
+			merged.Rows = append(merged.Rows, meta.Merged.Rows...)
+		}
+	}
+	merged.Logs = append(merged.Logs, right.LogStream)
+	merged.Rows = append(merged.Rows, right.RowStream)
+	mergedValue, err := merged.Marshal()
+	utils.Check(err)
+
+	leftRangeKey := fmt.Sprintf("PART/%d/range", req.PartID)
+	rightRangeKey := fmt.Sprintf("PART/%d/range", req.RightPartID)
+	ops := []clientv3.Op{
+		clientv3.OpPut(leftRangeKey, string(rangeValue)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/tables", req.PartID), string(tables)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/merged", req.PartID), string(mergedValue)),
+		clientv3.OpDelete(fmt.Sprintf("PART/%d/", req.RightPartID), clientv3.WithPrefix()),
+	}
+	if len(blobs.Blob) > 0 {
+		data, err := blobs.Marshal()
+		utils.Check(err)
+		ops = append(ops, clientv3.OpPut(fmt.Sprintf("PART/%d/blobStreams", req.PartID), string(data)))
+	}
+
+	//both ranges must not be changed by split or another merge, and neither
+	//partition is moved to another PS since they were checked
+	err = manager.EtcdSetKVS(pm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(pm.leaderKey), "=", pm.memberValue),
+		clientv3.Compare(clientv3.Value(leftRangeKey), "=", string(leftRange)),
+		clientv3.Compare(clientv3.Value(rightRangeKey), "=", string(rightRange)),
+		clientv3.Compare(clientv3.Value(fmt.Sprintf("PART/%d/parent", req.PartID)), "=", uint64ToBig(left.Parent)),
+		clientv3.Compare(clientv3.Value(fmt.Sprintf("PART/%d/parent", req.RightPartID)), "=", uint64ToBig(right.Parent)),
+		psversionCmp(req.PartID, left.Psversion),
+		psversionCmp(req.RightPartID, right.Psversion),
+	}, ops)
+	if err != nil {
+		return errDone(err)
+	}
+
+	//GetRegions never sees both or neither of them
+	pm.partLock.Lock()
+	delete(pm.partMeta, req.RightPartID)
+	if meta, ok := pm.partMeta[req.PartID]; ok {
+		meta.Rg = rg
+		meta.Locs = proto.Clone(locs).(*pspb.TableLocations)
+		meta.Merged = merged
+		if len(blobs.Blob) > 0 {
+			meta.Blobs = blobs
+		}
+	}
+	pm.partLock.Unlock()
+
+	return &pspb.MergePartitionResponse{
+		Code: pb.Code_OK,
+	}, nil
+}
+
+//SetMergedStreams is called by the survivor of merge when it does not read some
+//merged streams any more, they are deleted by PS after that
+func (pm *PartitionManager) SetMergedStreams(ctx context.Context, req *pspb.SetMergedStreamsRequest) (*pspb.SetMergedStreamsResponse, error) {
+	errDone := func(err error) (*pspb.SetMergedStreamsResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.SetMergedStreamsResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !pm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	pm.partLock.RLock()
+	_, ok := pm.partMeta[req.PartID]
+	pm.partLock.RUnlock()
+	if !ok {
+		return errDone(errors.Errorf("no such partition %d", req.PartID))
+	}
+
+	//the key is deleted when no merged stream is left
+	var merged *pspb.MergedStreams
+	mergedKey := fmt.Sprintf("PART/%d/merged", req.PartID)
+	op := clientv3.OpDelete(mergedKey)
+	if req.Merged != nil && len(req.Merged.Logs)+len(req.Merged.Rows) > 0 {
+		merged = proto.Clone(req.Merged).(*pspb.MergedStreams)
+		data, err := merged.Marshal()
+		utils.Check(err)
+		op = clientv3.OpPut(mergedKey, string(data))
+	}
+	err := manager.EtcdSetKVS(pm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(pm.leaderKey), "=", pm.memberValue),
+	}, []clientv3.Op{op})
+	if err != nil {
+		return errDone(err)
+	}
+
+	pm.partLock.Lock()
+	if meta, ok := pm.partMeta[req.PartID]; ok {
+		meta.Merged = merged
+	}
+	pm.partLock.Unlock()
+
+	return &pspb.SetMergedStreamsResponse{
+		Code: pb.Code_OK,
+	}, nil
+}
+
+//AddBlobStream is called by PS when it writes big values of a partition which has no blob stream
+func (pm *PartitionManager) AddBlobStream(ctx context.Context, req *pspb.AddBlobStreamRequest) (*pspb.AddBlobStreamResponse, error) {
+	errDone := func(err error) (*pspb.AddBlobStreamResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.AddBlobStreamResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !pm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	pm.partLock.RLock()
+	meta, ok := pm.partMeta[req.PartID]
+	var old *pspb.BlobStreams
+	if ok && meta.Blobs != nil {
+		old = proto.Clone(meta.Blobs).(*pspb.BlobStreams)
+	}
+	pm.partLock.RUnlock()
+	if !ok {
+		return errDone(errors.Errorf("no such partition %d", req.PartID))
+	}
+
+	blobs := &pspb.BlobStreams{}
+	blobsKey := fmt.Sprintf("PART/%d/blobStreams", req.PartID)
+	//blob streams must not be changed by split, merge or another AddBlobStream
+	cmps := []clientv3.Cmp{clientv3.Compare(clientv3.Value(pm.leaderKey), "=", pm.memberValue)}
+	if old == nil {
+		cmps = append(cmps, clientv3.Compare(clientv3.Version(blobsKey), "=", 0))
+	} else {
+		data, err := old.Marshal()
+		utils.Check(err)
+		cmps = append(cmps, clientv3.Compare(clientv3.Value(blobsKey), "=", string(data)))
+		blobs.Blob = append(blobs.Blob, old.Blob...)
+	}
+	blobs.Blob = append(blobs.Blob, req.StreamID)
+	data, err := blobs.Marshal()
+	utils.Check(err)
+
+	if err = manager.EtcdSetKVS(pm.client, cmps, []clientv3.Op{clientv3.OpPut(blobsKey, string(data))}); err != nil {
+		return errDone(err)
+	}
+
+	pm.partLock.Lock()
+	if meta, ok := pm.partMeta[req.PartID]; ok {
+		meta.Blobs = blobs
+	}
+	pm.partLock.Unlock()
+
+	return &pspb.AddBlobStreamResponse{
+		Code: pb.Code_OK,
+	}, nil
+}
@@ -0,0 +1,232 @@
+package partitionmanager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/journeymidnight/autumn/manager"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func init() {
+	xlog.InitLog([]string{"pm.log"}, zapcore.DebugLevel)
+}
+
+//newTestPM starts etcd and a PM which is the leader, PS 1 has partition 1 of
+//[, m) and partition 2 of [m, )
+func newTestPM(t *testing.T) (*PartitionManager, func()) {
+	config := &manager.Config{
+		Name:                "pm",
+		Dir:                 "pm.db",
+		ClientUrls:          "http://127.0.0.1:2479",
+		PeerUrls:            "http://127.0.0.1:2480",
+		AdvertiseClientUrls: "http://127.0.0.1:2479",
+		AdvertisePeerUrls:   "http://127.0.0.1:2480",
+		InitialCluster:      "pm=http://127.0.0.1:2480",
+		InitialClusterState: "new",
+		ClusterToken:        "cluster",
+		GrpcUrl:             "127.0.0.1:3100",
+	}
+	etcd, client, err := manager.ServeETCD(config)
+	require.NoError(t, err)
+	cleanup := func() {
+		client.Close()
+		etcd.Close()
+		os.RemoveAll("pm.db")
+	}
+
+	pm := NewPartitionManager(etcd, client, config)
+	//writes of PM compare leaderKey with its memberValue
+	pm.leaderKey = "AutumnPMLeader/test"
+	kvs := map[string]string{
+		pm.leaderKey: pm.memberValue,
+		idKey:        uint64ToBig(100), //ids allocated by PM do not collide with the ones below
+		"PSSERVER/1": string(utils.MustMarshal(&pspb.PSDetail{PSID: 1, Address: "127.0.0.1:9951"})),
+	}
+	for partID, rg := range map[uint64]*pspb.Range{1: {EndKey: []byte("m")}, 2: {StartKey: []byte("m")}} {
+		kvs[fmt.Sprintf("PART/%d/range", partID)] = string(utils.MustMarshal(rg))
+		kvs[fmt.Sprintf("PART/%d/logStream", partID)] = uint64ToBig(partID * 10)
+		kvs[fmt.Sprintf("PART/%d/rowStream", partID)] = uint64ToBig(partID*10 + 1)
+		kvs[fmt.Sprintf("PART/%d/parent", partID)] = uint64ToBig(1)
+	}
+	for k, v := range kvs {
+		_, err = client.Put(context.Background(), k, v)
+		require.NoError(t, err)
+	}
+	pm.runAsLeader()
+	require.True(t, pm.AmLeader())
+	return pm, cleanup
+}
+
+func loadParts(t *testing.T, pm *PartitionManager) map[uint64]*pspb.PartitionMeta {
+	kvs, err := manager.EtcdRange(pm.client, "PART")
+	require.NoError(t, err)
+	return parseParts(kvs)
+}
+
+func TestMergePartition(t *testing.T) {
+	pm, cleanup := newTestPM(t)
+	defer cleanup()
+
+	//regions always cover all keys once, before and after merge
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	var bad []string
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			res, _ := pm.GetRegions(context.Background(), &pspb.GetRegionsRequest{})
+			regions := res.Regions
+			sort.Slice(regions, func(i, j int) bool {
+				return bytes.Compare(regions[i].Rg.StartKey, regions[j].Rg.StartKey) < 0
+			})
+			var end []byte
+			for _, r := range regions {
+				if !bytes.Equal(r.Rg.StartKey, end) {
+					bad = append(bad, fmt.Sprintf("%v", regions))
+				}
+				end = r.Rg.EndKey
+			}
+			if len(regions) == 0 || len(end) > 0 {
+				bad = append(bad, fmt.Sprintf("%v", regions))
+			}
+		}
+	}()
+
+	locs := &pspb.TableLocations{Locs: []*pspb.Location{{ExtentID: 100, Offset: 1}, {ExtentID: 200, Offset: 2}}}
+	res, err := pm.MergePartition(context.Background(), &pspb.MergePartitionRequest{PartID: 1, RightPartID: 2, Locs: locs})
+	require.NoError(t, err)
+	require.Equal(t, pb.Code_OK, res.Code, res.CodeDes)
+	close(stop)
+	wg.Wait()
+	require.Empty(t, bad)
+
+	//the survivor keeps its streams, the right ones are merged streams
+	for _, parts := range []map[uint64]*pspb.PartitionMeta{pm.partMeta, loadParts(t, pm)} {
+		require.Equal(t, 1, len(parts))
+		meta := parts[1]
+		require.Empty(t, meta.Rg.StartKey)
+		require.Empty(t, meta.Rg.EndKey)
+		require.Equal(t, uint64(10), meta.LogStream)
+		require.Equal(t, uint64(11), meta.RowStream)
+		require.Equal(t, locs.Locs, meta.Locs.Locs)
+		require.Equal(t, []uint64{20}, meta.Merged.Logs)
+		require.Equal(t, []uint64{21}, meta.Merged.Rows)
+	}
+
+	//the partition can not be split until merged streams are dropped
+	split, err := pm.SplitPartition(context.Background(), &pspb.SplitPartitionRequest{PartID: 1, SplitKey: []byte("m")})
+	require.NoError(t, err)
+	require.NotEqual(t, pb.Code_OK, split.Code)
+
+	set, err := pm.SetMergedStreams(context.Background(), &pspb.SetMergedStreamsRequest{PartID: 1, Merged: &pspb.MergedStreams{}})
+	require.NoError(t, err)
+	require.Equal(t, pb.Code_OK, set.Code, set.CodeDes)
+	require.Nil(t, pm.partMeta[1].Merged)
+	require.Nil(t, loadParts(t, pm)[1].Merged)
+}
+
+func TestMergeSharedLog(t *testing.T) {
+	pm, cleanup := newTestPM(t)
+	defer cleanup()
+
+	//the partition split from the right one reads its log stream
+	split, err := pm.SplitPartition(context.Background(), &pspb.SplitPartitionRequest{
+		PartID: 2, SplitKey: []byte("t"), LogID: 30, RowID: 31, SharedExtent: 5})
+	require.NoError(t, err)
+	require.Equal(t, pb.Code_OK, split.Code, split.CodeDes)
+	child := split.NewPartID
+	for _, parts := range []map[uint64]*pspb.PartitionMeta{pm.partMeta, loadParts(t, pm)} {
+		stats := decodeDiscard(parts[2].Discard)
+		require.Equal(t, uint64(5), stats.SharedExtent)
+		require.Equal(t, []uint64{child}, stats.SharedBy)
+		require.Equal(t, []uint64{2}, decodeDiscard(parts[child].Discard).SharedFrom)
+	}
+
+	merge, err := pm.MergePartition(context.Background(), &pspb.MergePartitionRequest{PartID: 1, RightPartID: 2})
+	require.NoError(t, err)
+	require.NotEqual(t, pb.Code_OK, merge.Code)
+	merge, err = pm.MergePartition(context.Background(), &pspb.MergePartitionRequest{PartID: 2, RightPartID: child})
+	require.NoError(t, err)
+	require.NotEqual(t, pb.Code_OK, merge.Code)
+	require.Equal(t, 3, len(loadParts(t, pm)))
+
+	//sharing is decided by PM, PS can not clear it
+	res, err := pm.SetDiscard(context.Background(), &pspb.SetDiscardRequest{PartID: 2, Discard: &pspb.DiscardStats{TruncatedSeq: 7}})
+	require.NoError(t, err)
+	require.Equal(t, pb.Code_OK, res.Code, res.CodeDes)
+	require.Equal(t, uint64(5), res.Discard.SharedExtent)
+	require.Equal(t, uint64(7), res.Discard.TruncatedSeq)
+
+	//stats of the child which still reads the log do not change it
+	res, err = pm.SetDiscard(context.Background(), &pspb.SetDiscardRequest{PartID: child, Discard: &pspb.DiscardStats{SharedFrom: []uint64{2}}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, res.Discard.SharedFrom)
+	require.Equal(t, uint64(5), decodeDiscard(pm.partMeta[2].Discard).SharedExtent)
+
+	//the child stops reading the log after its major compaction
+	res, err = pm.SetDiscard(context.Background(), &pspb.SetDiscardRequest{PartID: child, Discard: &pspb.DiscardStats{}})
+	require.NoError(t, err)
+	require.Equal(t, pb.Code_OK, res.Code, res.CodeDes)
+	require.Empty(t, res.Discard.SharedFrom)
+	for _, parts := range []map[uint64]*pspb.PartitionMeta{pm.partMeta, loadParts(t, pm)} {
+		stats := decodeDiscard(parts[2].Discard)
+		require.Zero(t, stats.SharedExtent)
+		require.Empty(t, stats.SharedBy)
+		require.Equal(t, uint64(7), stats.TruncatedSeq)
+		require.Empty(t, decodeDiscard(parts[child].Discard).SharedFrom)
+	}
+
+	merge, err = pm.MergePartition(context.Background(), &pspb.MergePartitionRequest{PartID: 1, RightPartID: 2})
+	require.NoError(t, err)
+	require.Equal(t, pb.Code_OK, merge.Code, merge.CodeDes)
+	require.Equal(t, 2, len(loadParts(t, pm)))
+}
+
+func TestMergeMovedPartition(t *testing.T) {
+	pm, cleanup := newTestPM(t)
+	defer cleanup()
+
+	//partition 2 has been moved away and back, PM does not know it yet
+	_, err := pm.client.Put(context.Background(), "PART/2/psversion", uint64ToBig(2))
+	require.NoError(t, err)
+	res, err := pm.MergePartition(context.Background(), &pspb.MergePartitionRequest{PartID: 1, RightPartID: 2})
+	require.NoError(t, err)
+	require.NotEqual(t, pb.Code_OK, res.Code)
+
+	//partition 1 is on another PS
+	_, err = pm.client.Delete(context.Background(), "PART/2/psversion")
+	require.NoError(t, err)
+	_, err = pm.client.Put(context.Background(), "PART/1/parent", uint64ToBig(2))
+	require.NoError(t, err)
+	res, err = pm.MergePartition(context.Background(), &pspb.MergePartitionRequest{PartID: 1, RightPartID: 2})
+	require.NoError(t, err)
+	require.NotEqual(t, pb.Code_OK, res.Code)
+
+	for _, parts := range []map[uint64]*pspb.PartitionMeta{pm.partMeta, loadParts(t, pm)} {
+		require.Equal(t, 2, len(parts))
+		require.Equal(t, []byte("m"), parts[1].Rg.EndKey)
+	}
+
+	_, err = pm.client.Put(context.Background(), "PART/1/parent", uint64ToBig(1))
+	require.NoError(t, err)
+	res, err = pm.MergePartition(context.Background(), &pspb.MergePartitionRequest{PartID: 1, RightPartID: 2})
+	require.NoError(t, err)
+	require.Equal(t, pb.Code_OK, res.Code, res.CodeDes)
+}
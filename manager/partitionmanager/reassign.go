@@ -0,0 +1,149 @@
+package partitionmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/journeymidnight/autumn/manager"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+const psCallTimeout = 30 * time.Second
+
+//callPS dials a partition server for admin commands, these calls are rare, so
+//there is no connection pool
+func callPS(addr string, f func(client pspb.PartitionKVClient, ctx context.Context) (pb.Code, string, error)) error {
+	c, err := grpc.Dial(addr, grpc.WithBackoffMaxDelay(time.Second), grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), psCallTimeout)
+	defer cancel()
+	code, codeDes, err := f(pspb.NewPartitionKVClient(c), ctx)
+	if err != nil {
+		return err
+	}
+	return wire_errors.FromPBCode(code, codeDes)
+}
+
+func closePartOnPS(addr string, partID uint64, psversion uint64) error {
+	return callPS(addr, func(client pspb.PartitionKVClient, ctx context.Context) (pb.Code, string, error) {
+		res, err := client.ClosePart(ctx, &pspb.ClosePartRequest{Partid: partID, Psversion: psversion})
+		if err != nil {
+			return pb.Code_ERROR, "", err
+		}
+		return res.Code, res.CodeDes, nil
+	})
+}
+
+func openPartOnPS(addr string, partID uint64, psversion uint64) error {
+	return callPS(addr, func(client pspb.PartitionKVClient, ctx context.Context) (pb.Code, string, error) {
+		res, err := client.OpenPart(ctx, &pspb.OpenPartRequest{Partid: partID, Psversion: psversion})
+		if err != nil {
+			return pb.Code_ERROR, "", err
+		}
+		return res.Code, res.CodeDes, nil
+	})
+}
+
+//reassign moves partID to PS target and increases its psversion. If closeSource
+//is true, the source PS closes the partition gracefully before the parent is
+//changed, so its memtable is flushed into tables. If the source PS is dead,
+//closeSource should be false, the target replays the log stream after the lease
+//of the source expires. The target seals the last extents of the log and row
+//streams before replaying, so a stale source which still appends fails instead
+//of writing after the replay.
+func (pm *PartitionManager) reassign(partID uint64, target uint64, closeSource bool) error {
+	pm.partLock.RLock()
+	meta, ok := pm.partMeta[partID]
+	var source, psversion uint64
+	if ok {
+		source, psversion = meta.Parent, meta.Psversion
+	}
+	pm.partLock.RUnlock()
+	if !ok {
+		return errors.Errorf("no such partition %d", partID)
+	}
+	if source == target {
+		return errors.Errorf("partition %d is already on PS %d", partID, target)
+	}
+
+	pm.pslock.RLock()
+	sourceDetail := pm.psNodes[source]
+	targetDetail, ok := pm.psNodes[target]
+	pm.pslock.RUnlock()
+	if !ok {
+		return errors.Errorf("no such PS %d", target)
+	}
+
+	if closeSource {
+		if sourceDetail == nil {
+			return errors.Errorf("no address of PS %d", source)
+		}
+		if err := closePartOnPS(sourceDetail.Address, partID, psversion); err != nil {
+			return errors.Wrapf(err, "close partition %d on PS %d", partID, source)
+		}
+	}
+
+	parentKey := fmt.Sprintf("PART/%d/parent", partID)
+	ops := []clientv3.Op{
+		clientv3.OpPut(parentKey, uint64ToBig(target)),
+		clientv3.OpPut(fmt.Sprintf("PART/%d/psversion", partID), uint64ToBig(psversion+1)),
+	}
+	//partition must not be moved by others
+	err := manager.EtcdSetKVS(pm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(pm.leaderKey), "=", pm.memberValue),
+		clientv3.Compare(clientv3.Value(parentKey), "=", uint64ToBig(source)),
+	}, ops)
+	if err != nil {
+		//the source PS still owns it
+		if closeSource {
+			if err := openPartOnPS(sourceDetail.Address, partID, psversion); err != nil {
+				xlog.Logger.Errorf("reopen partition %d on PS %d: %v", partID, source, err)
+			}
+		}
+		return err
+	}
+
+	pm.partLock.Lock()
+	if meta, ok := pm.partMeta[partID]; ok {
+		meta.Parent = target
+		meta.Psversion = psversion + 1
+	}
+	pm.partLock.Unlock()
+
+	//the source does not get the partition in its next lease, but it may still
+	//serve it until the last lease expires
+	if !closeSource {
+		time.Sleep(time.Until(pm.lastLease(source).Add(leaseTTL)))
+	}
+
+	//the target also opens it when it restarts
+	if err = openPartOnPS(targetDetail.Address, partID, psversion+1); err != nil {
+		return errors.Wrapf(err, "partition %d is reassigned, but PS %d failed to open it", partID, target)
+	}
+	xlog.Logger.Infof("partition %d is moved from PS %d to PS %d", partID, source, target)
+	return nil
+}
+
+func (pm *PartitionManager) ReassignPartition(ctx context.Context, req *pspb.ReassignPartitionRequest) (*pspb.ReassignPartitionResponse, error) {
+	if !pm.AmLeader() {
+		code, desCode := wire_errors.ConvertToPBCode(wire_errors.NotLeader)
+		return &pspb.ReassignPartitionResponse{Code: code, CodeDes: desCode}, nil
+	}
+
+	if err := pm.reassign(req.PartID, req.PSID, true); err != nil {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.ReassignPartitionResponse{Code: code, CodeDes: desCode}, nil
+	}
+	return &pspb.ReassignPartitionResponse{Code: pb.Code_OK}, nil
+}
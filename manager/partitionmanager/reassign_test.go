@@ -0,0 +1,52 @@
+package partitionmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReassign(t *testing.T) {
+	pm, cleanup := newTestPM(t)
+	defer cleanup()
+
+	//no PS listens on these addresses
+	pm.pslock.Lock()
+	pm.psNodes[2] = &pspb.PSDetail{PSID: 2, Address: "127.0.0.1:9952"}
+	pm.pslock.Unlock()
+	//the lease of PS 1 has expired, reassign does not wait for it
+	pm.leaseLock.Lock()
+	pm.leaderSince = time.Now().Add(-time.Hour)
+	pm.leaseLock.Unlock()
+
+	require.Error(t, pm.reassign(1, 1, false))
+	require.Error(t, pm.reassign(1, 3, false))
+	require.Error(t, pm.reassign(3, 2, false))
+
+	//the source can not close it gracefully, nothing changes
+	require.Error(t, pm.reassign(1, 2, true))
+	for _, parts := range []map[uint64]*pspb.PartitionMeta{pm.partMeta, loadParts(t, pm)} {
+		require.Equal(t, uint64(1), parts[1].Parent)
+		require.Equal(t, uint64(0), parts[1].Psversion)
+	}
+
+	//the partition is moved even if the target fails to open it, the target
+	//opens it when it restarts
+	err := pm.reassign(1, 2, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "reassigned")
+	for _, parts := range []map[uint64]*pspb.PartitionMeta{pm.partMeta, loadParts(t, pm)} {
+		require.Equal(t, uint64(2), parts[1].Parent)
+		require.Equal(t, uint64(1), parts[1].Psversion)
+	}
+
+	//partition 2 has been moved by others, PM does not know it yet
+	_, err = pm.client.Put(context.Background(), "PART/2/parent", uint64ToBig(3))
+	require.NoError(t, err)
+	require.Error(t, pm.reassign(2, 2, false))
+	require.Equal(t, uint64(1), pm.partMeta[2].Parent)
+	require.Equal(t, uint64(3), loadParts(t, pm)[2].Parent)
+}
@@ -5,10 +5,27 @@ import (
 )
 
 type MockPMClient struct {
-	Tables []*pspb.Location
+	Tables  []*pspb.Location
+	Discard *pspb.DiscardStats
+	Shared  []*pspb.Location //tables of other partitions
+	Merged  *pspb.MergedStreams
 }
 
 func (c *MockPMClient) SetRowStreamTables(id uint64, tables []*pspb.Location) error {
 	c.Tables = tables
 	return nil
 }
+
+func (c *MockPMClient) SetDiscard(id uint64, discard *pspb.DiscardStats) (*pspb.DiscardStats, error) {
+	c.Discard = discard
+	return discard, nil
+}
+
+func (c *MockPMClient) GetSharedTables(id uint64) ([]*pspb.Location, error) {
+	return c.Shared, nil
+}
+
+func (c *MockPMClient) SetMergedStreams(id uint64, merged *pspb.MergedStreams) error {
+	c.Merged = merged
+	return nil
+}
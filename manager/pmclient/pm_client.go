@@ -8,6 +8,7 @@ import (
 	"github.com/journeymidnight/autumn/proto/pb"
 	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
 	"github.com/journeymidnight/autumn/xlog"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -16,6 +17,9 @@ import (
 //FIXME: delete PMCLient, add function to range_partition
 type PMClient interface {
 	SetRowStreamTables(uint64, []*pspb.Location) error
+	SetDiscard(uint64, *pspb.DiscardStats) (*pspb.DiscardStats, error)
+	GetSharedTables(uint64) ([]*pspb.Location, error)
+	SetMergedStreams(uint64, *pspb.MergedStreams) error
 }
 
 type AutumnPMClient struct {
@@ -104,6 +108,87 @@ func (client *AutumnPMClient) SetRowStreamTables(id uint64, tables []*pspb.Locat
 	return aerr
 }
 
+//SetDiscard saves discard stats of partition id
+//SetDiscard saves discard stats of partition id, it returns the stats saved in PM,
+//whose sharedExtent, sharedBy and sharedFrom are decided by PM
+func (client *AutumnPMClient) SetDiscard(id uint64, discard *pspb.DiscardStats) (*pspb.DiscardStats, error) {
+	acerr := errors.New("unknow err")
+	var saved *pspb.DiscardStats
+
+	req := &pspb.SetDiscardRequest{
+		PartID:  id,
+		Discard: discard,
+	}
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pspb.NewPartitionManagerServiceClient(conn)
+		res, err := c.SetDiscard(context.Background(), req)
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code == pb.Code_NotLEADER {
+			return true
+		}
+		acerr = wire_errors.FromPBCode(res.Code, res.CodeDes)
+		saved = res.Discard
+		return false
+
+	}, 10*time.Millisecond)
+
+	return saved, acerr
+}
+
+//SetMergedStreams saves streams of merged partitions which are still read by partition id
+func (client *AutumnPMClient) SetMergedStreams(id uint64, merged *pspb.MergedStreams) error {
+	acerr := errors.New("unknow err")
+
+	req := &pspb.SetMergedStreamsRequest{
+		PartID: id,
+		Merged: merged,
+	}
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pspb.NewPartitionManagerServiceClient(conn)
+		res, err := c.SetMergedStreams(context.Background(), req)
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code == pb.Code_NotLEADER {
+			return true
+		}
+		acerr = wire_errors.FromPBCode(res.Code, res.CodeDes)
+		return false
+
+	}, 10*time.Millisecond)
+
+	return acerr
+}
+
+func (client *AutumnPMClient) GetSharedTables(id uint64) ([]*pspb.Location, error) {
+	acerr := errors.New("unknow err")
+	var locs []*pspb.Location
+
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pspb.NewPartitionManagerServiceClient(conn)
+		res, err := c.GetSharedTables(context.Background(), &pspb.GetSharedTablesRequest{
+			PartID: id,
+		})
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code == pb.Code_NotLEADER {
+			return true
+		}
+		acerr = wire_errors.FromPBCode(res.Code, res.CodeDes)
+		locs = res.Locs
+		return false
+
+	}, 10*time.Millisecond)
+
+	return locs, acerr
+}
+
 func (client *AutumnPMClient) GetPartitionMeta(psid uint64) (ret []*pspb.PartitionMeta) {
 	client.try(func(conn *grpc.ClientConn) bool {
 		c := pspb.NewPartitionManagerServiceClient(conn)
@@ -126,14 +211,23 @@ func (client *AutumnPMClient) GetPartitionMeta(psid uint64) (ret []*pspb.Partiti
 	return ret
 }
 
-func (client *AutumnPMClient) Bootstrap(logID uint64, rowID uint64, psID uint64) (uint64, error) {
+//Bootstrap creates a partition on PS psID, if psID is 0, PM chooses the least loaded PS.
+//Tables of the partition are compressed by compression, and have prefix bloom filters
+//if prefixBloomLen is not 0. Compaction keeps the latest maxVersions versions of each key
+//and versions newer than retention. it returns the partID and the PS
+func (client *AutumnPMClient) Bootstrap(logID uint64, rowID uint64, psID uint64, compression pspb.CompressionType, prefixBloomLen uint32,
+	maxVersions uint32, retention time.Duration) (uint64, uint64, error) {
 	acerr := errors.New("unknow err")
-	var partID uint64
+	var partID, parent uint64
 
 	req := &pspb.BootstrapRequest{
-		LogID:  logID,
-		RowID:  rowID,
-		Parent: psID,
+		LogID:            logID,
+		RowID:            rowID,
+		Parent:           psID,
+		Compression:      compression,
+		PrefixBloomLen:   prefixBloomLen,
+		MaxVersions:      maxVersions,
+		VersionRetention: uint64(retention / time.Second),
 	}
 	client.try(func(conn *grpc.ClientConn) bool {
 		c := pspb.NewPartitionManagerServiceClient(conn)
@@ -143,12 +237,186 @@ func (client *AutumnPMClient) Bootstrap(logID uint64, rowID uint64, psID uint64)
 			return true
 		}
 		acerr = nil
-		partID = res.PartID
+		partID, parent = res.PartID, res.Parent
+		return false
+
+	}, 10*time.Millisecond)
+
+	return partID, parent, acerr
+}
+
+//SplitPartition returns the partID of [splitKey, endKey), blobID is the blob stream
+//of the new partition, 0 if the parent has no blob stream. The new partition reads
+//the log stream of the parent up to sharedExtent
+func (client *AutumnPMClient) SplitPartition(partID uint64, splitKey []byte, logID uint64, rowID uint64, blobID uint64, sharedExtent uint64, locs []*pspb.Location) (uint64, error) {
+	acerr := errors.New("unknow err")
+	var newPartID uint64
+
+	req := &pspb.SplitPartitionRequest{
+		PartID:       partID,
+		SplitKey:     splitKey,
+		LogID:        logID,
+		RowID:        rowID,
+		BlobID:       blobID,
+		SharedExtent: sharedExtent,
+		Locs:         &pspb.TableLocations{Locs: locs},
+	}
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pspb.NewPartitionManagerServiceClient(conn)
+		res, err := c.SplitPartition(context.Background(), req)
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code == pb.Code_NotLEADER {
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			acerr = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			return false
+		}
+		acerr = nil
+		newPartID = res.NewPartID
+		return false
+
+	}, 10*time.Millisecond)
+
+	return newPartID, acerr
+}
+
+func (client *AutumnPMClient) MergePartition(partID uint64, rightPartID uint64, locs []*pspb.Location) error {
+	acerr := errors.New("unknow err")
+
+	req := &pspb.MergePartitionRequest{
+		PartID:      partID,
+		RightPartID: rightPartID,
+		Locs:        &pspb.TableLocations{Locs: locs},
+	}
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pspb.NewPartitionManagerServiceClient(conn)
+		res, err := c.MergePartition(context.Background(), req)
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code == pb.Code_NotLEADER {
+			return true
+		}
+		acerr = wire_errors.FromPBCode(res.Code, res.CodeDes)
+		return false
+
+	}, 10*time.Millisecond)
+
+	return acerr
+}
+
+//ReassignPartition moves partID to PS psID
+func (client *AutumnPMClient) ReassignPartition(partID uint64, psID uint64) error {
+	acerr := errors.New("unknow err")
+
+	req := &pspb.ReassignPartitionRequest{
+		PartID: partID,
+		PSID:   psID,
+	}
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pspb.NewPartitionManagerServiceClient(conn)
+		res, err := c.ReassignPartition(context.Background(), req)
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code == pb.Code_NotLEADER {
+			return true
+		}
+		acerr = wire_errors.FromPBCode(res.Code, res.CodeDes)
+		return false
+
+	}, 10*time.Millisecond)
+
+	return acerr
+}
+
+//AddBlobStream appends streamID to blob streams of partID
+func (client *AutumnPMClient) AddBlobStream(partID uint64, streamID uint64) error {
+	acerr := errors.New("unknow err")
+
+	req := &pspb.AddBlobStreamRequest{
+		PartID:   partID,
+		StreamID: streamID,
+	}
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pspb.NewPartitionManagerServiceClient(conn)
+		res, err := c.AddBlobStream(context.Background(), req)
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code == pb.Code_NotLEADER {
+			return true
+		}
+		acerr = wire_errors.FromPBCode(res.Code, res.CodeDes)
+		return false
+
+	}, 10*time.Millisecond)
+
+	return acerr
+}
+
+//RenewLease reports loads of partitions on PS psid, it returns psversions of all partitions
+//on the PS, and how long the lease lasts
+func (client *AutumnPMClient) RenewLease(psid uint64, loads []*pspb.PartitionLoad) (map[uint64]uint64, time.Duration, error) {
+	var leases map[uint64]uint64
+	var ttl time.Duration
+	acerr := errors.New("unknow err")
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pspb.NewPartitionManagerServiceClient(conn)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		res, err := c.RenewLease(ctx, &pspb.RenewLeaseRequest{PSID: psid, Loads: loads})
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			acerr = err
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			acerr = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			return true
+		}
+		leases = make(map[uint64]uint64)
+		for _, lease := range res.Leases {
+			leases[lease.PartID] = lease.Psversion
+		}
+		ttl = time.Duration(res.Ttl) * time.Millisecond
+		acerr = nil
+		return false
+	}, 10*time.Millisecond)
+
+	return leases, ttl, acerr
+}
+
+//Balance moves partitions from overloaded PSes, if dryRun is true, it only returns
+//the proposed moves
+func (client *AutumnPMClient) Balance(dryRun bool) ([]*pspb.PartitionMove, error) {
+	acerr := errors.New("unknow err")
+	var moves []*pspb.PartitionMove
+
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pspb.NewPartitionManagerServiceClient(conn)
+		res, err := c.Balance(context.Background(), &pspb.BalanceRequest{DryRun: dryRun})
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code == pb.Code_NotLEADER {
+			return true
+		}
+		moves = res.Moves
+		acerr = wire_errors.FromPBCode(res.Code, res.CodeDes)
 		return false
 
 	}, 10*time.Millisecond)
 
-	return partID, acerr
+	return moves, acerr
 }
 
 func (client *AutumnPMClient) GetPSInfo() (ret []*pspb.PSDetail) {
@@ -377,6 +377,36 @@ func (client *SMClient) TruncateStream(ctx context.Context, streamID uint64, ext
 }
 	
 
+//DeleteStream deletes the stream and its extents
+func (client *SMClient) DeleteStream(ctx context.Context, streamID uint64) error {
+	err := errors.New("can not find connection to stream manager")
+	var res *pb.DeleteStreamResponse
+	client.try(func(conn *grpc.ClientConn) bool {
+		c := pb.NewStreamManagerServiceClient(conn)
+		res, err = c.DeleteStream(ctx, &pb.DeleteStreamRequest{
+			StreamID: streamID,
+		})
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return false
+		}
+		if err != nil {
+			xlog.Logger.Warnf(err.Error())
+			return true
+		}
+		if res.Code != pb.Code_OK {
+			err = wire_errors.FromPBCode(res.Code, res.CodeDes)
+			//if remote is not a leader, retry
+			if err == wire_errors.NotLeader {
+				return true
+			}
+			return false
+		}
+		return false
+	}, 500*time.Millisecond)
+
+	return err
+}
+
 func (client *SMClient) SubmitRecoveryTask(ctx context.Context, extentID uint64, replaceID uint64) error {
 	
 	err := errors.New("can not find connection to stream manager")
@@ -535,12 +535,16 @@ func (sm *StreamManager) Truncate(ctx context.Context, req *pb.TruncateRequest)
 	if !ok {
 		return errDone(errors.Errorf("stream do not have streaminfo"))
 	}
-	var i int
-	for i = range streamInfo.ExtentIDs {
-		if streamInfo.ExtentIDs[i] == req.ExtentID {
+	i := -1
+	for j := range streamInfo.ExtentIDs {
+		if streamInfo.ExtentIDs[j] == req.ExtentID {
+			i = j
 			break
 		}
 	}
+	if i < 0 {
+		return errDone(errors.Errorf("extent %d is not in stream %d", req.ExtentID, req.StreamID))
+	}
 
 	if i == 0 {
 		return &pb.TruncateResponse{
@@ -561,6 +565,14 @@ func (sm *StreamManager) Truncate(ctx context.Context, req *pb.TruncateRequest)
 	ops := []clientv3.Op{
 		clientv3.OpPut(streamKey, string(sdata)),
 	}
+	//truncated extents are removed
+	var removed []*pb.ExtentInfo
+	for _, extentID := range streamInfo.ExtentIDs[:i] {
+		ops = append(ops, clientv3.OpDelete(formatExtentKey(extentID)))
+		if extentInfo, ok := sm.cloneExtentInfo(extentID); ok {
+			removed = append(removed, extentInfo)
+		}
+	}
 	err = manager.EtcdSetKVS(sm.client, []clientv3.Cmp{
 		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
 	}, ops)
@@ -570,10 +582,87 @@ func (sm *StreamManager) Truncate(ctx context.Context, req *pb.TruncateRequest)
 	}
 
 	sm.streams.Set(req.StreamID, &newStreamInfo)
+	for _, extentInfo := range removed {
+		sm.extents.Del(extentInfo.ExtentID)
+	}
+	go sm.deleteExtents(removed)
 	return &pb.TruncateResponse{
 		Code: pb.Code_OK}, nil
 }
 
+//DeleteStream removes the stream and all its extents, it is called when no partition
+//reads the stream, e.g. streams created by a split which failed
+func (sm *StreamManager) DeleteStream(ctx context.Context, req *pb.DeleteStreamRequest) (*pb.DeleteStreamResponse, error) {
+	errDone := func(err error) (*pb.DeleteStreamResponse, error) {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.DeleteStreamResponse{
+			Code:    code,
+			CodeDes: desCode,
+		}, nil
+	}
+
+	if !sm.AmLeader() {
+		return errDone(wire_errors.NotLeader)
+	}
+
+	streamInfo, ok := sm.cloneStreamInfo(req.StreamID)
+	if !ok {
+		return errDone(errors.Errorf("no such stream %d", req.StreamID))
+	}
+
+	ops := []clientv3.Op{
+		clientv3.OpDelete(formatStreamKey(req.StreamID)),
+	}
+	var removed []*pb.ExtentInfo
+	for _, extentID := range streamInfo.ExtentIDs {
+		ops = append(ops, clientv3.OpDelete(formatExtentKey(extentID)))
+		if extentInfo, ok := sm.cloneExtentInfo(extentID); ok {
+			removed = append(removed, extentInfo)
+		}
+	}
+	err := manager.EtcdSetKVS(sm.client, []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(sm.leaderKey), "=", sm.memberValue),
+	}, ops)
+	if err != nil {
+		return errDone(err)
+	}
+
+	sm.streams.Del(req.StreamID)
+	for _, extentInfo := range removed {
+		sm.extents.Del(extentInfo.ExtentID)
+	}
+	go sm.deleteExtents(removed)
+	return &pb.DeleteStreamResponse{
+		Code: pb.Code_OK}, nil
+}
+
+//deleteExtents asks nodes to delete extents which have been removed from etcd,
+//files on nodes which are not reachable are left on disk
+func (sm *StreamManager) deleteExtents(extents []*pb.ExtentInfo) {
+	for _, extentInfo := range extents {
+		nodes := append(extentInfo.Replicates, extentInfo.Parity...)
+		for _, nodeID := range nodes {
+			ns := sm.getNodeStatus(nodeID)
+			if ns == nil {
+				continue
+			}
+			conn := ns.GetConn()
+			if conn == nil {
+				xlog.Logger.Warnf("can not delete extent %d on node %d", extentInfo.ExtentID, nodeID)
+				continue
+			}
+			c := pb.NewExtentServiceClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_, err := c.DeleteExtent(ctx, &pb.DeleteExtentRequest{
+				ExtentID: extentInfo.ExtentID,
+			})
+			cancel()
+			if err != nil {
+				xlog.Logger.Warnf("can not delete extent %d on node %d: %v", extentInfo.ExtentID, nodeID, err)
+			}
+		}
+	}
+}
 
 func (sm *StreamManager) cloneExtentInfo(extentID uint64) (*pb.ExtentInfo, bool) {
 	d, ok := sm.extents.Get(extentID)
@@ -467,6 +467,21 @@ func (en *ExtentNode) AllocExtent(ctx context.Context, req *pb.AllocExtentReques
 	}, nil
 }
 
+//DeleteExtent is called by SM after the extent is truncated from its stream
+func (en *ExtentNode) DeleteExtent(ctx context.Context, req *pb.DeleteExtentRequest) (*pb.DeleteExtentResponse, error) {
+	ex := en.getExtent(req.ExtentID)
+	if ex == nil {
+		return &pb.DeleteExtentResponse{Code: pb.Code_OK}, nil
+	}
+	en.removeExtent(req.ExtentID)
+	if err := ex.Remove(); err != nil {
+		xlog.Logger.Warnf("can not delete extent %d: %v", req.ExtentID, err)
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pb.DeleteExtentResponse{Code: code, CodeDes: desCode}, nil
+	}
+	return &pb.DeleteExtentResponse{Code: pb.Code_OK}, nil
+}
+
 func (en *ExtentNode) Seal(ctx context.Context, req *pb.SealRequest) (*pb.SealResponse, error) {
 	ex := en.getExtent(req.ExtentID)
 	if ex == nil {
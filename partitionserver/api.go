@@ -1,54 +1,143 @@
 package partitionserver
 
 import (
-	"bytes"
 	"context"
 	"errors"
-	"fmt"
+	"io"
 
+	"github.com/journeymidnight/autumn/proto/pb"
 	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/rangepartition"
+	"github.com/journeymidnight/autumn/wire_errors"
 )
 
-//FIXME: inc and decr
-func (ps *PartitionServer) checkVersion(verison uint64, partID uint64, key []byte) *rangepartition.RangePartition {
+//checkVersion returns the partition if it is served by this PS with the same
+//psversion, and the lease of this PS has not expired
+func (ps *PartitionServer) checkVersion(psversion uint64, partID uint64, key []byte) (*rangepartition.RangePartition, error) {
 	ps.RLock()
 	rp := ps.rangePartitions[partID]
+	version := ps.psversions[partID]
+	ps.RUnlock()
+	if rp == nil || !rp.InRange(key) {
+		return nil, wire_errors.Redirect
+	}
+	if psversion != version {
+		return nil, wire_errors.PSVersionMismatch
+	}
+	//PM may have given the partition to another PS
+	if !ps.leaseValid() {
+		return nil, wire_errors.Redirect
+	}
+	return rp, nil
+}
+
+//checkPartVersion fences admin RPCs of partID as checkVersion fences data RPCs,
+//it is called with adminLock held
+func (ps *PartitionServer) checkPartVersion(psversion uint64, partID uint64) error {
+	ps.RLock()
+	rp := ps.rangePartitions[partID]
+	version := ps.psversions[partID]
 	ps.RUnlock()
 	if rp == nil {
-		fmt.Println("no such rp")
-		return nil
+		return wire_errors.Redirect
+	}
+	if psversion != version {
+		return wire_errors.PSVersionMismatch
 	}
-	if bytes.Compare(rp.StartKey, key) <= 0 && (len(rp.EndKey) == 0 || bytes.Compare(key, rp.EndKey) < 0) {
-		return rp
+	if !ps.leaseValid() {
+		return wire_errors.Redirect
 	}
-	fmt.Println("compare?")
 	return nil
 }
 
+//ops of a Batch, puts and deletes of them must fit in a memtable too
+const maxBatchOps = 1000
+
+//Batch applies all puts and deletes atomically, gets in the same batch read
+//the snapshot which includes the whole batch. All ops must be in one partition
 func (ps *PartitionServer) Batch(ctx context.Context, req *pspb.BatchRequest) (*pspb.BatchResponse, error) {
-	return nil, errors.New("not implemented")
+	if len(req.Req) > maxBatchOps {
+		return nil, rangepartition.ErrBatchTooBig
+	}
+	var rp *rangepartition.RangePartition
+	var mutations []rangepartition.Mutation
+	for _, op := range req.Req {
+		var psversion, partID uint64
+		var key []byte
+		switch r := op.Request.(type) {
+		case *pspb.RequestOp_RequestPut:
+			psversion, partID, key = r.RequestPut.Psversion, r.RequestPut.Partid, r.RequestPut.Key
+			mutations = append(mutations, rangepartition.Mutation{Key: key, Value: r.RequestPut.Value, ExpiresAt: r.RequestPut.ExpiresAt})
+		case *pspb.RequestOp_RequestDelete:
+			psversion, partID, key = r.RequestDelete.Psversion, r.RequestDelete.Partid, r.RequestDelete.Key
+			mutations = append(mutations, rangepartition.Mutation{Key: key, Delete: true})
+		case *pspb.RequestOp_RequestGet:
+			psversion, partID, key = r.RequestGet.Psversion, r.RequestGet.Partid, r.RequestGet.Key
+		default:
+			return nil, errors.New("unknown op in batch")
+		}
+		opRP, err := ps.checkVersion(psversion, partID, key)
+		if err != nil {
+			return nil, err
+		}
+		if rp != nil && rp != opRP {
+			return nil, errors.New("batch across partitions")
+		}
+		rp = opRP
+	}
+	if rp == nil {
+		return &pspb.BatchResponse{}, nil
+	}
+
+	seq, err := rp.Batch(mutations)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pspb.ResponseOp, 0, len(req.Req))
+	for _, op := range req.Req {
+		switch r := op.Request.(type) {
+		case *pspb.RequestOp_RequestPut:
+			res = append(res, &pspb.ResponseOp{Response: &pspb.ResponseOp_ResponsePut{
+				ResponsePut: &pspb.PutResponse{Key: r.RequestPut.Key, Seq: seq},
+			}})
+		case *pspb.RequestOp_RequestDelete:
+			res = append(res, &pspb.ResponseOp{Response: &pspb.ResponseOp_ResponseDelete{
+				ResponseDelete: &pspb.DeleteResponse{Key: r.RequestDelete.Key, Seq: seq},
+			}})
+		case *pspb.RequestOp_RequestGet:
+			v, err := rp.Get(r.RequestGet.Key, seq)
+			if err != nil && err != rangepartition.ErrNotFound {
+				return nil, err
+			}
+			res = append(res, &pspb.ResponseOp{Response: &pspb.ResponseOp_ResponseGet{
+				ResponseGet: &pspb.GetResponse{Key: r.RequestGet.Key, Value: v, NotFound: err == rangepartition.ErrNotFound},
+			}})
+		}
+	}
+	return &pspb.BatchResponse{Res: res}, nil
 }
 
 func (ps *PartitionServer) Put(ctx context.Context, req *pspb.PutRequest) (*pspb.PutResponse, error) {
-	rp := ps.checkVersion(req.Psversion, req.Partid, req.Key)
-	if rp == nil {
-		return nil, errors.New("no such partid")
+	rp, err := ps.checkVersion(req.Psversion, req.Partid, req.Key)
+	if err != nil {
+		return nil, err
 	}
-	if err := rp.Write(req.Key, req.Value); err != nil {
+	seq, err := rp.Write(req.Key, req.Value, req.ExpiresAt)
+	if err != nil {
 		return nil, err
 	}
-	return &pspb.PutResponse{Key: req.Key}, nil
+	return &pspb.PutResponse{Key: req.Key, Seq: seq}, nil
 
 }
 
 func (ps *PartitionServer) Get(ctx context.Context, req *pspb.GetRequest) (*pspb.GetResponse, error) {
 
-	rp := ps.checkVersion(req.Psversion, req.Partid, req.Key)
-	if rp == nil {
-		return nil, errors.New("no such partid")
+	rp, err := ps.checkVersion(req.Psversion, req.Partid, req.Key)
+	if err != nil {
+		return nil, err
 	}
-	v, err := rp.Get(req.Key, 0)
+	v, err := rp.Get(req.Key, req.Version)
 	if err != nil {
 		return nil, err
 	}
@@ -59,30 +148,357 @@ func (ps *PartitionServer) Get(ctx context.Context, req *pspb.GetRequest) (*pspb
 
 }
 
+func (ps *PartitionServer) GetVersions(ctx context.Context, req *pspb.GetVersionsRequest) (*pspb.GetVersionsResponse, error) {
+	rp, err := ps.checkVersion(req.Psversion, req.Partid, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	versions, truncated, err := rp.GetVersions(req.Key, req.Before, req.Limit, req.Values)
+	if err != nil {
+		return nil, err
+	}
+	res := &pspb.GetVersionsResponse{Truncated: truncated}
+	for _, v := range versions {
+		res.Versions = append(res.Versions, &pspb.KeyVersion{
+			Version:   v.Version,
+			Value:     v.Value,
+			Deleted:   v.Deleted,
+			ExpiresAt: v.ExpiresAt,
+		})
+	}
+	return res, nil
+}
+
 func (ps *PartitionServer) Delete(ctx context.Context, req *pspb.DeleteRequest) (*pspb.DeleteResponse, error) {
-	rp := ps.checkVersion(req.Psversion, req.Partid, req.Key)
-	if rp == nil {
-		return nil, errors.New("no such partid")
+	rp, err := ps.checkVersion(req.Psversion, req.Partid, req.Key)
+	if err != nil {
+		return nil, err
 	}
 
-	err := rp.Delete(req.Key)
+	seq, err := rp.Delete(req.Key)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pspb.DeleteResponse{
 		Key: req.Key,
+		Seq: seq,
 	}, nil
 }
 
+//DeleteRange deletes keys in [start, end) of the partition, the client sends
+//one request to each partition overlapping the range
+func (ps *PartitionServer) DeleteRange(ctx context.Context, req *pspb.DeleteRangeRequest) (*pspb.DeleteRangeResponse, error) {
+	rp, err := ps.checkVersion(req.Psversion, req.Partid, req.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	seq, err := rp.DeleteRange(req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	return &pspb.DeleteRangeResponse{Seq: seq}, nil
+}
+
 func (ps *PartitionServer) Range(ctx context.Context, req *pspb.RangeRequest) (*pspb.RangeResponse, error) {
-	rp := ps.checkVersion(req.Psversion, req.Partid, req.Start)
-	if rp == nil {
-		return nil, errors.New("no such partid")
+	rp, err := ps.checkVersion(req.Psversion, req.Partid, req.Start)
+	if err != nil {
+		return nil, err
+	}
+	res, err := rp.Range(rangepartition.RangeOption{
+		Prefix:   req.Prefix,
+		Start:    req.Start,
+		End:      req.End,
+		Continue: req.Continuation,
+		Limit:    req.Limit,
+		Reverse:  req.Reverse,
+		Values:   req.Values,
+		Version:  req.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var truncated uint32
+	if res.Truncated {
+		truncated = 1
 	}
-	out := rp.Range(req.Prefix, req.Start, req.Limit)
 	return &pspb.RangeResponse{
-		Truncated: 0,
-		Keys:      out,
+		Truncated:    truncated,
+		Keys:         res.Keys,
+		Values:       res.Values,
+		Continuation: res.Continue,
+	}, nil
+}
+
+func toCondition(c *pspb.Condition) rangepartition.Condition {
+	if c == nil {
+		return rangepartition.Condition{Type: rangepartition.CondAlways}
+	}
+	var t rangepartition.CondType
+	switch c.Type {
+	case pspb.CondType_versionEqual:
+		t = rangepartition.CondVersionEqual
+	case pspb.CondType_valueEqual:
+		t = rangepartition.CondValueEqual
+	case pspb.CondType_absent:
+		t = rangepartition.CondAbsent
+	case pspb.CondType_present:
+		t = rangepartition.CondPresent
+	default:
+		t = rangepartition.CondAlways
+	}
+	return rangepartition.Condition{Type: t, Version: c.Version, Value: c.Value}
+}
+
+func (ps *PartitionServer) CondPut(ctx context.Context, req *pspb.CondPutRequest) (*pspb.CondPutResponse, error) {
+	if req.Put == nil {
+		return nil, errors.New("no put request")
+	}
+	rp, err := ps.checkVersion(req.Put.Psversion, req.Put.Partid, req.Put.Key)
+	if err != nil {
+		return nil, err
+	}
+	seq, err := rp.CondWrite(req.Put.Key, req.Put.Value, req.Put.ExpiresAt, toCondition(req.Cond))
+	if err != nil {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.CondPutResponse{Code: code, CodeDes: desCode}, nil
+	}
+	return &pspb.CondPutResponse{Code: pb.Code_OK, Seq: seq}, nil
+}
+
+func (ps *PartitionServer) CondDelete(ctx context.Context, req *pspb.CondDeleteRequest) (*pspb.CondDeleteResponse, error) {
+	if req.Delete == nil {
+		return nil, errors.New("no delete request")
+	}
+	rp, err := ps.checkVersion(req.Delete.Psversion, req.Delete.Partid, req.Delete.Key)
+	if err != nil {
+		return nil, err
+	}
+	seq, err := rp.CondDelete(req.Delete.Key, toCondition(req.Cond))
+	if err != nil {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.CondDeleteResponse{Code: code, CodeDes: desCode}, nil
+	}
+	return &pspb.CondDeleteResponse{Code: pb.Code_OK, Seq: seq}, nil
+}
+
+func (ps *PartitionServer) SplitPart(ctx context.Context, req *pspb.SplitPartRequest) (*pspb.SplitPartResponse, error) {
+	newPartID, splitKey, err := ps.splitRangePartition(req.Partid, req.Psversion, req.SplitKey)
+	if err != nil {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.SplitPartResponse{Code: code, CodeDes: desCode}, nil
+	}
+	return &pspb.SplitPartResponse{
+		Code:      pb.Code_OK,
+		NewPartID: newPartID,
+		SplitKey:  splitKey,
 	}, nil
 }
+
+func (ps *PartitionServer) MergePart(ctx context.Context, req *pspb.MergePartRequest) (*pspb.MergePartResponse, error) {
+	rightPartID, err := ps.mergeRangePartitions(req.Partid, req.Psversion)
+	if err != nil {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.MergePartResponse{Code: code, CodeDes: desCode}, nil
+	}
+	return &pspb.MergePartResponse{
+		Code:        pb.Code_OK,
+		RightPartID: rightPartID,
+	}, nil
+}
+
+func (ps *PartitionServer) PartStats(ctx context.Context, req *pspb.PartStatsRequest) (*pspb.PartStatsResponse, error) {
+	ps.RLock()
+	rp := ps.rangePartitions[req.Partid]
+	ps.RUnlock()
+	if rp == nil {
+		code, desCode := wire_errors.ConvertToPBCode(wire_errors.Redirect)
+		return &pspb.PartStatsResponse{Code: code, CodeDes: desCode}, nil
+	}
+
+	stats := rp.Stats()
+	cs := rp.CompactionStats()
+	return &pspb.PartStatsResponse{
+		Code:         pb.Code_OK,
+		Reads:        stats.Reads,
+		Writes:       stats.Writes,
+		WriteBytes:   stats.WriteBytes,
+		MemtableSize: stats.MemtableSize,
+		TableSize:    stats.TableSize,
+		Compaction: &pspb.CompactionStats{
+			NumTables:     uint32(cs.NumTables),
+			PendingTables: uint32(cs.PendingTables),
+			PendingBytes:  cs.PendingBytes,
+			Running:       cs.Running,
+			NumMinor:      cs.NumMinor,
+			NumMajor:      cs.NumMajor,
+			LastStart:     cs.LastStart,
+			LastEnd:       cs.LastEnd,
+		},
+		BlockCache: ps.blockCacheStats(),
+		BlockCompression: &pspb.CompressionStats{
+			Compression:     stats.Compression,
+			RawBytes:        stats.RawBlockBytes,
+			CompressedBytes: stats.StoredBlockBytes,
+		},
+	}, nil
+}
+
+func (ps *PartitionServer) blockCacheStats() *pspb.BlockCacheStats {
+	if ps.blockCache == nil {
+		return &pspb.BlockCacheStats{}
+	}
+	m := ps.blockCache.Metrics
+	return &pspb.BlockCacheStats{
+		MaxCost:     ps.BlockCacheSize,
+		Hits:        m.Hits(),
+		Misses:      m.Misses(),
+		KeysAdded:   m.KeysAdded(),
+		KeysEvicted: m.KeysEvicted(),
+		CostAdded:   m.CostAdded(),
+		CostEvicted: m.CostEvicted(),
+	}
+}
+
+func (ps *PartitionServer) OpenPart(ctx context.Context, req *pspb.OpenPartRequest) (*pspb.OpenPartResponse, error) {
+	if err := ps.openRangePartition(req.Partid, req.Psversion); err != nil {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.OpenPartResponse{Code: code, CodeDes: desCode}, nil
+	}
+	return &pspb.OpenPartResponse{Code: pb.Code_OK}, nil
+}
+
+func (ps *PartitionServer) ClosePart(ctx context.Context, req *pspb.ClosePartRequest) (*pspb.ClosePartResponse, error) {
+	if err := ps.closeRangePartition(req.Partid, req.Psversion); err != nil {
+		code, desCode := wire_errors.ConvertToPBCode(err)
+		return &pspb.ClosePartResponse{Code: code, CodeDes: desCode}, nil
+	}
+	return &pspb.ClosePartResponse{Code: pb.Code_OK}, nil
+}
+
+func (ps *PartitionServer) Watch(req *pspb.WatchRequest, stream pspb.PartitionKV_WatchServer) error {
+	ps.RLock()
+	rp := ps.rangePartitions[req.Partid]
+	ps.RUnlock()
+	if rp == nil {
+		return wire_errors.Redirect
+	}
+	//prefix may be bigger than the partition, only keys in the partition are watched
+	if _, err := ps.checkVersion(req.Psversion, req.Partid, rp.StartKey); err != nil {
+		return err
+	}
+
+	err := rp.Watch(req.Prefix, req.StartSeq, stream.Context().Done(), func(events []rangepartition.WatchEvent) error {
+		res := &pspb.WatchResponse{Events: make([]*pspb.WatchEvent, len(events))}
+		for i, ev := range events {
+			res.Events[i] = &pspb.WatchEvent{
+				Key:         ev.Key,
+				Value:       ev.Value,
+				Seq:         ev.Seq,
+				Delete:      ev.Delete,
+				ExpiresAt:   ev.ExpiresAt,
+				DeleteRange: ev.DeleteRange,
+				End:         ev.End,
+			}
+		}
+		return stream.Send(res)
+	})
+	//the partition is closed or moved, client resumes from other PS
+	if err == rangepartition.ErrWatchStopped {
+		return wire_errors.Redirect
+	}
+	if err == rangepartition.ErrWatchCompacted {
+		return wire_errors.WatchCompacted
+	}
+	return err
+}
+
+//entries of an ExportResponse are at most about exportBatchSize bytes
+const exportBatchSize = 4 << 20
+
+func (ps *PartitionServer) Export(req *pspb.ExportRequest, stream pspb.PartitionKV_ExportServer) error {
+	ps.RLock()
+	rp := ps.rangePartitions[req.Partid]
+	ps.RUnlock()
+	if rp == nil {
+		return wire_errors.Redirect
+	}
+	if _, err := ps.checkVersion(req.Psversion, req.Partid, rp.StartKey); err != nil {
+		return err
+	}
+
+	res := &pspb.ExportResponse{}
+	size := 0
+	seq, err := rp.Export(func(e rangepartition.ExportEntry) error {
+		res.Entries = append(res.Entries, &pspb.ExportEntry{
+			Key:       e.Key,
+			Value:     e.Value,
+			Version:   e.Version,
+			Deleted:   e.Deleted,
+			ExpiresAt: e.ExpiresAt,
+		})
+		size += len(e.Key) + len(e.Value)
+		if size < exportBatchSize {
+			return nil
+		}
+		err := stream.Send(res)
+		res = &pspb.ExportResponse{}
+		size = 0
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	//the last response has the seqNumber, it may have no entries
+	res.Seq = seq
+	return stream.Send(res)
+}
+
+//Ingest copies tables of all requests into the row stream of the partition and
+//attaches them after the client closes the stream, nothing is ingested if the stream fails
+func (ps *PartitionServer) Ingest(stream pspb.PartitionKV_IngestServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	ps.RLock()
+	rp := ps.rangePartitions[req.Partid]
+	ps.RUnlock()
+	if rp == nil {
+		return wire_errors.Redirect
+	}
+	if _, err = ps.checkVersion(req.Psversion, req.Partid, rp.StartKey); err != nil {
+		return err
+	}
+
+	ing, err := rp.NewIngest()
+	if err != nil {
+		return err
+	}
+	for {
+		if err = ing.AddBlocks(req.Blocks); err == nil && req.Index != nil {
+			err = ing.AddTable(req.Index)
+		}
+		if err != nil {
+			ing.Abort()
+			return err
+		}
+		if req, err = stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			ing.Abort()
+			return err
+		}
+	}
+
+	seq, tables, blocks, err := ing.Finish()
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&pspb.IngestResponse{
+		Seq:    seq,
+		Tables: uint32(tables),
+		Blocks: blocks,
+	})
+}
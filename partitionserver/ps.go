@@ -1,20 +1,28 @@
 package partitionserver
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"path"
 	"strconv"
+	"sync/atomic"
+	"time"
 
+	"github.com/dgraph-io/ristretto"
 	"github.com/journeymidnight/autumn/manager/pmclient"
 	"github.com/journeymidnight/autumn/manager/smclient"
 	"github.com/journeymidnight/autumn/proto/pb"
 	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/rangepartition"
+	"github.com/journeymidnight/autumn/rangepartition/table"
 	"github.com/journeymidnight/autumn/streamclient"
 	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
 	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 )
 
@@ -22,8 +30,16 @@ type partID_t = uint64
 type psID_t = uint64
 
 type PartitionServer struct {
-	utils.SafeMutex //protect rangePartitions
+	utils.SafeMutex //protect rangePartitions, streams, psversions
 	rangePartitions map[partID_t]*rangepartition.RangePartition
+	streams         map[partID_t][]*streamclient.AutumnStreamClient //row, log and blob stream of each partition
+	psversions      map[partID_t]uint64
+	adminLock       utils.SafeMutex //serialise split, stop and start of partitions
+	leaseLock       utils.SafeMutex //serialise lease renewals, admin ops do not block them
+	leaseExpire     int64           //unix nano, protected by atomic
+	leaseStopper    *utils.Stopper
+	lastStats       map[partID_t]rangepartition.Stats //protected by leaseLock
+	lastStatsTime   time.Time
 	PSID            uint64
 	pmClient        *pmclient.AutumnPMClient
 	smClient        *smclient.SMClient
@@ -33,11 +49,23 @@ type PartitionServer struct {
 	extentManager *streamclient.AutumnExtentManager
 	blockReader   *streamclient.AutumnBlockReader
 	grcpServer    *grpc.Server
+	//values bigger than BlobThreshold are written to blob streams, 0 means never.
+	//new blob streams are created with BlobDataShard and BlobParityShard
+	BlobThreshold   uint32
+	BlobDataShard   uint32
+	BlobParityShard uint32
+	//blocks of tables are cached in blockCache which is shared by all partitions,
+	//BlockCacheSize is in bytes, 0 means no cache
+	BlockCacheSize int64
+	blockCache     *ristretto.Cache
 }
 
 func NewPartitionServer(smAddr []string, pmAddr []string, baseDir string, address string) *PartitionServer {
 	return &PartitionServer{
 		rangePartitions: make(map[partID_t]*rangepartition.RangePartition),
+		streams:         make(map[partID_t][]*streamclient.AutumnStreamClient),
+		psversions:      make(map[partID_t]uint64),
+		leaseStopper:    utils.NewStopper(),
 		smClient:        smclient.NewSMClient(smAddr),
 		pmClient:        pmclient.NewAutumnPMClient(pmAddr),
 		baseFileDir:     baseDir,
@@ -64,6 +92,13 @@ func (ps *PartitionServer) Init() {
 	ps.extentManager = smclient.NewExtentManager(ps.smClient)
 	ps.blockReader = streamclient.NewAutumnBlockReader(ps.extentManager, ps.smClient)
 
+	if ps.BlockCacheSize > 0 {
+		var err error
+		if ps.blockCache, err = table.NewBlockCache(ps.BlockCacheSize); err != nil {
+			xlog.Logger.Fatalf(err.Error())
+		}
+	}
+
 	metas := ps.pmClient.GetPartitionMeta(ps.PSID)
 	xlog.Logger.Infof("get all partitions for PS :%+v: RangePartitions", metas)
 
@@ -74,13 +109,18 @@ func (ps *PartitionServer) Init() {
 		}
 	}
 
+	if err := ps.renewLease(); err != nil {
+		xlog.Logger.Warnf("renew lease: %v", err)
+	}
+	ps.leaseStopper.RunWorker(ps.leaseLoop)
 }
 
 func (ps *PartitionServer) startRangePartition(meta *pspb.PartitionMeta) error {
 	//1. pmclient get info
 	//2. streamclient connect
 	//3. open RangePartition
-	var row, log *streamclient.AutumnStreamClient
+	var row, log, blob *streamclient.AutumnStreamClient
+	var merged []*streamclient.AutumnStreamClient
 
 	cleanup := func() {
 		if row != nil {
@@ -89,6 +129,12 @@ func (ps *PartitionServer) startRangePartition(meta *pspb.PartitionMeta) error {
 		if log != nil {
 			log.Close()
 		}
+		if blob != nil {
+			blob.Close()
+		}
+		for _, stream := range merged {
+			stream.Close()
+		}
 	}
 
 	row = streamclient.NewStreamClient(ps.smClient, ps.extentManager, meta.RowStream)
@@ -103,6 +149,18 @@ func (ps *PartitionServer) startRangePartition(meta *pspb.PartitionMeta) error {
 		return err
 	}
 
+	//the last owner may still append to the streams until its lease expires,
+	//seal their last extents before the log is replayed, so its appends fail
+	//instead of landing after the replay
+	if err := log.SealLastExtent(); err != nil {
+		cleanup()
+		return errors.Wrapf(err, "seal log stream %d", meta.LogStream)
+	}
+	if err := row.SealLastExtent(); err != nil {
+		cleanup()
+		return errors.Wrapf(err, "seal row stream %d", meta.RowStream)
+	}
+
 	openStream := func(si pb.StreamInfo) streamclient.StreamClient {
 		return streamclient.NewStreamClient(ps.smClient, ps.extentManager, si.StreamID)
 	}
@@ -116,26 +174,333 @@ func (ps *PartitionServer) startRangePartition(meta *pspb.PartitionMeta) error {
 		blobs = meta.Blobs.Blob
 	}
 
+	var discard *pspb.DiscardStats
+	if len(meta.Discard) > 0 {
+		discard = &pspb.DiscardStats{}
+		if err := discard.Unmarshal(meta.Discard); err != nil {
+			xlog.Logger.Warnf("invalid discard stats of partition %d: %v", meta.PartID, err)
+			discard = nil
+		}
+	}
+
+	//only the last blob stream is written, values in others are read by blockReader
+	if ps.BlobThreshold > 0 {
+		if len(blobs) == 0 {
+			si, _, err := ps.smClient.CreateStream(context.Background(), ps.BlobDataShard, ps.BlobParityShard)
+			if err != nil {
+				cleanup()
+				return err
+			}
+			if err = ps.pmClient.AddBlobStream(meta.PartID, si.StreamID); err != nil {
+				cleanup()
+				ps.deleteStreams(si.StreamID)
+				return err
+			}
+			blobs = []uint64{si.StreamID}
+		}
+		blob = streamclient.NewStreamClient(ps.smClient, ps.extentManager, blobs[len(blobs)-1])
+		if err := blob.Connect(); err != nil {
+			cleanup()
+			return err
+		}
+	}
+
+	//streams of merged partitions are read until values and tables in them are moved
+	mergedLogs := make(map[uint64]streamclient.StreamClient)
+	mergedRows := make(map[uint64]streamclient.StreamClient)
+	openMerged := func(streamIDs []uint64, streams map[uint64]streamclient.StreamClient) error {
+		for _, streamID := range streamIDs {
+			stream := streamclient.NewStreamClient(ps.smClient, ps.extentManager, streamID)
+			if err := stream.Connect(); err != nil {
+				return err
+			}
+			streams[streamID] = stream
+			merged = append(merged, stream)
+		}
+		return nil
+	}
+	if meta.Merged != nil {
+		err := openMerged(meta.Merged.Logs, mergedLogs)
+		if err == nil {
+			err = openMerged(meta.Merged.Rows, mergedRows)
+		}
+		if err != nil {
+			cleanup()
+			return err
+		}
+	}
+
 	utils.AssertTrue(meta.Rg != nil)
 	utils.AssertTrue(meta.PartID != 0)
 
 	rp := rangepartition.OpenRangePartition(meta.PartID, row, log, ps.blockReader, meta.Rg.StartKey, meta.Rg.EndKey, locs,
-		blobs, ps.pmClient, openStream, nil)
+		ps.pmClient, openStream, nil, rangepartition.OpenOption{
+			BlobStreams:      blobs,
+			Discard:          discard,
+			BlockCache:       ps.blockCache,
+			Compression:      meta.Compression,
+			PrefixBloomLen:   meta.PrefixBloomLen,
+			MaxVersions:      meta.MaxVersions,
+			VersionRetention: time.Duration(meta.VersionRetention) * time.Second,
+			MergedLogs:       mergedLogs,
+			MergedRows:       mergedRows,
+			DeleteStream: func(streamID uint64) error {
+				return ps.smClient.DeleteStream(context.Background(), streamID)
+			},
+		})
+	streams := append([]*streamclient.AutumnStreamClient{row, log}, merged...)
+	if blob != nil {
+		rp.SetBlobStream(blob, ps.BlobThreshold)
+		streams = append(streams, blob)
+	}
 
 	//FIXME: check each partID is uniq
 	ps.Lock()
 	ps.rangePartitions[meta.PartID] = rp
+	ps.streams[meta.PartID] = streams
+	ps.psversions[meta.PartID] = meta.Psversion
 	ps.Unlock()
 	xlog.Logger.Infof("open range partition %d, StartKey:[%s], EndKey:[%s]", meta.PartID, meta.Rg.StartKey, meta.Rg.EndKey)
 	return nil
 }
 
-func (ps *PartitionServer) stopRangePartition() {
-	//FIXME
+//stopRangePartition closes the partition gracefully, all data in memtable is flushed into tables
+func (ps *PartitionServer) stopRangePartition(partID uint64) (*rangepartition.RangePartition, error) {
+	ps.Lock()
+	rp := ps.rangePartitions[partID]
+	streams := ps.streams[partID]
+	delete(ps.rangePartitions, partID)
+	delete(ps.streams, partID)
+	delete(ps.psversions, partID)
+	ps.Unlock()
+	if rp == nil {
+		return nil, errors.Errorf("no such partid %d", partID)
+	}
+
+	err := rp.Close()
+	for _, stream := range streams {
+		stream.Close()
+	}
+	return rp, err
 }
 
+//splitRangePartition splits the partition at splitKey, if splitKey is empty, the
+//middle key of tables is used. [startKey, splitKey) keeps partID and its streams,
+//[splitKey, endKey) gets new streams, both of them open the parent's tables
+//until compaction rewrites them.
+func (ps *PartitionServer) splitRangePartition(partID uint64, psversion uint64, splitKey []byte) (uint64, []byte, error) {
+	ps.adminLock.Lock()
+	defer ps.adminLock.Unlock()
+
+	if err := ps.checkPartVersion(psversion, partID); err != nil {
+		return 0, nil, err
+	}
+	ps.RLock()
+	rp := ps.rangePartitions[partID]
+	streams := ps.streams[partID]
+	ps.RUnlock()
+
+	var err error
+	if len(splitKey) == 0 {
+		if splitKey, err = rp.SplitKey(); err != nil {
+			return 0, nil, err
+		}
+	} else if !rp.CanSplitAt(splitKey) {
+		return 0, nil, errors.Errorf("split key is out of range")
+	}
+
+	//streams of the new partition have the same replication or EC layout as
+	//the parent's
+	rowData, rowParity, err := streams[0].Shards()
+	if err != nil {
+		return 0, nil, err
+	}
+	logData, logParity, err := streams[1].Shards()
+	if err != nil {
+		return 0, nil, err
+	}
+	log, _, err := ps.smClient.CreateStream(context.Background(), logData, logParity)
+	if err != nil {
+		return 0, nil, err
+	}
+	row, _, err := ps.smClient.CreateStream(context.Background(), rowData, rowParity)
+	if err != nil {
+		ps.deleteStreams(log.StreamID)
+		return 0, nil, err
+	}
+	created := []uint64{log.StreamID, row.StreamID}
+	//the new partition must not write blob streams of the parent
+	var blobID uint64
+	if len(rp.BlobStreams()) > 0 || ps.BlobThreshold > 0 {
+		blob, _, err := ps.smClient.CreateStream(context.Background(), ps.BlobDataShard, ps.BlobParityShard)
+		if err != nil {
+			ps.deleteStreams(created...)
+			return 0, nil, err
+		}
+		blobID = blob.StreamID
+		created = append(created, blobID)
+	}
+
+	//data in memtable must be in tables before the new partition opens them
+	var newPartID uint64
+	_, splitErr := ps.stopRangePartition(partID)
+	if splitErr == nil {
+		//values of the new partition are in log stream of the parent until gc
+		newPartID, splitErr = ps.pmClient.SplitPartition(partID, splitKey, log.StreamID, row.StreamID, blobID, rp.LogHead(), rp.TableLocs())
+	}
+
+	//reopen both halves, or the parent if split failed
+	var saved bool
+	for _, meta := range ps.pmClient.GetPartitionMeta(ps.PSID) {
+		if meta.LogStream == log.StreamID {
+			saved = true
+		}
+		if meta.PartID == partID || (splitErr == nil && meta.PartID == newPartID) {
+			if err := ps.startRangePartition(meta); err != nil {
+				xlog.Logger.Errorf("reopen range partition %d: %v", meta.PartID, err)
+			}
+		}
+	}
+	if splitErr != nil {
+		//PM may have saved the split before the error, the new partition owns the streams then
+		if !saved {
+			ps.deleteStreams(created...)
+		}
+		return 0, nil, splitErr
+	}
+	return newPartID, splitKey, nil
+}
+
+//deleteStreams deletes streams which no partition uses, errors are only logged
+//and the streams are left in SM
+func (ps *PartitionServer) deleteStreams(streamIDs ...uint64) {
+	for _, streamID := range streamIDs {
+		if err := ps.smClient.DeleteStream(context.Background(), streamID); err != nil {
+			xlog.Logger.Warnf("failed to delete stream %d: %v", streamID, err)
+		}
+	}
+}
+
+//mergeRangePartitions merges partID with the partition right after it on this PS.
+//Both are closed gracefully so their logs are flushed into tables, the survivor
+//partID opens tables of both with its own streams. It replays the log of the other
+//one and compacts its tables, then the streams of the other one are deleted.
+func (ps *PartitionServer) mergeRangePartitions(partID uint64, psversion uint64) (uint64, error) {
+	ps.adminLock.Lock()
+	defer ps.adminLock.Unlock()
+
+	if err := ps.checkPartVersion(psversion, partID); err != nil {
+		return 0, err
+	}
+	ps.RLock()
+	left := ps.rangePartitions[partID]
+	var right *rangepartition.RangePartition
+	if left != nil && len(left.EndKey) > 0 {
+		for _, rp := range ps.rangePartitions {
+			if bytes.Equal(rp.StartKey, left.EndKey) {
+				right = rp
+				break
+			}
+		}
+	}
+	ps.RUnlock()
+	if left == nil {
+		return 0, errors.Errorf("no such partid %d", partID)
+	}
+	if right == nil {
+		return 0, errors.Errorf("the next partition of %d is not on this PS", partID)
+	}
+
+	_, mergeErr := ps.stopRangePartition(left.PartID)
+	if _, err := ps.stopRangePartition(right.PartID); mergeErr == nil {
+		mergeErr = err
+	}
+	if mergeErr == nil {
+		locs := append(left.TableLocs(), right.TableLocs()...)
+		mergeErr = ps.pmClient.MergePartition(left.PartID, right.PartID, locs)
+	}
+
+	//reopen the survivor, or both if merge failed
+	for _, meta := range ps.pmClient.GetPartitionMeta(ps.PSID) {
+		if meta.PartID == left.PartID || (mergeErr != nil && meta.PartID == right.PartID) {
+			if err := ps.startRangePartition(meta); err != nil {
+				xlog.Logger.Errorf("reopen range partition %d: %v", meta.PartID, err)
+			}
+		}
+	}
+	if mergeErr != nil {
+		return 0, mergeErr
+	}
+	return right.PartID, nil
+}
+
+//openRangePartition opens partID if PM has assigned it to this PS
+func (ps *PartitionServer) openRangePartition(partID uint64, psversion uint64) error {
+	ps.adminLock.Lock()
+	defer ps.adminLock.Unlock()
+
+	if !ps.leaseValid() {
+		return wire_errors.Redirect
+	}
+	ps.RLock()
+	_, ok := ps.rangePartitions[partID]
+	version := ps.psversions[partID]
+	ps.RUnlock()
+	if ok {
+		if version != psversion {
+			return wire_errors.PSVersionMismatch
+		}
+		return nil
+	}
+	for _, meta := range ps.pmClient.GetPartitionMeta(ps.PSID) {
+		if meta.PartID == partID {
+			//PM has moved it again since the request was sent
+			if meta.Psversion != psversion {
+				return wire_errors.PSVersionMismatch
+			}
+			return ps.startRangePartition(meta)
+		}
+	}
+	return errors.Errorf("partition %d is not assigned to PS %d", partID, ps.PSID)
+}
+
+//closeRangePartition is called by PM before partID is moved to another PS.
+//closing a partition which is not opened is not an error.
+func (ps *PartitionServer) closeRangePartition(partID uint64, psversion uint64) error {
+	ps.adminLock.Lock()
+	defer ps.adminLock.Unlock()
+
+	ps.RLock()
+	_, ok := ps.rangePartitions[partID]
+	ps.RUnlock()
+	if !ok {
+		return nil
+	}
+	//flushing after the lease expires may write streams owned by another PS
+	if err := ps.checkPartVersion(psversion, partID); err != nil {
+		return err
+	}
+	_, err := ps.stopRangePartition(partID)
+	return err
+}
+
+//Close closes all range partitions gracefully
 func (ps *PartitionServer) Close() {
+	ps.adminLock.Lock()
+	defer ps.adminLock.Unlock()
+
+	ps.RLock()
+	partIDs := make([]uint64, 0, len(ps.rangePartitions))
+	for partID := range ps.rangePartitions {
+		partIDs = append(partIDs, partID)
+	}
+	ps.RUnlock()
 
+	for _, partID := range partIDs {
+		if _, err := ps.stopRangePartition(partID); err != nil {
+			xlog.Logger.Errorf("close range partition %d: %v", partID, err)
+		}
+	}
 }
 
 func (ps *PartitionServer) registerPS() {
@@ -188,6 +553,127 @@ func (ps *PartitionServer) ServeGRPC() error {
 	return nil
 }
 
+//Shutdown stops serving requests, and closes all range partitions
 func (ps *PartitionServer) Shutdown() {
-	//FIXME
+	if ps.grcpServer != nil {
+		ps.grcpServer.GracefulStop()
+	}
+	ps.leaseStopper.Stop()
+	ps.Close()
+	if ps.blockCache != nil {
+		ps.blockCache.Close()
+	}
+}
+
+func (ps *PartitionServer) leaseValid() bool {
+	return time.Now().UnixNano() < atomic.LoadInt64(&ps.leaseExpire)
+}
+
+//renewLease extends the lease of this PS, partitions which are not in the lease or
+//whose psversion changed have been given to other PSes, they are abandoned
+//without flushing, because their streams may be written by the new owner.
+//renewLease does not take adminLock, a slow split or close must not let the
+//lease expire. Partitions opened, closed or reopened by admin ops while the lease
+//is being renewed are left alone, the lease may not know them yet.
+func (ps *PartitionServer) renewLease() error {
+	ps.leaseLock.Lock()
+	defer ps.leaseLock.Unlock()
+
+	start := time.Now()
+	ps.RLock()
+	versions := make(map[partID_t]uint64, len(ps.psversions))
+	for partID, version := range ps.psversions {
+		versions[partID] = version
+	}
+	ps.RUnlock()
+
+	leases, ttl, err := ps.pmClient.RenewLease(ps.PSID, ps.collectLoads(start))
+	if err != nil {
+		return err
+	}
+
+	ps.Lock()
+	var lost []partID_t
+	for partID, version := range ps.psversions {
+		if before, ok := versions[partID]; !ok || before != version {
+			continue
+		}
+		if v, ok := leases[partID]; !ok || v != version {
+			lost = append(lost, partID)
+		}
+	}
+	type abandoned struct {
+		rp      *rangepartition.RangePartition
+		streams []*streamclient.AutumnStreamClient
+	}
+	var parts []abandoned
+	for _, partID := range lost {
+		parts = append(parts, abandoned{ps.rangePartitions[partID], ps.streams[partID]})
+		delete(ps.rangePartitions, partID)
+		delete(ps.streams, partID)
+		delete(ps.psversions, partID)
+	}
+	ps.Unlock()
+
+	atomic.StoreInt64(&ps.leaseExpire, start.Add(ttl).UnixNano())
+
+	//abandoning waits for compaction and gc, the next renewal does not
+	for i, part := range parts {
+		xlog.Logger.Warnf("range partition %d is not owned by PS %d any more", lost[i], ps.PSID)
+		go func(part abandoned) {
+			part.rp.Abandon()
+			for _, stream := range part.streams {
+				stream.Close()
+			}
+		}(part)
+	}
+	return nil
+}
+
+//collectLoads returns loads of all partitions since the last call
+func (ps *PartitionServer) collectLoads(now time.Time) []*pspb.PartitionLoad {
+	stats := make(map[partID_t]rangepartition.Stats)
+	ps.RLock()
+	for partID, rp := range ps.rangePartitions {
+		stats[partID] = rp.Stats()
+	}
+	ps.RUnlock()
+
+	elapsed := now.Sub(ps.lastStatsTime).Seconds()
+	var loads []*pspb.PartitionLoad
+	for partID, s := range stats {
+		load := &pspb.PartitionLoad{
+			PartID:       partID,
+			MemtableSize: s.MemtableSize,
+			TableSize:    s.TableSize,
+		}
+		//partitions opened or reopened after the last call have no rates yet
+		if last, ok := ps.lastStats[partID]; ok && elapsed > 0 &&
+			s.Reads >= last.Reads && s.Writes >= last.Writes && s.WriteBytes >= last.WriteBytes {
+			load.Qps = uint64(float64(s.Reads+s.Writes-last.Reads-last.Writes) / elapsed)
+			load.WriteBytes = uint64(float64(s.WriteBytes-last.WriteBytes) / elapsed)
+		}
+		loads = append(loads, load)
+	}
+	ps.lastStats = stats
+	ps.lastStatsTime = now
+	return loads
+}
+
+//leaseRenewInterval should be much less than the lease ttl given by PM
+const leaseRenewInterval = 3 * time.Second
+
+func (ps *PartitionServer) leaseLoop() {
+	ticker := time.NewTicker(leaseRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ps.leaseStopper.ShouldStop():
+			return
+		case <-ticker.C:
+			if err := ps.renewLease(); err != nil {
+				xlog.Logger.Warnf("renew lease: %v", err)
+			}
+		}
+	}
 }
@@ -30,12 +30,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Code int32
 
 const (
-	Code_OK          Code = 0
-	Code_ERROR       Code = 1
-	Code_EndOfExtent Code = 2
-	Code_EndOfStream Code = 3
-	Code_EVersionLow Code = 4
-	Code_NotLEADER   Code = 5
+	Code_OK                 Code = 0
+	Code_ERROR              Code = 1
+	Code_EndOfExtent        Code = 2
+	Code_EndOfStream        Code = 3
+	Code_EVersionLow        Code = 4
+	Code_NotLEADER          Code = 5
+	Code_PreconditionFailed Code = 6
+	Code_EPSVersion         Code = 7
 )
 
 var Code_name = map[int32]string{
@@ -45,15 +47,19 @@ var Code_name = map[int32]string{
 	3: "EndOfStream",
 	4: "EVersionLow",
 	5: "NotLEADER",
+	6: "PreconditionFailed",
+	7: "EPSVersion",
 }
 
 var Code_value = map[string]int32{
-	"OK":          0,
-	"ERROR":       1,
-	"EndOfExtent": 2,
-	"EndOfStream": 3,
-	"EVersionLow": 4,
-	"NotLEADER":   5,
+	"OK":                 0,
+	"ERROR":              1,
+	"EndOfExtent":        2,
+	"EndOfStream":        3,
+	"EVersionLow":        4,
+	"NotLEADER":          5,
+	"PreconditionFailed": 6,
+	"EPSVersion":         7,
 }
 
 func (x Code) String() string {
@@ -1743,6 +1749,102 @@ func (m *AllocExtentResponse) GetCodeDes() string {
 	return ""
 }
 
+type DeleteExtentRequest struct {
+	ExtentID uint64 `protobuf:"varint,1,opt,name=extentID,proto3" json:"extentID,omitempty"`
+}
+
+func (m *DeleteExtentRequest) Reset()         { *m = DeleteExtentRequest{} }
+func (m *DeleteExtentRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteExtentRequest) ProtoMessage()    {}
+func (*DeleteExtentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{29}
+}
+func (m *DeleteExtentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteExtentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteExtentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteExtentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteExtentRequest.Merge(m, src)
+}
+func (m *DeleteExtentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteExtentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteExtentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteExtentRequest proto.InternalMessageInfo
+
+func (m *DeleteExtentRequest) GetExtentID() uint64 {
+	if m != nil {
+		return m.ExtentID
+	}
+	return 0
+}
+
+type DeleteExtentResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+}
+
+func (m *DeleteExtentResponse) Reset()         { *m = DeleteExtentResponse{} }
+func (m *DeleteExtentResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteExtentResponse) ProtoMessage()    {}
+func (*DeleteExtentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{30}
+}
+func (m *DeleteExtentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteExtentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteExtentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteExtentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteExtentResponse.Merge(m, src)
+}
+func (m *DeleteExtentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteExtentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteExtentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteExtentResponse proto.InternalMessageInfo
+
+func (m *DeleteExtentResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_OK
+}
+
+func (m *DeleteExtentResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
+}
+
 type StreamAllocExtentRequest struct {
 	StreamID     uint64 `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
 	ExtentToSeal uint64 `protobuf:"varint,2,opt,name=extentToSeal,proto3" json:"extentToSeal,omitempty"`
@@ -1754,7 +1856,7 @@ func (m *StreamAllocExtentRequest) Reset()         { *m = StreamAllocExtentReque
 func (m *StreamAllocExtentRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamAllocExtentRequest) ProtoMessage()    {}
 func (*StreamAllocExtentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{29}
+	return fileDescriptor_f80abaa17e25ccc8, []int{31}
 }
 func (m *StreamAllocExtentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1822,7 +1924,7 @@ func (m *StreamAllocExtentResponse) Reset()         { *m = StreamAllocExtentResp
 func (m *StreamAllocExtentResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamAllocExtentResponse) ProtoMessage()    {}
 func (*StreamAllocExtentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{30}
+	return fileDescriptor_f80abaa17e25ccc8, []int{32}
 }
 func (m *StreamAllocExtentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +1989,7 @@ func (m *StreamInfoRequest) Reset()         { *m = StreamInfoRequest{} }
 func (m *StreamInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamInfoRequest) ProtoMessage()    {}
 func (*StreamInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{31}
+	return fileDescriptor_f80abaa17e25ccc8, []int{33}
 }
 func (m *StreamInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1934,7 +2036,7 @@ func (m *StreamInfoResponse) Reset()         { *m = StreamInfoResponse{} }
 func (m *StreamInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamInfoResponse) ProtoMessage()    {}
 func (*StreamInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{32}
+	return fileDescriptor_f80abaa17e25ccc8, []int{34}
 }
 func (m *StreamInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2101,7 @@ func (m *ExtentInfoRequest) Reset()         { *m = ExtentInfoRequest{} }
 func (m *ExtentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ExtentInfoRequest) ProtoMessage()    {}
 func (*ExtentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{33}
+	return fileDescriptor_f80abaa17e25ccc8, []int{35}
 }
 func (m *ExtentInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2045,7 +2147,7 @@ func (m *ExtentInfoResponse) Reset()         { *m = ExtentInfoResponse{} }
 func (m *ExtentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ExtentInfoResponse) ProtoMessage()    {}
 func (*ExtentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{34}
+	return fileDescriptor_f80abaa17e25ccc8, []int{36}
 }
 func (m *ExtentInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2102,7 +2204,7 @@ func (m *NodesInfoRequest) Reset()         { *m = NodesInfoRequest{} }
 func (m *NodesInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodesInfoRequest) ProtoMessage()    {}
 func (*NodesInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{35}
+	return fileDescriptor_f80abaa17e25ccc8, []int{37}
 }
 func (m *NodesInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2141,7 +2243,7 @@ func (m *NodesInfoResponse) Reset()         { *m = NodesInfoResponse{} }
 func (m *NodesInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*NodesInfoResponse) ProtoMessage()    {}
 func (*NodesInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{36}
+	return fileDescriptor_f80abaa17e25ccc8, []int{38}
 }
 func (m *NodesInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2199,7 +2301,7 @@ func (m *RegisterNodeRequest) Reset()         { *m = RegisterNodeRequest{} }
 func (m *RegisterNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterNodeRequest) ProtoMessage()    {}
 func (*RegisterNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{37}
+	return fileDescriptor_f80abaa17e25ccc8, []int{39}
 }
 func (m *RegisterNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2245,7 +2347,7 @@ func (m *RegisterNodeResponse) Reset()         { *m = RegisterNodeResponse{} }
 func (m *RegisterNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterNodeResponse) ProtoMessage()    {}
 func (*RegisterNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{38}
+	return fileDescriptor_f80abaa17e25ccc8, []int{40}
 }
 func (m *RegisterNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2304,7 +2406,7 @@ func (m *CreateStreamRequest) Reset()         { *m = CreateStreamRequest{} }
 func (m *CreateStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateStreamRequest) ProtoMessage()    {}
 func (*CreateStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{39}
+	return fileDescriptor_f80abaa17e25ccc8, []int{41}
 }
 func (m *CreateStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2358,7 +2460,7 @@ func (m *CreateStreamResponse) Reset()         { *m = CreateStreamResponse{} }
 func (m *CreateStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateStreamResponse) ProtoMessage()    {}
 func (*CreateStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{40}
+	return fileDescriptor_f80abaa17e25ccc8, []int{42}
 }
 func (m *CreateStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2526,7 @@ func (m *TruncateRequest) Reset()         { *m = TruncateRequest{} }
 func (m *TruncateRequest) String() string { return proto.CompactTextString(m) }
 func (*TruncateRequest) ProtoMessage()    {}
 func (*TruncateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{41}
+	return fileDescriptor_f80abaa17e25ccc8, []int{43}
 }
 func (m *TruncateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2476,7 +2578,7 @@ func (m *TruncateResponse) Reset()         { *m = TruncateResponse{} }
 func (m *TruncateResponse) String() string { return proto.CompactTextString(m) }
 func (*TruncateResponse) ProtoMessage()    {}
 func (*TruncateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{42}
+	return fileDescriptor_f80abaa17e25ccc8, []int{44}
 }
 func (m *TruncateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2519,6 +2621,103 @@ func (m *TruncateResponse) GetCodeDes() string {
 	return ""
 }
 
+//all extents of the stream are deleted
+type DeleteStreamRequest struct {
+	StreamID uint64 `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+}
+
+func (m *DeleteStreamRequest) Reset()         { *m = DeleteStreamRequest{} }
+func (m *DeleteStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteStreamRequest) ProtoMessage()    {}
+func (*DeleteStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{45}
+}
+func (m *DeleteStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteStreamRequest.Merge(m, src)
+}
+func (m *DeleteStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteStreamRequest proto.InternalMessageInfo
+
+func (m *DeleteStreamRequest) GetStreamID() uint64 {
+	if m != nil {
+		return m.StreamID
+	}
+	return 0
+}
+
+type DeleteStreamResponse struct {
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+}
+
+func (m *DeleteStreamResponse) Reset()         { *m = DeleteStreamResponse{} }
+func (m *DeleteStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteStreamResponse) ProtoMessage()    {}
+func (*DeleteStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{46}
+}
+func (m *DeleteStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteStreamResponse.Merge(m, src)
+}
+func (m *DeleteStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteStreamResponse proto.InternalMessageInfo
+
+func (m *DeleteStreamResponse) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_OK
+}
+
+func (m *DeleteStreamResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
+}
+
 type SubmitRecoveryTaskRequest struct {
 	Task *RecoveryTask `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 }
@@ -2527,7 +2726,7 @@ func (m *SubmitRecoveryTaskRequest) Reset()         { *m = SubmitRecoveryTaskReq
 func (m *SubmitRecoveryTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmitRecoveryTaskRequest) ProtoMessage()    {}
 func (*SubmitRecoveryTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{43}
+	return fileDescriptor_f80abaa17e25ccc8, []int{47}
 }
 func (m *SubmitRecoveryTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2572,7 +2771,7 @@ func (m *SubmitRecoveryTaskResponse) Reset()         { *m = SubmitRecoveryTaskRe
 func (m *SubmitRecoveryTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitRecoveryTaskResponse) ProtoMessage()    {}
 func (*SubmitRecoveryTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{44}
+	return fileDescriptor_f80abaa17e25ccc8, []int{48}
 }
 func (m *SubmitRecoveryTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2626,7 +2825,7 @@ func (m *MemberValue) Reset()         { *m = MemberValue{} }
 func (m *MemberValue) String() string { return proto.CompactTextString(m) }
 func (*MemberValue) ProtoMessage()    {}
 func (*MemberValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{45}
+	return fileDescriptor_f80abaa17e25ccc8, []int{49}
 }
 func (m *MemberValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2689,7 +2888,7 @@ func (m *ExtentInfo) Reset()         { *m = ExtentInfo{} }
 func (m *ExtentInfo) String() string { return proto.CompactTextString(m) }
 func (*ExtentInfo) ProtoMessage()    {}
 func (*ExtentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{46}
+	return fileDescriptor_f80abaa17e25ccc8, []int{50}
 }
 func (m *ExtentInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2769,7 +2968,7 @@ func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
 func (m *StreamInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamInfo) ProtoMessage()    {}
 func (*StreamInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{47}
+	return fileDescriptor_f80abaa17e25ccc8, []int{51}
 }
 func (m *StreamInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2821,7 +3020,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f80abaa17e25ccc8, []int{48}
+	return fileDescriptor_f80abaa17e25ccc8, []int{52}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,6 +3094,8 @@ func init() {
 	proto.RegisterType((*ReplicateBlocksResponse)(nil), "pb.ReplicateBlocksResponse")
 	proto.RegisterType((*AllocExtentRequest)(nil), "pb.AllocExtentRequest")
 	proto.RegisterType((*AllocExtentResponse)(nil), "pb.AllocExtentResponse")
+	proto.RegisterType((*DeleteExtentRequest)(nil), "pb.DeleteExtentRequest")
+	proto.RegisterType((*DeleteExtentResponse)(nil), "pb.DeleteExtentResponse")
 	proto.RegisterType((*StreamAllocExtentRequest)(nil), "pb.StreamAllocExtentRequest")
 	proto.RegisterType((*StreamAllocExtentResponse)(nil), "pb.StreamAllocExtentResponse")
 	proto.RegisterType((*StreamInfoRequest)(nil), "pb.StreamInfoRequest")
@@ -2913,6 +3114,8 @@ func init() {
 	proto.RegisterType((*CreateStreamResponse)(nil), "pb.CreateStreamResponse")
 	proto.RegisterType((*TruncateRequest)(nil), "pb.TruncateRequest")
 	proto.RegisterType((*TruncateResponse)(nil), "pb.TruncateResponse")
+	proto.RegisterType((*DeleteStreamRequest)(nil), "pb.DeleteStreamRequest")
+	proto.RegisterType((*DeleteStreamResponse)(nil), "pb.DeleteStreamResponse")
 	proto.RegisterType((*SubmitRecoveryTaskRequest)(nil), "pb.SubmitRecoveryTaskRequest")
 	proto.RegisterType((*SubmitRecoveryTaskResponse)(nil), "pb.SubmitRecoveryTaskResponse")
 	proto.RegisterType((*MemberValue)(nil), "pb.MemberValue")
@@ -2924,122 +3127,127 @@ func init() {
 func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
 
 var fileDescriptor_f80abaa17e25ccc8 = []byte{
-	// 1830 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x19, 0x4d, 0x6f, 0x1b, 0xd5,
-	0xd6, 0x63, 0x8f, 0x9d, 0xf8, 0x38, 0x1f, 0xce, 0x4d, 0xe2, 0xba, 0xd3, 0xd4, 0xca, 0xbb, 0xaf,
-	0xaf, 0x2f, 0x7d, 0x0f, 0xd2, 0x36, 0x95, 0x00, 0x55, 0x14, 0x9a, 0xc4, 0x09, 0x49, 0x9b, 0x34,
-	0x30, 0x49, 0xba, 0x61, 0xc3, 0xd8, 0x73, 0x9d, 0xba, 0xb1, 0x3d, 0x66, 0x66, 0x12, 0x1a, 0xa4,
-	0x22, 0x81, 0x84, 0x84, 0x58, 0xb1, 0x43, 0x62, 0xc1, 0xaf, 0x60, 0xcd, 0x1a, 0x89, 0x4d, 0x77,
-	0xb0, 0x44, 0xe9, 0x8e, 0x5f, 0x81, 0xee, 0xd7, 0xcc, 0x9d, 0x19, 0x3b, 0xb8, 0x0c, 0x62, 0xe5,
-	0x7b, 0xce, 0xbd, 0xe7, 0xdc, 0xf3, 0x35, 0xe7, 0xe3, 0x1a, 0xc6, 0xfb, 0x8d, 0xe5, 0xbe, 0xeb,
-	0xf8, 0x0e, 0xca, 0xf6, 0x1b, 0xc6, 0xdc, 0x91, 0x73, 0xe4, 0x30, 0xf0, 0x26, 0x5d, 0xf1, 0x1d,
-	0xfc, 0x1c, 0xf2, 0x1b, 0x3d, 0xdf, 0x3d, 0x43, 0x65, 0xc8, 0x1d, 0x93, 0xb3, 0xaa, 0xb6, 0xa8,
-	0x2d, 0x4d, 0x98, 0x74, 0x89, 0xe6, 0x20, 0x7f, 0x6a, 0x75, 0x4e, 0x48, 0x35, 0xcb, 0x70, 0x1c,
-	0x40, 0x08, 0xf4, 0x2e, 0xf1, 0xad, 0x6a, 0x6e, 0x51, 0x5b, 0x9a, 0x34, 0xd9, 0x1a, 0x19, 0x30,
-	0x7e, 0xe8, 0x11, 0x77, 0x97, 0xe2, 0x75, 0x86, 0x0f, 0x60, 0xb4, 0x00, 0xc5, 0x8d, 0x67, 0xfd,
-	0xb6, 0x4b, 0xbc, 0x55, 0xbf, 0x9a, 0x5f, 0xd4, 0x96, 0x74, 0x33, 0x44, 0xe0, 0x2f, 0x34, 0x28,
-	0xb2, 0xfb, 0xb7, 0x7b, 0x2d, 0x07, 0x5d, 0x81, 0x5c, 0xc7, 0x39, 0x62, 0x32, 0x94, 0x56, 0x8a,
-	0xcb, 0xfd, 0xc6, 0x32, 0xdb, 0x33, 0x29, 0x96, 0x5e, 0x42, 0x9e, 0xf9, 0xa4, 0xe7, 0x6f, 0xd7,
-	0x99, 0x44, 0xba, 0x19, 0xc0, 0xa8, 0x02, 0x05, 0xa7, 0xd5, 0xf2, 0x88, 0x2f, 0xc4, 0x12, 0x10,
-	0xba, 0x06, 0x93, 0xc4, 0xf3, 0xdb, 0x5d, 0xcb, 0x27, 0xf6, 0x7e, 0xfb, 0x53, 0xc2, 0xa4, 0xd3,
-	0xcd, 0x28, 0x12, 0x5f, 0x81, 0xfc, 0x5a, 0xc7, 0x69, 0x1e, 0x53, 0xdd, 0x6c, 0xcb, 0xb7, 0x84,
-	0x11, 0xd8, 0x1a, 0x3f, 0x85, 0xc9, 0xd5, 0x7e, 0x9f, 0xf4, 0x6c, 0x93, 0x7c, 0x7c, 0x42, 0x3c,
-	0x3f, 0x22, 0x87, 0x16, 0x93, 0xe3, 0x5f, 0x50, 0x68, 0x50, 0x4e, 0x5e, 0x35, 0xbb, 0x98, 0x93,
-	0x3a, 0x30, 0xde, 0xa6, 0xd8, 0x60, 0xe4, 0xa7, 0xc4, 0xf5, 0xda, 0x4e, 0x8f, 0x09, 0x4b, 0xc9,
-	0x05, 0x8c, 0x7d, 0x98, 0x92, 0x77, 0x79, 0x7d, 0xa7, 0xe7, 0x11, 0xb4, 0x00, 0x7a, 0xd3, 0xb1,
-	0x09, 0xbb, 0x68, 0x6a, 0x65, 0x9c, 0xb2, 0x5b, 0x77, 0x6c, 0x62, 0x32, 0x2c, 0xaa, 0xc2, 0x18,
-	0xfd, 0xad, 0x13, 0x8f, 0x59, 0xa4, 0x68, 0x4a, 0x90, 0xee, 0x70, 0x13, 0x78, 0xd5, 0xdc, 0x62,
-	0x6e, 0x69, 0xd2, 0x94, 0x20, 0xf5, 0x33, 0xe9, 0xd9, 0xc2, 0x4d, 0x74, 0x89, 0x6f, 0xc3, 0xec,
-	0xba, 0x4b, 0x2c, 0x9f, 0x6c, 0x30, 0x35, 0x14, 0x3d, 0x3d, 0xdf, 0x25, 0x56, 0x37, 0xd4, 0x53,
-	0xc2, 0xf8, 0x29, 0xcc, 0x45, 0x49, 0x52, 0x8a, 0xab, 0xda, 0x34, 0x17, 0xb5, 0x29, 0xfe, 0x52,
-	0x83, 0x19, 0x93, 0x58, 0x36, 0x33, 0xa3, 0x37, 0x8a, 0x17, 0xc2, 0x68, 0xc8, 0x46, 0xa2, 0x61,
-	0x11, 0x4a, 0xbd, 0x93, 0xee, 0x5e, 0x8b, 0x73, 0x12, 0xa1, 0xa2, 0xa2, 0x22, 0xce, 0xd1, 0x63,
-	0xce, 0xf9, 0x5c, 0x03, 0xa4, 0xca, 0x91, 0x52, 0xe5, 0x30, 0x54, 0x72, 0xc3, 0x42, 0x25, 0xe9,
-	0xaa, 0xab, 0x30, 0xf6, 0xbe, 0x75, 0xd6, 0x71, 0x2c, 0x9b, 0xc6, 0x6a, 0x5d, 0x89, 0x55, 0xba,
-	0x66, 0x9e, 0x74, 0xba, 0xdd, 0xb6, 0xbf, 0x43, 0x7a, 0x47, 0xfe, 0x93, 0x11, 0x6c, 0x85, 0x5b,
-	0x30, 0x17, 0x25, 0x49, 0xa9, 0x56, 0x05, 0x0a, 0x1d, 0xc6, 0x49, 0x7e, 0x89, 0x1c, 0xc2, 0xbb,
-	0x50, 0xda, 0x27, 0x56, 0x67, 0x14, 0xf7, 0x61, 0x98, 0x68, 0x2a, 0x22, 0x09, 0x27, 0x46, 0x70,
-	0x78, 0x13, 0x26, 0x38, 0xbb, 0x74, 0xe2, 0xe2, 0x8f, 0xb8, 0x4f, 0x69, 0x9a, 0x69, 0x93, 0x54,
-	0xc1, 0x55, 0x81, 0x82, 0x4b, 0xfa, 0x1d, 0xeb, 0x4c, 0x2a, 0xce, 0x21, 0xfc, 0x95, 0x06, 0xb3,
-	0x91, 0x2b, 0x52, 0x1a, 0xf8, 0xbf, 0x30, 0x46, 0x38, 0x2b, 0x11, 0x38, 0x93, 0x41, 0x9e, 0xa4,
-	0x39, 0xd4, 0x94, 0xbb, 0x03, 0xa2, 0x67, 0x19, 0xb2, 0xf5, 0x4d, 0x9a, 0xd6, 0x7d, 0xc7, 0xb7,
-	0x3a, 0x42, 0x33, 0x0e, 0xd0, 0x70, 0x6a, 0xb9, 0x84, 0x88, 0xcc, 0xca, 0xd6, 0xf8, 0x0e, 0x14,
-	0xeb, 0x2d, 0x69, 0x93, 0xeb, 0x90, 0xf7, 0x2d, 0xef, 0xd8, 0xab, 0x6a, 0xec, 0xd6, 0x32, 0xbd,
-	0xd5, 0x24, 0x4d, 0xe7, 0x94, 0xb8, 0x67, 0x07, 0x96, 0x77, 0x6c, 0xf2, 0x6d, 0xfc, 0xb5, 0x06,
-	0x40, 0xa9, 0x52, 0xc7, 0x51, 0xd6, 0x6e, 0x31, 0x53, 0x96, 0x56, 0x0a, 0x94, 0xaa, 0xbe, 0x69,
-	0x66, 0xed, 0x16, 0x7a, 0x0d, 0xc6, 0x6d, 0xa7, 0x47, 0xe8, 0x8d, 0x55, 0x7d, 0x88, 0x24, 0xc1,
-	0x09, 0xfc, 0x19, 0x4c, 0xa8, 0x3b, 0x17, 0x3a, 0x76, 0x01, 0x8a, 0xcc, 0x65, 0x4d, 0x12, 0x14,
-	0x98, 0x10, 0x41, 0xdd, 0xdb, 0x73, 0x6c, 0x12, 0xe4, 0x27, 0x01, 0x51, 0x2a, 0xcf, 0xb7, 0x5c,
-	0xff, 0xa0, 0xdd, 0xe5, 0xd5, 0x25, 0x67, 0x86, 0x08, 0xfc, 0x0e, 0x54, 0xa8, 0xfd, 0xda, 0x2e,
-	0x91, 0x62, 0x48, 0x73, 0x5e, 0x03, 0x9d, 0xda, 0x4b, 0xd4, 0xba, 0xa4, 0x0e, 0x6c, 0x17, 0x7f,
-	0x00, 0x97, 0x12, 0xf4, 0x29, 0x23, 0xbe, 0x03, 0x68, 0xdd, 0xe9, 0x07, 0x7c, 0xb6, 0x88, 0x65,
-	0x13, 0xf7, 0x2f, 0xbb, 0xa9, 0x06, 0xd0, 0xe7, 0x09, 0x69, 0x87, 0xc8, 0x7a, 0xa6, 0x60, 0xf0,
-	0x4d, 0x98, 0xa1, 0xb7, 0x25, 0x2a, 0xcb, 0xd0, 0x7c, 0xf4, 0x94, 0x8b, 0x17, 0xab, 0x2b, 0xb7,
-	0xa0, 0xf0, 0x84, 0x09, 0x2a, 0xec, 0x55, 0xe1, 0x02, 0xc6, 0xd5, 0xd8, 0xca, 0x98, 0xe2, 0x1c,
-	0x32, 0x60, 0x4c, 0x88, 0xc1, 0xdb, 0x97, 0xad, 0x8c, 0x29, 0x11, 0x6b, 0x05, 0x5e, 0xe6, 0xb1,
-	0x43, 0xbd, 0xd3, 0xef, 0xb4, 0x9b, 0x96, 0x4f, 0x5e, 0xa9, 0xba, 0xf0, 0x54, 0x24, 0x13, 0x00,
-	0x87, 0x46, 0x48, 0xe8, 0xf8, 0x39, 0x75, 0x67, 0xec, 0xc2, 0x7f, 0xb0, 0xd0, 0xdf, 0x02, 0xb4,
-	0xda, 0xe9, 0x38, 0xcd, 0xd1, 0xbd, 0xb1, 0x0b, 0xb3, 0x11, 0x8a, 0x94, 0xb1, 0xf7, 0x9d, 0x06,
-	0xd5, 0x7d, 0xd6, 0x43, 0x0c, 0x96, 0x63, 0x58, 0xbf, 0x41, 0x4b, 0x02, 0x97, 0xe9, 0xc0, 0xa1,
-	0x69, 0x5f, 0x7c, 0x9e, 0x11, 0x1c, 0xfd, 0x12, 0xa9, 0x57, 0xf7, 0x9f, 0x58, 0xae, 0x2d, 0x72,
-	0x70, 0x88, 0xa0, 0xb5, 0xbf, 0x6f, 0xb9, 0x6d, 0xff, 0x8c, 0xef, 0x73, 0xab, 0xa8, 0x28, 0xfc,
-	0xad, 0x06, 0x97, 0x07, 0x08, 0x97, 0xbe, 0xb3, 0x09, 0xb4, 0xca, 0xc5, 0xb4, 0xba, 0x0e, 0x05,
-	0xae, 0x01, 0x13, 0xa7, 0xb4, 0x32, 0xc5, 0x32, 0x39, 0xb7, 0x3d, 0x4d, 0xe5, 0x62, 0x17, 0xdf,
-	0x86, 0x19, 0x2e, 0x18, 0xc3, 0x0a, 0x73, 0xb1, 0xc4, 0xc3, 0x19, 0xf1, 0x9c, 0xac, 0x9b, 0x21,
-	0x02, 0x9f, 0x67, 0x01, 0xa9, 0x34, 0x29, 0xb5, 0xb8, 0x07, 0x63, 0x9c, 0xb7, 0x0c, 0xee, 0x7f,
-	0x53, 0xd2, 0xe4, 0x05, 0x02, 0xe5, 0xf1, 0xb6, 0x5d, 0xd2, 0x50, 0x72, 0xae, 0x8a, 0x27, 0x72,
-	0xf6, 0x30, 0x72, 0xae, 0xbc, 0x24, 0x17, 0x34, 0xc6, 0x03, 0x98, 0x50, 0xf9, 0xaa, 0xa3, 0x8a,
-	0xce, 0x47, 0x95, 0x6b, 0xea, 0xa8, 0x22, 0x0c, 0xa9, 0xb0, 0xe7, 0x9b, 0x77, 0xb3, 0x6f, 0x69,
-	0x94, 0x97, 0x7a, 0xc9, 0x88, 0xbc, 0x14, 0xa7, 0x84, 0xbc, 0xf0, 0xeb, 0x30, 0xa3, 0x6c, 0x08,
-	0xbf, 0x54, 0x43, 0x5d, 0xb9, 0x57, 0x24, 0x88, 0x7f, 0xd1, 0x00, 0xa9, 0xe7, 0xd3, 0xfb, 0x44,
-	0x5e, 0xa4, 0xf8, 0x24, 0x79, 0xc1, 0x70, 0xa3, 0xfe, 0x6d, 0x86, 0x40, 0x50, 0x7e, 0xe4, 0xd8,
-	0xc4, 0x53, 0xec, 0x80, 0x7f, 0xd6, 0x60, 0x46, 0x41, 0xa6, 0x54, 0xf6, 0x0d, 0xc8, 0xd3, 0x82,
-	0x2b, 0x55, 0x5d, 0xa4, 0x84, 0x09, 0xee, 0x1c, 0xc3, 0xf5, 0xe4, 0xc7, 0x8d, 0x4d, 0x80, 0x10,
-	0x39, 0x40, 0x47, 0x1c, 0xd5, 0x71, 0x42, 0xf2, 0x8d, 0x6b, 0x78, 0x83, 0x36, 0x71, 0x47, 0x6d,
-	0xcf, 0x27, 0x2e, 0xdd, 0x96, 0xce, 0x46, 0xa0, 0x5b, 0xb6, 0xcd, 0xab, 0x52, 0xd1, 0x64, 0x6b,
-	0xda, 0x51, 0x47, 0x8f, 0xa6, 0xef, 0xa8, 0x59, 0xaf, 0x61, 0x47, 0x3a, 0x0f, 0x1b, 0x1f, 0xca,
-	0xb1, 0x8d, 0x07, 0xba, 0x92, 0x17, 0xc2, 0x34, 0xa8, 0xfd, 0x49, 0x1a, 0xcc, 0x26, 0xd3, 0xe0,
-	0xf7, 0x9a, 0x9c, 0xed, 0x24, 0xdf, 0x94, 0xf2, 0x5f, 0x87, 0x02, 0xcf, 0x03, 0xa2, 0x9b, 0x8b,
-	0x7f, 0x9c, 0x62, 0x77, 0xe4, 0x6c, 0xb8, 0x0d, 0xd3, 0x07, 0xee, 0x49, 0x8f, 0xd6, 0xd0, 0x51,
-	0x4a, 0xc7, 0x05, 0xcf, 0x06, 0xf8, 0x01, 0x94, 0x43, 0x56, 0x29, 0x6b, 0xdb, 0x2a, 0x5c, 0xde,
-	0x3f, 0x69, 0x74, 0xdb, 0x7e, 0xa4, 0x8d, 0x7b, 0xa5, 0x6e, 0xef, 0x00, 0x8c, 0x41, 0x2c, 0x52,
-	0x0a, 0xf6, 0x10, 0x4a, 0xbb, 0xa4, 0xdb, 0x20, 0xee, 0x63, 0xf6, 0x7e, 0x33, 0x05, 0xd9, 0xc0,
-	0x4a, 0xd9, 0xed, 0x3a, 0x0d, 0xe1, 0x47, 0x56, 0x97, 0x08, 0x2a, 0xb6, 0xa6, 0xcc, 0xde, 0x73,
-	0xfb, 0xcd, 0x43, 0x73, 0x87, 0xf9, 0xac, 0x68, 0x4a, 0x10, 0xff, 0xa0, 0x01, 0x84, 0x3e, 0xb9,
-	0xb0, 0x4f, 0xaa, 0x01, 0xb8, 0xb2, 0xd9, 0xe1, 0xef, 0x21, 0xba, 0xa9, 0x60, 0x68, 0x5c, 0xf3,
-	0xb8, 0x63, 0xdf, 0xb4, 0x6e, 0x0a, 0xe8, 0xa2, 0x19, 0x9c, 0x0a, 0xeb, 0x92, 0x96, 0x27, 0xde,
-	0x91, 0xd8, 0x9a, 0xf6, 0x06, 0xb4, 0xfe, 0x13, 0x5b, 0x8c, 0x8b, 0x05, 0xde, 0x1b, 0xa8, 0x38,
-	0xbc, 0x09, 0x10, 0x46, 0xdc, 0x85, 0xe1, 0xb2, 0x00, 0x45, 0xa9, 0x81, 0x14, 0x3a, 0x44, 0xe0,
-	0xb7, 0x61, 0x5c, 0x66, 0x07, 0x65, 0x22, 0xd0, 0x22, 0x13, 0x41, 0x15, 0xc6, 0x68, 0x1e, 0x20,
-	0x5e, 0xe0, 0x09, 0x01, 0xfe, 0xef, 0x43, 0xd0, 0xa9, 0xc7, 0x50, 0x01, 0xb2, 0x7b, 0x0f, 0xcb,
-	0x19, 0x54, 0x84, 0xfc, 0x86, 0x69, 0xee, 0x99, 0x65, 0x0d, 0x4d, 0x43, 0x69, 0xa3, 0x67, 0xef,
-	0xb5, 0xb8, 0x6d, 0xcb, 0xd9, 0x00, 0xc1, 0xc5, 0x2e, 0xe7, 0x18, 0xe2, 0x31, 0x37, 0xc3, 0x8e,
-	0xf3, 0x49, 0x59, 0x47, 0x93, 0x50, 0x7c, 0xe4, 0xf8, 0x3b, 0x1b, 0xab, 0xf5, 0x0d, 0xb3, 0x9c,
-	0x5f, 0xf9, 0x3d, 0x0f, 0x93, 0x9c, 0x7a, 0x9f, 0xb8, 0xa7, 0xed, 0x26, 0x41, 0xb7, 0xa1, 0xc0,
-	0x5f, 0x93, 0xd0, 0x0c, 0x0d, 0x96, 0xc8, 0x2b, 0x96, 0x81, 0x54, 0x14, 0x8f, 0x30, 0x9c, 0x41,
-	0xf7, 0xa1, 0xa4, 0xcc, 0xaa, 0xa8, 0xc2, 0x03, 0x35, 0x3e, 0x1f, 0x1b, 0x97, 0x12, 0xf8, 0x80,
-	0xc3, 0x1a, 0x4c, 0xef, 0x77, 0x2d, 0xd7, 0x0f, 0x5f, 0x4a, 0xd0, 0xbc, 0x3c, 0x1d, 0xe9, 0xb1,
-	0x8d, 0x4a, 0x1c, 0x1d, 0xf0, 0x78, 0x17, 0x20, 0x9c, 0x01, 0x38, 0x79, 0x62, 0x88, 0x30, 0x2a,
-	0x71, 0xb4, 0x24, 0xbf, 0xa5, 0xa1, 0xff, 0x40, 0xb6, 0xde, 0x42, 0x6c, 0x30, 0x0e, 0x06, 0x58,
-	0x63, 0x4a, 0x82, 0xc1, 0x3d, 0x3b, 0x30, 0x1d, 0x9b, 0xae, 0x90, 0xc1, 0x85, 0x1a, 0x34, 0xb2,
-	0x19, 0x57, 0x06, 0xee, 0x05, 0xdc, 0xfe, 0x0f, 0x3a, 0xeb, 0x43, 0xa7, 0x59, 0x7e, 0x0b, 0xdf,
-	0x3a, 0x8c, 0x72, 0x88, 0x08, 0x0e, 0xaf, 0xc3, 0x84, 0xfa, 0xec, 0x82, 0x2e, 0x71, 0x6d, 0x12,
-	0x6f, 0x37, 0x46, 0x35, 0xb9, 0x11, 0x30, 0xb9, 0x01, 0xc5, 0x2d, 0x62, 0xb9, 0x7e, 0x83, 0x58,
-	0x3e, 0x2a, 0xd1, 0x83, 0xe2, 0x71, 0xc8, 0x50, 0x01, 0x66, 0x11, 0xa6, 0x6a, 0x64, 0xf2, 0x90,
-	0xaa, 0x0e, 0x9a, 0x7f, 0xa4, 0xaa, 0x03, 0x47, 0x15, 0x9c, 0x41, 0xf7, 0x00, 0xd2, 0xf8, 0xf7,
-	0x3e, 0x94, 0x94, 0x16, 0x9b, 0x47, 0x59, 0x72, 0x20, 0xe0, 0x51, 0x36, 0xa0, 0x17, 0xc7, 0x99,
-	0x95, 0x1f, 0x75, 0x98, 0xe3, 0x5f, 0xc6, 0xae, 0xd5, 0xb3, 0x8e, 0x88, 0x2b, 0x63, 0xfe, 0x5e,
-	0xe4, 0x43, 0x9f, 0x8f, 0xb7, 0x99, 0x8a, 0x64, 0xc9, 0xee, 0x93, 0x2b, 0xa6, 0x64, 0xb7, 0xf9,
-	0x78, 0x43, 0xa5, 0x90, 0x27, 0xfb, 0x2c, 0x9c, 0x41, 0x77, 0xe9, 0x27, 0x29, 0x9a, 0x12, 0x34,
-	0x17, 0xeb, 0x51, 0x38, 0xf1, 0xfc, 0xc0, 0xce, 0x05, 0x67, 0xd0, 0x21, 0xa0, 0x64, 0xf2, 0x47,
-	0x57, 0x99, 0xa8, 0xc3, 0xea, 0x8a, 0x51, 0x1b, 0xb6, 0x1d, 0xb0, 0x35, 0xe5, 0xec, 0xa0, 0x5a,
-	0x7c, 0x21, 0x34, 0xc0, 0x00, 0xbb, 0x5f, 0x1d, 0xb2, 0x1b, 0x09, 0x5e, 0xa5, 0x43, 0x10, 0xc1,
-	0x9b, 0xec, 0x45, 0x44, 0xf0, 0x0e, 0x68, 0x26, 0x38, 0x13, 0xb5, 0x4d, 0x42, 0x22, 0xa7, 0x24,
-	0x7a, 0x2c, 0xce, 0x64, 0x50, 0x47, 0x85, 0x33, 0xe8, 0x4d, 0x18, 0x97, 0x05, 0x1c, 0xcd, 0xd2,
-	0x73, 0xb1, 0xce, 0xc0, 0x98, 0x8b, 0x22, 0x25, 0xe1, 0x5a, 0xf5, 0xa7, 0xf3, 0x9a, 0xf6, 0xe2,
-	0xbc, 0xa6, 0xfd, 0x76, 0x5e, 0xd3, 0xbe, 0x79, 0x59, 0xcb, 0xbc, 0x78, 0x59, 0xcb, 0xfc, 0xfa,
-	0xb2, 0x96, 0x69, 0x14, 0xd8, 0xff, 0x22, 0x77, 0xfe, 0x08, 0x00, 0x00, 0xff, 0xff, 0x54, 0x68,
-	0xeb, 0x8a, 0x3d, 0x19, 0x00, 0x00,
+	// 1907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x19, 0x5d, 0x6f, 0xe3, 0x58,
+	0x35, 0x4e, 0x9c, 0xb4, 0x39, 0xe9, 0x47, 0x7a, 0x9b, 0x49, 0xbd, 0x9e, 0x4e, 0x54, 0x2e, 0xc3,
+	0x30, 0xcb, 0xc7, 0xec, 0xb4, 0x2b, 0x01, 0x5a, 0x31, 0xb0, 0x6d, 0x93, 0xd2, 0xee, 0xf6, 0x63,
+	0x70, 0xda, 0x7d, 0xc6, 0x89, 0x6f, 0x3a, 0x9e, 0x26, 0x76, 0xb0, 0xdd, 0xb2, 0x45, 0x5a, 0x24,
+	0x58, 0x21, 0x21, 0x9e, 0x78, 0x43, 0xe2, 0x81, 0xbf, 0xc0, 0x0b, 0x3f, 0x02, 0x89, 0x97, 0x7d,
+	0x83, 0x47, 0xd4, 0xf9, 0x23, 0xe8, 0x7e, 0xd9, 0xd7, 0x71, 0x52, 0x32, 0x78, 0xb4, 0x4f, 0xf1,
+	0x39, 0xc7, 0xe7, 0xdc, 0xf3, 0xe5, 0xf3, 0x71, 0x03, 0x8b, 0xe3, 0xde, 0xb3, 0x71, 0xe0, 0x47,
+	0x3e, 0x2a, 0x8e, 0x7b, 0x66, 0xe3, 0xd2, 0xbf, 0xf4, 0x19, 0xf8, 0x01, 0x7d, 0xe2, 0x14, 0xfc,
+	0x05, 0x94, 0x3b, 0x5e, 0x14, 0xdc, 0xa2, 0x3a, 0x94, 0xae, 0xc8, 0xad, 0xa1, 0x6d, 0x69, 0x4f,
+	0x97, 0x2c, 0xfa, 0x88, 0x1a, 0x50, 0xbe, 0xb1, 0x87, 0xd7, 0xc4, 0x28, 0x32, 0x1c, 0x07, 0x10,
+	0x02, 0x7d, 0x44, 0x22, 0xdb, 0x28, 0x6d, 0x69, 0x4f, 0x97, 0x2d, 0xf6, 0x8c, 0x4c, 0x58, 0xbc,
+	0x08, 0x49, 0x70, 0x42, 0xf1, 0x3a, 0xc3, 0xc7, 0x30, 0xda, 0x84, 0x6a, 0xe7, 0xf3, 0xb1, 0x1b,
+	0x90, 0x70, 0x37, 0x32, 0xca, 0x5b, 0xda, 0x53, 0xdd, 0x4a, 0x10, 0xf8, 0x77, 0x1a, 0x54, 0xd9,
+	0xf9, 0x47, 0xde, 0xc0, 0x47, 0x0f, 0xa1, 0x34, 0xf4, 0x2f, 0x99, 0x0e, 0xb5, 0x9d, 0xea, 0xb3,
+	0x71, 0xef, 0x19, 0xa3, 0x59, 0x14, 0x4b, 0x0f, 0x21, 0x9f, 0x47, 0xc4, 0x8b, 0x8e, 0xda, 0x4c,
+	0x23, 0xdd, 0x8a, 0x61, 0xd4, 0x84, 0x8a, 0x3f, 0x18, 0x84, 0x24, 0x12, 0x6a, 0x09, 0x08, 0x3d,
+	0x86, 0x65, 0x12, 0x46, 0xee, 0xc8, 0x8e, 0x88, 0xd3, 0x75, 0x7f, 0x4d, 0x98, 0x76, 0xba, 0x95,
+	0x46, 0xe2, 0x87, 0x50, 0xde, 0x1b, 0xfa, 0xfd, 0x2b, 0x6a, 0x9b, 0x63, 0x47, 0xb6, 0x70, 0x02,
+	0x7b, 0xc6, 0xaf, 0x61, 0x79, 0x77, 0x3c, 0x26, 0x9e, 0x63, 0x91, 0x5f, 0x5e, 0x93, 0x30, 0x4a,
+	0xe9, 0xa1, 0x4d, 0xe8, 0xf1, 0x0d, 0xa8, 0xf4, 0xa8, 0xa4, 0xd0, 0x28, 0x6e, 0x95, 0xa4, 0x0d,
+	0x4c, 0xb6, 0x25, 0x08, 0x8c, 0xfd, 0x86, 0x04, 0xa1, 0xeb, 0x7b, 0x46, 0x49, 0xb0, 0x0b, 0x18,
+	0x47, 0xb0, 0x22, 0xcf, 0x0a, 0xc7, 0xbe, 0x17, 0x12, 0xb4, 0x09, 0x7a, 0xdf, 0x77, 0x08, 0x3b,
+	0x68, 0x65, 0x67, 0x91, 0x8a, 0xdb, 0xf7, 0x1d, 0x62, 0x31, 0x2c, 0x32, 0x60, 0x81, 0xfe, 0xb6,
+	0x49, 0xc8, 0x3c, 0x52, 0xb5, 0x24, 0x48, 0x29, 0xdc, 0x05, 0xa1, 0x51, 0xda, 0x2a, 0x3d, 0x5d,
+	0xb6, 0x24, 0x48, 0xe3, 0x4c, 0x3c, 0x47, 0x84, 0x89, 0x3e, 0xe2, 0x6d, 0x58, 0xdf, 0x0f, 0x88,
+	0x1d, 0x91, 0x0e, 0x33, 0x43, 0xb1, 0x33, 0x8c, 0x02, 0x62, 0x8f, 0x12, 0x3b, 0x25, 0x8c, 0x5f,
+	0x43, 0x23, 0xcd, 0x92, 0x53, 0x5d, 0xd5, 0xa7, 0xa5, 0xb4, 0x4f, 0xf1, 0xef, 0x35, 0x58, 0xb3,
+	0x88, 0xed, 0x30, 0x37, 0x86, 0xf3, 0x44, 0x21, 0xc9, 0x86, 0x62, 0x2a, 0x1b, 0xb6, 0xa0, 0xe6,
+	0x5d, 0x8f, 0xce, 0x06, 0x5c, 0x92, 0x48, 0x15, 0x15, 0x95, 0x0a, 0x8e, 0x3e, 0x11, 0x9c, 0xdf,
+	0x6a, 0x80, 0x54, 0x3d, 0x72, 0x9a, 0x9c, 0xa4, 0x4a, 0x69, 0x56, 0xaa, 0x64, 0x43, 0xf5, 0x08,
+	0x16, 0x5e, 0xda, 0xb7, 0x43, 0xdf, 0x76, 0x68, 0xae, 0xb6, 0x95, 0x5c, 0xa5, 0xcf, 0x2c, 0x92,
+	0xfe, 0x68, 0xe4, 0x46, 0xc7, 0xc4, 0xbb, 0x8c, 0x5e, 0xcd, 0xe1, 0x2b, 0x3c, 0x80, 0x46, 0x9a,
+	0x25, 0xa7, 0x59, 0x4d, 0xa8, 0x0c, 0x99, 0x24, 0xf9, 0x25, 0x72, 0x08, 0x9f, 0x40, 0xad, 0x4b,
+	0xec, 0xe1, 0x3c, 0xe1, 0xc3, 0xb0, 0xd4, 0x57, 0x54, 0x12, 0x41, 0x4c, 0xe1, 0xf0, 0x01, 0x2c,
+	0x71, 0x71, 0xf9, 0xd4, 0xc5, 0xbf, 0xe0, 0x31, 0xa5, 0x65, 0xc6, 0x25, 0xb9, 0x92, 0xab, 0x09,
+	0x95, 0x80, 0x8c, 0x87, 0xf6, 0xad, 0x34, 0x9c, 0x43, 0xf8, 0x0f, 0x1a, 0xac, 0xa7, 0x8e, 0xc8,
+	0xe9, 0xe0, 0x6f, 0xc3, 0x02, 0xe1, 0xa2, 0x44, 0xe2, 0x2c, 0xc7, 0x75, 0x92, 0xd6, 0x50, 0x4b,
+	0x52, 0xa7, 0x64, 0xcf, 0x33, 0x28, 0xb6, 0x0f, 0x68, 0x59, 0x8f, 0xfc, 0xc8, 0x1e, 0x0a, 0xcb,
+	0x38, 0x40, 0xd3, 0x69, 0x10, 0x10, 0x22, 0x2a, 0x2b, 0x7b, 0xc6, 0x1f, 0x42, 0xb5, 0x3d, 0x90,
+	0x3e, 0x79, 0x02, 0xe5, 0xc8, 0x0e, 0xaf, 0x42, 0x43, 0x63, 0xa7, 0xd6, 0xe9, 0xa9, 0x16, 0xe9,
+	0xfb, 0x37, 0x24, 0xb8, 0x3d, 0xb7, 0xc3, 0x2b, 0x8b, 0x93, 0xf1, 0x1f, 0x35, 0x80, 0xf6, 0x20,
+	0xb7, 0x99, 0x4d, 0x28, 0x3a, 0x03, 0xe6, 0xca, 0xda, 0x4e, 0x85, 0x72, 0xb5, 0x0f, 0xac, 0xa2,
+	0x33, 0x40, 0xdf, 0x83, 0x45, 0xc7, 0xf7, 0x08, 0x3d, 0xd1, 0xd0, 0x67, 0x68, 0x12, 0xbf, 0x81,
+	0x7f, 0x03, 0x4b, 0x2a, 0xe5, 0xde, 0xc0, 0x6e, 0x42, 0x95, 0x85, 0xac, 0x4f, 0xe2, 0x06, 0x93,
+	0x20, 0x68, 0x78, 0x3d, 0xdf, 0x21, 0x71, 0x7d, 0x12, 0x10, 0xe5, 0x0a, 0x23, 0x3b, 0x88, 0xce,
+	0xdd, 0x11, 0xef, 0x2e, 0x25, 0x2b, 0x41, 0xe0, 0x9f, 0x40, 0x93, 0xfa, 0xcf, 0x0d, 0x88, 0x54,
+	0x43, 0xba, 0xf3, 0x31, 0xe8, 0xd4, 0x5f, 0xa2, 0xd7, 0x65, 0x6d, 0x60, 0x54, 0xfc, 0x73, 0xd8,
+	0xc8, 0xf0, 0xe7, 0xcc, 0xf8, 0x21, 0xa0, 0x7d, 0x7f, 0x1c, 0xcb, 0x39, 0x24, 0xb6, 0x43, 0x82,
+	0xff, 0x3b, 0x4c, 0x2d, 0x80, 0x31, 0x2f, 0x48, 0xc7, 0x44, 0xf6, 0x33, 0x05, 0x83, 0x3f, 0x80,
+	0x35, 0x7a, 0x5a, 0xa6, 0xb3, 0xcc, 0xac, 0x47, 0xaf, 0x01, 0xa9, 0x0c, 0xc2, 0xd8, 0xe7, 0x50,
+	0x79, 0xc5, 0x14, 0x15, 0xfe, 0x6a, 0x72, 0x05, 0x27, 0xcd, 0x38, 0x2c, 0x58, 0xe2, 0x3d, 0x64,
+	0xc2, 0x82, 0x50, 0x83, 0x8f, 0x2f, 0x87, 0x05, 0x4b, 0x22, 0xf6, 0x2a, 0xbc, 0xcd, 0x63, 0x9f,
+	0x46, 0x67, 0x3c, 0x74, 0xfb, 0x76, 0x44, 0xde, 0xaa, 0xbb, 0xf0, 0x52, 0x24, 0x0b, 0x00, 0x87,
+	0xe6, 0x28, 0xe8, 0xf8, 0x0b, 0xd8, 0xc8, 0x1c, 0xf8, 0x35, 0x36, 0xfa, 0xe7, 0x80, 0x76, 0x87,
+	0x43, 0xbf, 0x3f, 0x7f, 0x34, 0x4e, 0x60, 0x3d, 0xc5, 0x91, 0x33, 0xf7, 0xb6, 0x61, 0xbd, 0x4d,
+	0x86, 0x64, 0xca, 0xa4, 0x31, 0x53, 0x83, 0x53, 0x68, 0xa4, 0x59, 0x72, 0xaa, 0xf0, 0x17, 0x0d,
+	0x8c, 0x2e, 0x1b, 0x63, 0xa6, 0xbb, 0x62, 0xd6, 0xc8, 0x43, 0xbb, 0x12, 0x57, 0xea, 0xdc, 0xa7,
+	0x9d, 0x47, 0x54, 0x88, 0x14, 0x8e, 0x16, 0x03, 0x9a, 0x58, 0xdd, 0x57, 0x76, 0xe0, 0x88, 0x36,
+	0x90, 0x20, 0xe8, 0xf8, 0x31, 0xb6, 0x03, 0x37, 0xba, 0xe5, 0x74, 0x1e, 0x18, 0x15, 0x85, 0xff,
+	0xac, 0xc1, 0x7b, 0x53, 0x94, 0xcb, 0x3f, 0x5c, 0xc5, 0x56, 0x95, 0x26, 0xac, 0x7a, 0x02, 0x15,
+	0x6e, 0x01, 0x53, 0xa7, 0xb6, 0xb3, 0xc2, 0x9a, 0x09, 0x77, 0x3e, 0xed, 0x26, 0x82, 0x8a, 0xb7,
+	0x61, 0x8d, 0x2b, 0xc6, 0xb0, 0xc2, 0x5d, 0xac, 0xf6, 0x71, 0x41, 0xbc, 0x2d, 0xe8, 0x56, 0x82,
+	0xc0, 0x77, 0x45, 0x40, 0x2a, 0x4f, 0x4e, 0x2b, 0x5e, 0xc0, 0x02, 0x97, 0x2d, 0xbf, 0xaf, 0x6f,
+	0x52, 0xd6, 0xec, 0x01, 0x02, 0x15, 0xf2, 0xcd, 0x41, 0xf2, 0x50, 0x76, 0x6e, 0x4a, 0x68, 0xe8,
+	0xf7, 0xb2, 0x73, 0xe3, 0x25, 0xbb, 0xe0, 0x31, 0x3f, 0x81, 0x25, 0x55, 0xae, 0xba, 0x2d, 0xe9,
+	0x7c, 0x5b, 0x7a, 0xac, 0x6e, 0x4b, 0xc2, 0x91, 0x8a, 0x78, 0x4e, 0xfc, 0xa8, 0xf8, 0x23, 0x8d,
+	0xca, 0x52, 0x0f, 0x99, 0x53, 0x96, 0x12, 0x94, 0x44, 0x16, 0xfe, 0x3e, 0xac, 0x29, 0x04, 0x11,
+	0x17, 0x23, 0xb1, 0x95, 0x47, 0x45, 0x82, 0xf8, 0x5f, 0x1a, 0x20, 0xf5, 0xfd, 0xfc, 0x31, 0x91,
+	0x07, 0x29, 0x31, 0xc9, 0x1e, 0x30, 0xdb, 0xa9, 0xef, 0xcc, 0x11, 0x08, 0xea, 0xa7, 0xbe, 0x43,
+	0x42, 0xc5, 0x0f, 0xf8, 0x9f, 0x1a, 0xac, 0x29, 0xc8, 0x9c, 0xc6, 0xfe, 0x00, 0xca, 0xb4, 0xe7,
+	0x4b, 0x53, 0xb7, 0x28, 0x63, 0x46, 0x3a, 0xc7, 0x70, 0x3b, 0xf9, 0xeb, 0xe6, 0x01, 0x40, 0x82,
+	0x9c, 0x62, 0x23, 0x4e, 0xdb, 0xb8, 0x24, 0xe5, 0x4e, 0x5a, 0xf8, 0x3e, 0x9d, 0x23, 0x2f, 0xdd,
+	0x30, 0x22, 0x01, 0x25, 0xcb, 0x60, 0x23, 0xd0, 0x6d, 0xc7, 0xe1, 0x8d, 0xb1, 0x6a, 0xb1, 0x67,
+	0x3a, 0xd4, 0xa7, 0x5f, 0xcd, 0x3f, 0xd4, 0xb3, 0x71, 0xc7, 0x49, 0x0d, 0x3f, 0x0e, 0xbe, 0x90,
+	0x9b, 0x23, 0x4f, 0x74, 0xa5, 0x2e, 0x24, 0x65, 0x50, 0xfb, 0x1f, 0x65, 0xb0, 0x98, 0x2d, 0x83,
+	0x7f, 0xd5, 0xa0, 0x91, 0x96, 0x9b, 0x53, 0xff, 0x27, 0x50, 0xe1, 0x75, 0xc0, 0x28, 0x25, 0x79,
+	0xa4, 0x7c, 0x9c, 0x82, 0x3a, 0x77, 0x35, 0x3c, 0x82, 0xd5, 0xf3, 0xe0, 0xda, 0xa3, 0x6d, 0x7c,
+	0x9e, 0xd6, 0x71, 0xcf, 0xcd, 0x05, 0xfe, 0x04, 0xea, 0x89, 0xa8, 0x77, 0xd5, 0x5e, 0xd3, 0xe1,
+	0xb8, 0x6f, 0x91, 0x8f, 0xdb, 0xeb, 0xbb, 0xf1, 0x34, 0xde, 0x85, 0xf7, 0xba, 0xd7, 0xbd, 0x91,
+	0x1b, 0xa5, 0x86, 0xd9, 0xb7, 0x9a, 0x79, 0xcf, 0xc1, 0x9c, 0x26, 0x22, 0xa7, 0x62, 0x9f, 0x42,
+	0xed, 0x84, 0x8c, 0x7a, 0x24, 0xf8, 0x8c, 0xdd, 0x62, 0xad, 0x40, 0x31, 0xf6, 0x46, 0xf1, 0xa8,
+	0x4d, 0xbf, 0xa2, 0x53, 0x7b, 0x44, 0x04, 0x17, 0x7b, 0xa6, 0xc2, 0x7e, 0x16, 0x8c, 0xfb, 0x17,
+	0xd6, 0x31, 0x4b, 0x9b, 0xaa, 0x25, 0x41, 0xfc, 0x77, 0x0d, 0x20, 0x49, 0x8b, 0x7b, 0xa7, 0xc5,
+	0x16, 0x40, 0x20, 0x47, 0x3e, 0x7e, 0x2b, 0xa4, 0x5b, 0x0a, 0x86, 0x7e, 0x5a, 0x3c, 0xf5, 0x59,
+	0x59, 0xd1, 0x2d, 0x01, 0xdd, 0x77, 0x13, 0x41, 0x95, 0x0d, 0xc8, 0x20, 0x14, 0xb7, 0x69, 0xec,
+	0x99, 0x8e, 0x27, 0x74, 0x04, 0x21, 0x8e, 0x58, 0x9a, 0x2b, 0x7c, 0x3c, 0x51, 0x71, 0xf8, 0x00,
+	0x20, 0x49, 0xfa, 0x7b, 0x33, 0x76, 0x13, 0xaa, 0xd2, 0x02, 0xa9, 0x74, 0x82, 0xc0, 0x3f, 0x86,
+	0x45, 0x59, 0xa0, 0x94, 0xbd, 0x48, 0x4b, 0xed, 0x45, 0x06, 0x2c, 0xd0, 0x52, 0x44, 0xc2, 0x38,
+	0x12, 0x02, 0xfc, 0xce, 0x97, 0x1a, 0xe8, 0x34, 0x64, 0xa8, 0x02, 0xc5, 0xb3, 0x4f, 0xeb, 0x05,
+	0x54, 0x85, 0x72, 0xc7, 0xb2, 0xce, 0xac, 0xba, 0x86, 0x56, 0xa1, 0xd6, 0xf1, 0x9c, 0xb3, 0x01,
+	0x77, 0x6e, 0xbd, 0x18, 0x23, 0xb8, 0xde, 0xf5, 0x12, 0x43, 0x7c, 0xc6, 0xfd, 0x70, 0xec, 0xff,
+	0xaa, 0xae, 0xa3, 0x65, 0xa8, 0x9e, 0xfa, 0xd1, 0x71, 0x67, 0xb7, 0xdd, 0xb1, 0xea, 0x65, 0xd4,
+	0x04, 0xf4, 0x32, 0x20, 0x7d, 0xdf, 0x73, 0xdc, 0xc8, 0xf5, 0xbd, 0x03, 0xdb, 0x1d, 0x12, 0xa7,
+	0x5e, 0x41, 0x2b, 0x00, 0x9d, 0x97, 0x5d, 0xc1, 0x59, 0x5f, 0xd8, 0xf9, 0x5b, 0x05, 0x96, 0xf9,
+	0x29, 0x5d, 0x12, 0xdc, 0xb8, 0x7d, 0x82, 0xb6, 0xa1, 0xc2, 0x2f, 0xdf, 0xd0, 0x1a, 0xcd, 0xaa,
+	0xd4, 0xa5, 0x9f, 0x89, 0x54, 0x14, 0x4f, 0x45, 0x5c, 0x40, 0x1f, 0x43, 0x4d, 0x59, 0xed, 0x51,
+	0x93, 0x67, 0xf4, 0xe4, 0x75, 0x82, 0xb9, 0x91, 0xc1, 0xc7, 0x12, 0xf6, 0x60, 0xb5, 0x3b, 0xb2,
+	0x83, 0x28, 0xb9, 0x58, 0x42, 0x0f, 0xe4, 0xdb, 0xa9, 0x95, 0xc4, 0x6c, 0x4e, 0xa2, 0x63, 0x19,
+	0x3f, 0x05, 0x48, 0x56, 0x26, 0xce, 0x9e, 0xd9, 0xb9, 0xcc, 0xe6, 0x24, 0x5a, 0xb2, 0x3f, 0xd7,
+	0xd0, 0xb7, 0xa0, 0xd8, 0x1e, 0x20, 0x76, 0x8f, 0x10, 0xef, 0xfb, 0xe6, 0x8a, 0x04, 0xe3, 0x73,
+	0x8e, 0x61, 0x75, 0x62, 0x19, 0x45, 0x26, 0x57, 0x6a, 0xda, 0x86, 0x6b, 0x3e, 0x9c, 0x4a, 0x8b,
+	0xa5, 0x7d, 0x17, 0x74, 0x36, 0x33, 0xaf, 0xb2, 0x5a, 0x9c, 0x5c, 0x0d, 0x99, 0xf5, 0x04, 0x11,
+	0xbf, 0xbc, 0x0f, 0x4b, 0xea, 0x2d, 0x15, 0xda, 0xe0, 0xd6, 0x64, 0xae, 0xba, 0x4c, 0x23, 0x4b,
+	0x88, 0x85, 0xbc, 0x0f, 0xd5, 0x43, 0x62, 0x07, 0x51, 0x8f, 0xd8, 0x11, 0xaa, 0xd1, 0x17, 0xc5,
+	0x5d, 0x9a, 0xa9, 0x02, 0xcc, 0x23, 0xcc, 0xd4, 0xd4, 0xa2, 0x26, 0x4d, 0x9d, 0xb6, 0x2e, 0x9a,
+	0x0f, 0xa7, 0xd2, 0xe2, 0x83, 0x5f, 0x00, 0xe4, 0x89, 0xef, 0xc7, 0x50, 0x53, 0xd6, 0x01, 0x9e,
+	0x65, 0xd9, 0xe5, 0xc5, 0xdc, 0xc8, 0xe0, 0x55, 0xf7, 0xa9, 0x4b, 0x14, 0x77, 0xdf, 0x94, 0x4d,
+	0xcc, 0x34, 0xb2, 0x04, 0x29, 0x64, 0xe7, 0xcb, 0x32, 0x34, 0xf8, 0x67, 0x78, 0x62, 0x7b, 0xf6,
+	0x25, 0x09, 0xe4, 0x87, 0xf3, 0x22, 0x55, 0x56, 0x1e, 0x4c, 0xce, 0xd5, 0x8a, 0x79, 0xd9, 0x71,
+	0x9b, 0x7b, 0x47, 0xa9, 0xa5, 0x0f, 0x26, 0x27, 0x48, 0x85, 0x3d, 0x3b, 0x58, 0xe2, 0x02, 0xfa,
+	0x88, 0x7e, 0xff, 0x62, 0x0a, 0x43, 0x8d, 0x89, 0xa1, 0x8c, 0x33, 0x3f, 0x98, 0x3a, 0xaa, 0xe1,
+	0x02, 0xba, 0x00, 0x94, 0x6d, 0x35, 0xe8, 0x11, 0x53, 0x75, 0x56, 0x17, 0x33, 0x5b, 0xb3, 0xc8,
+	0xb1, 0x58, 0x4b, 0x2e, 0x4b, 0x6a, 0xd8, 0x36, 0x13, 0x07, 0x4c, 0x09, 0xde, 0xa3, 0x19, 0xd4,
+	0xd4, 0x17, 0xa0, 0x8c, 0x44, 0xe2, 0x0b, 0xc8, 0x0e, 0x5f, 0xa6, 0x91, 0x25, 0xa8, 0x42, 0xd4,
+	0xb9, 0x10, 0x89, 0xc2, 0x94, 0x19, 0x2a, 0x4d, 0x23, 0x4b, 0x88, 0x85, 0xfc, 0x10, 0x16, 0xe5,
+	0xc4, 0x82, 0xd6, 0xe9, 0x7b, 0x13, 0xa3, 0x90, 0xd9, 0x48, 0x23, 0xb3, 0x59, 0xa8, 0x9a, 0x30,
+	0x65, 0x60, 0x31, 0x8d, 0x2c, 0x41, 0x0a, 0xd9, 0x33, 0xfe, 0x71, 0xd7, 0xd2, 0xbe, 0xba, 0x6b,
+	0x69, 0xff, 0xb9, 0x6b, 0x69, 0x7f, 0x7a, 0xd3, 0x2a, 0x7c, 0xf5, 0xa6, 0x55, 0xf8, 0xf7, 0x9b,
+	0x56, 0xa1, 0x57, 0x61, 0x7f, 0x68, 0x7d, 0xf8, 0xdf, 0x01, 0x00, 0xd8, 0x5c, 0x6e, 0x0b, 0xf6,
+	0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3068,6 +3276,7 @@ type ExtentServiceClient interface {
 	ReplicateBlocks(ctx context.Context, in *ReplicateBlocksRequest, opts ...grpc.CallOption) (*ReplicateBlocksResponse, error)
 	ReadBlocks(ctx context.Context, in *ReadBlocksRequest, opts ...grpc.CallOption) (*ReadBlocksResponse, error)
 	AllocExtent(ctx context.Context, in *AllocExtentRequest, opts ...grpc.CallOption) (*AllocExtentResponse, error)
+	DeleteExtent(ctx context.Context, in *DeleteExtentRequest, opts ...grpc.CallOption) (*DeleteExtentResponse, error)
 }
 
 type extentServiceClient struct {
@@ -3232,6 +3441,15 @@ func (c *extentServiceClient) AllocExtent(ctx context.Context, in *AllocExtentRe
 	return out, nil
 }
 
+func (c *extentServiceClient) DeleteExtent(ctx context.Context, in *DeleteExtentRequest, opts ...grpc.CallOption) (*DeleteExtentResponse, error) {
+	out := new(DeleteExtentResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExtentService/DeleteExtent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExtentServiceServer is the server API for ExtentService service.
 type ExtentServiceServer interface {
 	//from stream client
@@ -3248,6 +3466,7 @@ type ExtentServiceServer interface {
 	ReplicateBlocks(context.Context, *ReplicateBlocksRequest) (*ReplicateBlocksResponse, error)
 	ReadBlocks(context.Context, *ReadBlocksRequest) (*ReadBlocksResponse, error)
 	AllocExtent(context.Context, *AllocExtentRequest) (*AllocExtentResponse, error)
+	DeleteExtent(context.Context, *DeleteExtentRequest) (*DeleteExtentResponse, error)
 }
 
 // UnimplementedExtentServiceServer can be embedded to have forward compatible implementations.
@@ -3290,6 +3509,9 @@ func (*UnimplementedExtentServiceServer) ReadBlocks(ctx context.Context, req *Re
 func (*UnimplementedExtentServiceServer) AllocExtent(ctx context.Context, req *AllocExtentRequest) (*AllocExtentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllocExtent not implemented")
 }
+func (*UnimplementedExtentServiceServer) DeleteExtent(ctx context.Context, req *DeleteExtentRequest) (*DeleteExtentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExtent not implemented")
+}
 
 func RegisterExtentServiceServer(s *grpc.Server, srv ExtentServiceServer) {
 	s.RegisterService(&_ExtentService_serviceDesc, srv)
@@ -3517,6 +3739,24 @@ func _ExtentService_AllocExtent_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExtentService_DeleteExtent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExtentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtentServiceServer).DeleteExtent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExtentService/DeleteExtent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtentServiceServer).DeleteExtent(ctx, req.(*DeleteExtentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ExtentService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.ExtentService",
 	HandlerType: (*ExtentServiceServer)(nil),
@@ -3561,6 +3801,10 @@ var _ExtentService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllocExtent",
 			Handler:    _ExtentService_AllocExtent_Handler,
 		},
+		{
+			MethodName: "DeleteExtent",
+			Handler:    _ExtentService_DeleteExtent_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3589,6 +3833,7 @@ type StreamManagerServiceClient interface {
 	CreateStream(ctx context.Context, in *CreateStreamRequest, opts ...grpc.CallOption) (*CreateStreamResponse, error)
 	RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (*RegisterNodeResponse, error)
 	Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResponse, error)
+	DeleteStream(ctx context.Context, in *DeleteStreamRequest, opts ...grpc.CallOption) (*DeleteStreamResponse, error)
 }
 
 type streamManagerServiceClient struct {
@@ -3671,6 +3916,15 @@ func (c *streamManagerServiceClient) Truncate(ctx context.Context, in *TruncateR
 	return out, nil
 }
 
+func (c *streamManagerServiceClient) DeleteStream(ctx context.Context, in *DeleteStreamRequest, opts ...grpc.CallOption) (*DeleteStreamResponse, error) {
+	out := new(DeleteStreamResponse)
+	err := c.cc.Invoke(ctx, "/pb.StreamManagerService/DeleteStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StreamManagerServiceServer is the server API for StreamManagerService service.
 type StreamManagerServiceServer interface {
 	StreamInfo(context.Context, *StreamInfoRequest) (*StreamInfoResponse, error)
@@ -3681,6 +3935,7 @@ type StreamManagerServiceServer interface {
 	CreateStream(context.Context, *CreateStreamRequest) (*CreateStreamResponse, error)
 	RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error)
 	Truncate(context.Context, *TruncateRequest) (*TruncateResponse, error)
+	DeleteStream(context.Context, *DeleteStreamRequest) (*DeleteStreamResponse, error)
 }
 
 // UnimplementedStreamManagerServiceServer can be embedded to have forward compatible implementations.
@@ -3711,6 +3966,9 @@ func (*UnimplementedStreamManagerServiceServer) RegisterNode(ctx context.Context
 func (*UnimplementedStreamManagerServiceServer) Truncate(ctx context.Context, req *TruncateRequest) (*TruncateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Truncate not implemented")
 }
+func (*UnimplementedStreamManagerServiceServer) DeleteStream(ctx context.Context, req *DeleteStreamRequest) (*DeleteStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStream not implemented")
+}
 
 func RegisterStreamManagerServiceServer(s *grpc.Server, srv StreamManagerServiceServer) {
 	s.RegisterService(&_StreamManagerService_serviceDesc, srv)
@@ -3860,6 +4118,24 @@ func _StreamManagerService_Truncate_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreamManagerService_DeleteStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamManagerServiceServer).DeleteStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StreamManagerService/DeleteStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamManagerServiceServer).DeleteStream(ctx, req.(*DeleteStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _StreamManagerService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.StreamManagerService",
 	HandlerType: (*StreamManagerServiceServer)(nil),
@@ -3896,6 +4172,10 @@ var _StreamManagerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Truncate",
 			Handler:    _StreamManagerService_Truncate_Handler,
 		},
+		{
+			MethodName: "DeleteStream",
+			Handler:    _StreamManagerService_DeleteStream_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pb.proto",
@@ -5110,7 +5390,7 @@ func (m *AllocExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StreamAllocExtentRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteExtentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5120,40 +5400,25 @@ func (m *StreamAllocExtentRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StreamAllocExtentRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteExtentRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamAllocExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ParityShard != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ParityShard))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.DataShard != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DataShard))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ExtentToSeal != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExtentToSeal))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.StreamID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+	if m.ExtentID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StreamAllocExtentResponse) Marshal() (dAtA []byte, err error) {
+func (m *DeleteExtentResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5163,33 +5428,16 @@ func (m *StreamAllocExtentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StreamAllocExtentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteExtentResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamAllocExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Extent != nil {
-		{
-			size, err := m.Extent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.StreamID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
-		i--
-		dAtA[i] = 0x18
-	}
 	if len(m.CodeDes) > 0 {
 		i -= len(m.CodeDes)
 		copy(dAtA[i:], m.CodeDes)
@@ -5205,7 +5453,7 @@ func (m *StreamAllocExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *StreamInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *StreamAllocExtentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5215,7 +5463,102 @@ func (m *StreamInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StreamInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StreamAllocExtentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamAllocExtentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParityShard != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ParityShard))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DataShard != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DataShard))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExtentToSeal != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExtentToSeal))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamAllocExtentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamAllocExtentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamAllocExtentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Extent != nil {
+		{
+			size, err := m.Extent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamInfoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -5741,6 +6084,69 @@ func (m *TruncateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeleteStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SubmitRecoveryTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6575,6 +6981,34 @@ func (m *AllocExtentResponse) Size() (n int) {
 	return n
 }
 
+func (m *DeleteExtentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPb(uint64(m.ExtentID))
+	}
+	return n
+}
+
+func (m *DeleteExtentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
 func (m *StreamAllocExtentRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6862,6 +7296,34 @@ func (m *TruncateResponse) Size() (n int) {
 	return n
 }
 
+func (m *DeleteStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		n += 1 + sovPb(uint64(m.StreamID))
+	}
+	return n
+}
+
+func (m *DeleteStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
 func (m *SubmitRecoveryTaskRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10437,7 +10899,7 @@ func (m *AllocExtentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteExtentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10460,17 +10922,17 @@ func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamAllocExtentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteExtentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamAllocExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
 			}
-			m.StreamID = 0
+			m.ExtentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -10480,48 +10942,224 @@ func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StreamID |= uint64(b&0x7F) << shift
+				m.ExtentID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentToSeal", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ExtentToSeal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExtentToSeal |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataShard", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.DataShard = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataShard |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteExtentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteExtentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteExtentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamAllocExtentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamAllocExtentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamAllocExtentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+			}
+			m.StreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentToSeal", wireType)
+			}
+			m.ExtentToSeal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentToSeal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataShard", wireType)
+			}
+			m.DataShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
 		case 4:
 			if wireType != 0 {
@@ -12478,6 +13116,182 @@ func (m *TruncateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeleteStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+			}
+			m.StreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SubmitRecoveryTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ package pspb
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
 	pb "github.com/journeymidnight/autumn/proto/pb"
@@ -52,6 +53,70 @@ func (RawBlockType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{0}
 }
 
+//compression of data blocks, chosen per partition
+type CompressionType int32
+
+const (
+	CompressionType_none   CompressionType = 0
+	CompressionType_snappy CompressionType = 1
+	CompressionType_zstd   CompressionType = 2
+)
+
+var CompressionType_name = map[int32]string{
+	0: "none",
+	1: "snappy",
+	2: "zstd",
+}
+
+var CompressionType_value = map[string]int32{
+	"none":   0,
+	"snappy": 1,
+	"zstd":   2,
+}
+
+func (x CompressionType) String() string {
+	return proto.EnumName(CompressionType_name, int32(x))
+}
+
+func (CompressionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{1}
+}
+
+//condition of CondPut/CondDelete, checked against the latest version of the key
+type CondType int32
+
+const (
+	CondType_always       CondType = 0
+	CondType_versionEqual CondType = 1
+	CondType_valueEqual   CondType = 2
+	CondType_absent       CondType = 3
+	CondType_present      CondType = 4
+)
+
+var CondType_name = map[int32]string{
+	0: "always",
+	1: "versionEqual",
+	2: "valueEqual",
+	3: "absent",
+	4: "present",
+}
+
+var CondType_value = map[string]int32{
+	"always":       0,
+	"versionEqual": 1,
+	"valueEqual":   2,
+	"absent":       3,
+	"present":      4,
+}
+
+func (x CondType) String() string {
+	return proto.EnumName(CondType_name, int32(x))
+}
+
+func (CondType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{2}
+}
+
 type MixedLog struct {
 	Offsets []uint32 `protobuf:"varint,1,rep,packed,name=offsets,proto3" json:"offsets,omitempty"`
 }
@@ -244,6 +309,137 @@ func (m *BlobStreams) GetBlob() []uint64 {
 	return nil
 }
 
+//PART_%d/discard
+type DiscardStats struct {
+	Discards     map[uint64]int64 `protobuf:"bytes,1,rep,name=discards,proto3" json:"discards,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	SharedExtent uint64           `protobuf:"varint,2,opt,name=sharedExtent,proto3" json:"sharedExtent,omitempty"`
+	TruncatedSeq uint64           `protobuf:"varint,3,opt,name=truncatedSeq,proto3" json:"truncatedSeq,omitempty"`
+	SharedBy     []uint64         `protobuf:"varint,4,rep,packed,name=sharedBy,proto3" json:"sharedBy,omitempty"`
+	SharedFrom   []uint64         `protobuf:"varint,5,rep,packed,name=sharedFrom,proto3" json:"sharedFrom,omitempty"`
+}
+
+func (m *DiscardStats) Reset()         { *m = DiscardStats{} }
+func (m *DiscardStats) String() string { return proto.CompactTextString(m) }
+func (*DiscardStats) ProtoMessage()    {}
+func (*DiscardStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{4}
+}
+func (m *DiscardStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiscardStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiscardStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DiscardStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscardStats.Merge(m, src)
+}
+func (m *DiscardStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiscardStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscardStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscardStats proto.InternalMessageInfo
+
+func (m *DiscardStats) GetDiscards() map[uint64]int64 {
+	if m != nil {
+		return m.Discards
+	}
+	return nil
+}
+
+func (m *DiscardStats) GetSharedExtent() uint64 {
+	if m != nil {
+		return m.SharedExtent
+	}
+	return 0
+}
+
+func (m *DiscardStats) GetTruncatedSeq() uint64 {
+	if m != nil {
+		return m.TruncatedSeq
+	}
+	return 0
+}
+
+func (m *DiscardStats) GetSharedBy() []uint64 {
+	if m != nil {
+		return m.SharedBy
+	}
+	return nil
+}
+
+func (m *DiscardStats) GetSharedFrom() []uint64 {
+	if m != nil {
+		return m.SharedFrom
+	}
+	return nil
+}
+
+//PART_%d/merged, streams of partitions merged into this one, they are deleted
+//after live values and tables in them are moved to streams of this partition
+type MergedStreams struct {
+	Logs []uint64 `protobuf:"varint,1,rep,packed,name=logs,proto3" json:"logs,omitempty"`
+	Rows []uint64 `protobuf:"varint,2,rep,packed,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (m *MergedStreams) Reset()         { *m = MergedStreams{} }
+func (m *MergedStreams) String() string { return proto.CompactTextString(m) }
+func (*MergedStreams) ProtoMessage()    {}
+func (*MergedStreams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{5}
+}
+func (m *MergedStreams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergedStreams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergedStreams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergedStreams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergedStreams.Merge(m, src)
+}
+func (m *MergedStreams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergedStreams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergedStreams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergedStreams proto.InternalMessageInfo
+
+func (m *MergedStreams) GetLogs() []uint64 {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *MergedStreams) GetRows() []uint64 {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
 type TableLocations struct {
 	Locs []*Location `protobuf:"bytes,1,rep,name=locs,proto3" json:"locs,omitempty"`
 }
@@ -252,7 +448,7 @@ func (m *TableLocations) Reset()         { *m = TableLocations{} }
 func (m *TableLocations) String() string { return proto.CompactTextString(m) }
 func (*TableLocations) ProtoMessage()    {}
 func (*TableLocations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{4}
+	return fileDescriptor_3e3c719c85d382a4, []int{6}
 }
 func (m *TableLocations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,21 +485,27 @@ func (m *TableLocations) GetLocs() []*Location {
 }
 
 type PartitionMeta struct {
-	Blobs     *BlobStreams    `protobuf:"bytes,1,opt,name=blobs,proto3" json:"blobs,omitempty"`
-	LogStream uint64          `protobuf:"varint,2,opt,name=logStream,proto3" json:"logStream,omitempty"`
-	RowStream uint64          `protobuf:"varint,3,opt,name=rowStream,proto3" json:"rowStream,omitempty"`
-	Locs      *TableLocations `protobuf:"bytes,4,opt,name=locs,proto3" json:"locs,omitempty"`
-	Parent    uint64          `protobuf:"varint,5,opt,name=parent,proto3" json:"parent,omitempty"`
-	Discard   []byte          `protobuf:"bytes,6,opt,name=discard,proto3" json:"discard,omitempty"`
-	Rg        *Range          `protobuf:"bytes,7,opt,name=rg,proto3" json:"rg,omitempty"`
-	PartID    uint64          `protobuf:"varint,8,opt,name=PartID,proto3" json:"PartID,omitempty"`
+	Blobs            *BlobStreams    `protobuf:"bytes,1,opt,name=blobs,proto3" json:"blobs,omitempty"`
+	LogStream        uint64          `protobuf:"varint,2,opt,name=logStream,proto3" json:"logStream,omitempty"`
+	RowStream        uint64          `protobuf:"varint,3,opt,name=rowStream,proto3" json:"rowStream,omitempty"`
+	Locs             *TableLocations `protobuf:"bytes,4,opt,name=locs,proto3" json:"locs,omitempty"`
+	Parent           uint64          `protobuf:"varint,5,opt,name=parent,proto3" json:"parent,omitempty"`
+	Discard          []byte          `protobuf:"bytes,6,opt,name=discard,proto3" json:"discard,omitempty"`
+	Rg               *Range          `protobuf:"bytes,7,opt,name=rg,proto3" json:"rg,omitempty"`
+	PartID           uint64          `protobuf:"varint,8,opt,name=PartID,proto3" json:"PartID,omitempty"`
+	Psversion        uint64          `protobuf:"varint,9,opt,name=psversion,proto3" json:"psversion,omitempty"`
+	Compression      CompressionType `protobuf:"varint,10,opt,name=compression,proto3,enum=pspb.CompressionType" json:"compression,omitempty"`
+	PrefixBloomLen   uint32          `protobuf:"varint,11,opt,name=prefixBloomLen,proto3" json:"prefixBloomLen,omitempty"`
+	MaxVersions      uint32          `protobuf:"varint,12,opt,name=maxVersions,proto3" json:"maxVersions,omitempty"`
+	VersionRetention uint64          `protobuf:"varint,13,opt,name=versionRetention,proto3" json:"versionRetention,omitempty"`
+	Merged           *MergedStreams  `protobuf:"bytes,14,opt,name=merged,proto3" json:"merged,omitempty"`
 }
 
 func (m *PartitionMeta) Reset()         { *m = PartitionMeta{} }
 func (m *PartitionMeta) String() string { return proto.CompactTextString(m) }
 func (*PartitionMeta) ProtoMessage()    {}
 func (*PartitionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{5}
+	return fileDescriptor_3e3c719c85d382a4, []int{7}
 }
 func (m *PartitionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,6 +590,48 @@ func (m *PartitionMeta) GetPartID() uint64 {
 	return 0
 }
 
+func (m *PartitionMeta) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
+}
+
+func (m *PartitionMeta) GetCompression() CompressionType {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionType_none
+}
+
+func (m *PartitionMeta) GetPrefixBloomLen() uint32 {
+	if m != nil {
+		return m.PrefixBloomLen
+	}
+	return 0
+}
+
+func (m *PartitionMeta) GetMaxVersions() uint32 {
+	if m != nil {
+		return m.MaxVersions
+	}
+	return 0
+}
+
+func (m *PartitionMeta) GetVersionRetention() uint64 {
+	if m != nil {
+		return m.VersionRetention
+	}
+	return 0
+}
+
+func (m *PartitionMeta) GetMerged() *MergedStreams {
+	if m != nil {
+		return m.Merged
+	}
+	return nil
+}
+
 type PSDetail struct {
 	PSID    uint64 `protobuf:"varint,1,opt,name=PSID,proto3" json:"PSID,omitempty"`
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -397,7 +641,7 @@ func (m *PSDetail) Reset()         { *m = PSDetail{} }
 func (m *PSDetail) String() string { return proto.CompactTextString(m) }
 func (*PSDetail) ProtoMessage()    {}
 func (*PSDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{6}
+	return fileDescriptor_3e3c719c85d382a4, []int{8}
 }
 func (m *PSDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,17 +685,18 @@ func (m *PSDetail) GetAddress() string {
 }
 
 type RegionInfo struct {
-	Rg     *Range `protobuf:"bytes,1,opt,name=rg,proto3" json:"rg,omitempty"`
-	PartID uint64 `protobuf:"varint,2,opt,name=PartID,proto3" json:"PartID,omitempty"`
-	PSID   uint64 `protobuf:"varint,3,opt,name=PSID,proto3" json:"PSID,omitempty"`
-	Addr   string `protobuf:"bytes,4,opt,name=addr,proto3" json:"addr,omitempty"`
+	Rg        *Range `protobuf:"bytes,1,opt,name=rg,proto3" json:"rg,omitempty"`
+	PartID    uint64 `protobuf:"varint,2,opt,name=PartID,proto3" json:"PartID,omitempty"`
+	PSID      uint64 `protobuf:"varint,3,opt,name=PSID,proto3" json:"PSID,omitempty"`
+	Addr      string `protobuf:"bytes,4,opt,name=addr,proto3" json:"addr,omitempty"`
+	Psversion uint64 `protobuf:"varint,5,opt,name=psversion,proto3" json:"psversion,omitempty"`
 }
 
 func (m *RegionInfo) Reset()         { *m = RegionInfo{} }
 func (m *RegionInfo) String() string { return proto.CompactTextString(m) }
 func (*RegionInfo) ProtoMessage()    {}
 func (*RegionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{7}
+	return fileDescriptor_3e3c719c85d382a4, []int{9}
 }
 func (m *RegionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,21 +753,29 @@ func (m *RegionInfo) GetAddr() string {
 	return ""
 }
 
+func (m *RegionInfo) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
+}
+
 //BlockMeta will be marshaled into pb.Block.userdata
 type RawBlockMeta struct {
-	Type             RawBlockType `protobuf:"varint,1,opt,name=type,proto3,enum=pspb.RawBlockType" json:"type,omitempty"`
-	CompressedSize   uint32       `protobuf:"varint,2,opt,name=CompressedSize,proto3" json:"CompressedSize,omitempty"`
-	UnCompressedSize uint32       `protobuf:"varint,3,opt,name=UnCompressedSize,proto3" json:"UnCompressedSize,omitempty"`
-	VpExtentID       uint64       `protobuf:"varint,4,opt,name=vpExtentID,proto3" json:"vpExtentID,omitempty"`
-	VpOffset         uint32       `protobuf:"varint,5,opt,name=vpOffset,proto3" json:"vpOffset,omitempty"`
-	SeqNum           uint64       `protobuf:"varint,6,opt,name=seqNum,proto3" json:"seqNum,omitempty"`
+	Type             RawBlockType    `protobuf:"varint,1,opt,name=type,proto3,enum=pspb.RawBlockType" json:"type,omitempty"`
+	CompressedSize   uint32          `protobuf:"varint,2,opt,name=CompressedSize,proto3" json:"CompressedSize,omitempty"`
+	UnCompressedSize uint32          `protobuf:"varint,3,opt,name=UnCompressedSize,proto3" json:"UnCompressedSize,omitempty"`
+	VpExtentID       uint64          `protobuf:"varint,4,opt,name=vpExtentID,proto3" json:"vpExtentID,omitempty"`
+	VpOffset         uint32          `protobuf:"varint,5,opt,name=vpOffset,proto3" json:"vpOffset,omitempty"`
+	SeqNum           uint64          `protobuf:"varint,6,opt,name=seqNum,proto3" json:"seqNum,omitempty"`
+	Compression      CompressionType `protobuf:"varint,7,opt,name=compression,proto3,enum=pspb.CompressionType" json:"compression,omitempty"`
 }
 
 func (m *RawBlockMeta) Reset()         { *m = RawBlockMeta{} }
 func (m *RawBlockMeta) String() string { return proto.CompactTextString(m) }
 func (*RawBlockMeta) ProtoMessage()    {}
 func (*RawBlockMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{8}
+	return fileDescriptor_3e3c719c85d382a4, []int{10}
 }
 func (m *RawBlockMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,6 +846,13 @@ func (m *RawBlockMeta) GetSeqNum() uint64 {
 	return 0
 }
 
+func (m *RawBlockMeta) GetCompression() CompressionType {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionType_none
+}
+
 type BlockOffset struct {
 	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	ExtentID uint64 `protobuf:"varint,2,opt,name=extentID,proto3" json:"extentID,omitempty"`
@@ -603,7 +863,7 @@ func (m *BlockOffset) Reset()         { *m = BlockOffset{} }
 func (m *BlockOffset) String() string { return proto.CompactTextString(m) }
 func (*BlockOffset) ProtoMessage()    {}
 func (*BlockOffset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{9}
+	return fileDescriptor_3e3c719c85d382a4, []int{11}
 }
 func (m *BlockOffset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,17 +914,22 @@ func (m *BlockOffset) GetOffset() uint32 {
 }
 
 type TableIndex struct {
-	Offsets       []*BlockOffset `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets,omitempty"`
-	BloomFilter   []byte         `protobuf:"bytes,2,opt,name=bloomFilter,proto3" json:"bloomFilter,omitempty"`
-	EstimatedSize uint64         `protobuf:"varint,3,opt,name=estimatedSize,proto3" json:"estimatedSize,omitempty"`
-	NumOfBlocks   uint32         `protobuf:"varint,4,opt,name=numOfBlocks,proto3" json:"numOfBlocks,omitempty"`
+	Offsets           []*BlockOffset `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets,omitempty"`
+	BloomFilter       []byte         `protobuf:"bytes,2,opt,name=bloomFilter,proto3" json:"bloomFilter,omitempty"`
+	EstimatedSize     uint64         `protobuf:"varint,3,opt,name=estimatedSize,proto3" json:"estimatedSize,omitempty"`
+	NumOfBlocks       uint32         `protobuf:"varint,4,opt,name=numOfBlocks,proto3" json:"numOfBlocks,omitempty"`
+	PrefixBloomFilter []byte         `protobuf:"bytes,5,opt,name=prefixBloomFilter,proto3" json:"prefixBloomFilter,omitempty"`
+	PrefixLen         uint32         `protobuf:"varint,6,opt,name=prefixLen,proto3" json:"prefixLen,omitempty"`
+	RangeDeletes      []*RangeDelete `protobuf:"bytes,7,rep,name=rangeDeletes,proto3" json:"rangeDeletes,omitempty"`
+	VersionTimes      []*VersionTime `protobuf:"bytes,8,rep,name=versionTimes,proto3" json:"versionTimes,omitempty"`
+	GlobalSeq         uint64         `protobuf:"varint,9,opt,name=globalSeq,proto3" json:"globalSeq,omitempty"`
 }
 
 func (m *TableIndex) Reset()         { *m = TableIndex{} }
 func (m *TableIndex) String() string { return proto.CompactTextString(m) }
 func (*TableIndex) ProtoMessage()    {}
 func (*TableIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{10}
+	return fileDescriptor_3e3c719c85d382a4, []int{12}
 }
 func (m *TableIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,67 +986,59 @@ func (m *TableIndex) GetNumOfBlocks() uint32 {
 	return 0
 }
 
-type GetPartitionMetaRequest struct {
-	PSID uint64 `protobuf:"varint,1,opt,name=PSID,proto3" json:"PSID,omitempty"`
+func (m *TableIndex) GetPrefixBloomFilter() []byte {
+	if m != nil {
+		return m.PrefixBloomFilter
+	}
+	return nil
 }
 
-func (m *GetPartitionMetaRequest) Reset()         { *m = GetPartitionMetaRequest{} }
-func (m *GetPartitionMetaRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPartitionMetaRequest) ProtoMessage()    {}
-func (*GetPartitionMetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{11}
-}
-func (m *GetPartitionMetaRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GetPartitionMetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GetPartitionMetaRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *TableIndex) GetPrefixLen() uint32 {
+	if m != nil {
+		return m.PrefixLen
 	}
+	return 0
 }
-func (m *GetPartitionMetaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPartitionMetaRequest.Merge(m, src)
-}
-func (m *GetPartitionMetaRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *GetPartitionMetaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPartitionMetaRequest.DiscardUnknown(m)
+
+func (m *TableIndex) GetRangeDeletes() []*RangeDelete {
+	if m != nil {
+		return m.RangeDeletes
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetPartitionMetaRequest proto.InternalMessageInfo
+func (m *TableIndex) GetVersionTimes() []*VersionTime {
+	if m != nil {
+		return m.VersionTimes
+	}
+	return nil
+}
 
-func (m *GetPartitionMetaRequest) GetPSID() uint64 {
+func (m *TableIndex) GetGlobalSeq() uint64 {
 	if m != nil {
-		return m.PSID
+		return m.GlobalSeq
 	}
 	return 0
 }
 
-type GetPartitionMetaResponse struct {
-	Code pb.Code          `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	Meta []*PartitionMeta `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty"`
+//versions <= seq were written before unixTime
+type VersionTime struct {
+	Seq      uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	UnixTime int64  `protobuf:"varint,2,opt,name=unixTime,proto3" json:"unixTime,omitempty"`
 }
 
-func (m *GetPartitionMetaResponse) Reset()         { *m = GetPartitionMetaResponse{} }
-func (m *GetPartitionMetaResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPartitionMetaResponse) ProtoMessage()    {}
-func (*GetPartitionMetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{12}
+func (m *VersionTime) Reset()         { *m = VersionTime{} }
+func (m *VersionTime) String() string { return proto.CompactTextString(m) }
+func (*VersionTime) ProtoMessage()    {}
+func (*VersionTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{13}
 }
-func (m *GetPartitionMetaResponse) XXX_Unmarshal(b []byte) error {
+func (m *VersionTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetPartitionMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VersionTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetPartitionMetaResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VersionTime.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -791,49 +1048,51 @@ func (m *GetPartitionMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *GetPartitionMetaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPartitionMetaResponse.Merge(m, src)
+func (m *VersionTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionTime.Merge(m, src)
 }
-func (m *GetPartitionMetaResponse) XXX_Size() int {
+func (m *VersionTime) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetPartitionMetaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPartitionMetaResponse.DiscardUnknown(m)
+func (m *VersionTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionTime.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPartitionMetaResponse proto.InternalMessageInfo
+var xxx_messageInfo_VersionTime proto.InternalMessageInfo
 
-func (m *GetPartitionMetaResponse) GetCode() pb.Code {
+func (m *VersionTime) GetSeq() uint64 {
 	if m != nil {
-		return m.Code
+		return m.Seq
 	}
-	return pb.Code_OK
+	return 0
 }
 
-func (m *GetPartitionMetaResponse) GetMeta() []*PartitionMeta {
+func (m *VersionTime) GetUnixTime() int64 {
 	if m != nil {
-		return m.Meta
+		return m.UnixTime
 	}
-	return nil
+	return 0
 }
 
-type SetRowStreamTablesRequest struct {
-	PartitionID uint64          `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	Locs        *TableLocations `protobuf:"bytes,2,opt,name=locs,proto3" json:"locs,omitempty"`
+//versions older than seq of keys in [start, end) are deleted, empty end means no upper bound
+type RangeDelete struct {
+	Start []byte `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   []byte `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Seq   uint64 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (m *SetRowStreamTablesRequest) Reset()         { *m = SetRowStreamTablesRequest{} }
-func (m *SetRowStreamTablesRequest) String() string { return proto.CompactTextString(m) }
-func (*SetRowStreamTablesRequest) ProtoMessage()    {}
-func (*SetRowStreamTablesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{13}
+func (m *RangeDelete) Reset()         { *m = RangeDelete{} }
+func (m *RangeDelete) String() string { return proto.CompactTextString(m) }
+func (*RangeDelete) ProtoMessage()    {}
+func (*RangeDelete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{14}
 }
-func (m *SetRowStreamTablesRequest) XXX_Unmarshal(b []byte) error {
+func (m *RangeDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetRowStreamTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RangeDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetRowStreamTablesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RangeDelete.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -843,91 +1102,55 @@ func (m *SetRowStreamTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *SetRowStreamTablesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetRowStreamTablesRequest.Merge(m, src)
+func (m *RangeDelete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeDelete.Merge(m, src)
 }
-func (m *SetRowStreamTablesRequest) XXX_Size() int {
+func (m *RangeDelete) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetRowStreamTablesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetRowStreamTablesRequest.DiscardUnknown(m)
+func (m *RangeDelete) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeDelete.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetRowStreamTablesRequest proto.InternalMessageInfo
+var xxx_messageInfo_RangeDelete proto.InternalMessageInfo
 
-func (m *SetRowStreamTablesRequest) GetPartitionID() uint64 {
+func (m *RangeDelete) GetStart() []byte {
 	if m != nil {
-		return m.PartitionID
+		return m.Start
 	}
-	return 0
+	return nil
 }
 
-func (m *SetRowStreamTablesRequest) GetLocs() *TableLocations {
+func (m *RangeDelete) GetEnd() []byte {
 	if m != nil {
-		return m.Locs
+		return m.End
 	}
 	return nil
 }
 
-type SetRowStreamTablesResponse struct {
-	Code pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-}
-
-func (m *SetRowStreamTablesResponse) Reset()         { *m = SetRowStreamTablesResponse{} }
-func (m *SetRowStreamTablesResponse) String() string { return proto.CompactTextString(m) }
-func (*SetRowStreamTablesResponse) ProtoMessage()    {}
-func (*SetRowStreamTablesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{14}
-}
-func (m *SetRowStreamTablesResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SetRowStreamTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SetRowStreamTablesResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *SetRowStreamTablesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetRowStreamTablesResponse.Merge(m, src)
-}
-func (m *SetRowStreamTablesResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *SetRowStreamTablesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetRowStreamTablesResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SetRowStreamTablesResponse proto.InternalMessageInfo
-
-func (m *SetRowStreamTablesResponse) GetCode() pb.Code {
+func (m *RangeDelete) GetSeq() uint64 {
 	if m != nil {
-		return m.Code
+		return m.Seq
 	}
-	return pb.Code_OK
+	return 0
 }
 
-type GetRegionsRequest struct {
+type GetPartitionMetaRequest struct {
+	PSID uint64 `protobuf:"varint,1,opt,name=PSID,proto3" json:"PSID,omitempty"`
 }
 
-func (m *GetRegionsRequest) Reset()         { *m = GetRegionsRequest{} }
-func (m *GetRegionsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRegionsRequest) ProtoMessage()    {}
-func (*GetRegionsRequest) Descriptor() ([]byte, []int) {
+func (m *GetPartitionMetaRequest) Reset()         { *m = GetPartitionMetaRequest{} }
+func (m *GetPartitionMetaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionMetaRequest) ProtoMessage()    {}
+func (*GetPartitionMetaRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{15}
 }
-func (m *GetRegionsRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetPartitionMetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetRegionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetPartitionMetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetRegionsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetPartitionMetaRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -937,35 +1160,42 @@ func (m *GetRegionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GetRegionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegionsRequest.Merge(m, src)
+func (m *GetPartitionMetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionMetaRequest.Merge(m, src)
 }
-func (m *GetRegionsRequest) XXX_Size() int {
+func (m *GetPartitionMetaRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetRegionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegionsRequest.DiscardUnknown(m)
+func (m *GetPartitionMetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionMetaRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetPartitionMetaRequest proto.InternalMessageInfo
 
-type GetRegionsResponse struct {
-	Code    pb.Code       `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	Regions []*RegionInfo `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"`
+func (m *GetPartitionMetaRequest) GetPSID() uint64 {
+	if m != nil {
+		return m.PSID
+	}
+	return 0
 }
 
-func (m *GetRegionsResponse) Reset()         { *m = GetRegionsResponse{} }
-func (m *GetRegionsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRegionsResponse) ProtoMessage()    {}
-func (*GetRegionsResponse) Descriptor() ([]byte, []int) {
+type GetPartitionMetaResponse struct {
+	Code pb.Code          `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	Meta []*PartitionMeta `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (m *GetPartitionMetaResponse) Reset()         { *m = GetPartitionMetaResponse{} }
+func (m *GetPartitionMetaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionMetaResponse) ProtoMessage()    {}
+func (*GetPartitionMetaResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{16}
 }
-func (m *GetRegionsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetPartitionMetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetRegionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetPartitionMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetRegionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetPartitionMetaResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -975,48 +1205,49 @@ func (m *GetRegionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetRegionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegionsResponse.Merge(m, src)
+func (m *GetPartitionMetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionMetaResponse.Merge(m, src)
 }
-func (m *GetRegionsResponse) XXX_Size() int {
+func (m *GetPartitionMetaResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetRegionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegionsResponse.DiscardUnknown(m)
+func (m *GetPartitionMetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionMetaResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPartitionMetaResponse proto.InternalMessageInfo
 
-func (m *GetRegionsResponse) GetCode() pb.Code {
+func (m *GetPartitionMetaResponse) GetCode() pb.Code {
 	if m != nil {
 		return m.Code
 	}
 	return pb.Code_OK
 }
 
-func (m *GetRegionsResponse) GetRegions() []*RegionInfo {
+func (m *GetPartitionMetaResponse) GetMeta() []*PartitionMeta {
 	if m != nil {
-		return m.Regions
+		return m.Meta
 	}
 	return nil
 }
 
-type RegisterPSRequest struct {
-	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+type PartitionLease struct {
+	PartID    uint64 `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
 }
 
-func (m *RegisterPSRequest) Reset()         { *m = RegisterPSRequest{} }
-func (m *RegisterPSRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterPSRequest) ProtoMessage()    {}
-func (*RegisterPSRequest) Descriptor() ([]byte, []int) {
+func (m *PartitionLease) Reset()         { *m = PartitionLease{} }
+func (m *PartitionLease) String() string { return proto.CompactTextString(m) }
+func (*PartitionLease) ProtoMessage()    {}
+func (*PartitionLease) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{17}
 }
-func (m *RegisterPSRequest) XXX_Unmarshal(b []byte) error {
+func (m *PartitionLease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterPSRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PartitionLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterPSRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PartitionLease.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1026,42 +1257,53 @@ func (m *RegisterPSRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *RegisterPSRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterPSRequest.Merge(m, src)
+func (m *PartitionLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionLease.Merge(m, src)
 }
-func (m *RegisterPSRequest) XXX_Size() int {
+func (m *PartitionLease) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterPSRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterPSRequest.DiscardUnknown(m)
+func (m *PartitionLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionLease.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterPSRequest proto.InternalMessageInfo
+var xxx_messageInfo_PartitionLease proto.InternalMessageInfo
 
-func (m *RegisterPSRequest) GetAddr() string {
+func (m *PartitionLease) GetPartID() uint64 {
 	if m != nil {
-		return m.Addr
+		return m.PartID
 	}
-	return ""
+	return 0
 }
 
-type RegisterPSResponse struct {
-	Code pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
-	Id   uint64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+func (m *PartitionLease) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
 }
 
-func (m *RegisterPSResponse) Reset()         { *m = RegisterPSResponse{} }
-func (m *RegisterPSResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterPSResponse) ProtoMessage()    {}
-func (*RegisterPSResponse) Descriptor() ([]byte, []int) {
+//load of a partition since the last RenewLease
+type PartitionLoad struct {
+	PartID       uint64 `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	Qps          uint64 `protobuf:"varint,2,opt,name=qps,proto3" json:"qps,omitempty"`
+	WriteBytes   uint64 `protobuf:"varint,3,opt,name=writeBytes,proto3" json:"writeBytes,omitempty"`
+	MemtableSize uint64 `protobuf:"varint,4,opt,name=memtableSize,proto3" json:"memtableSize,omitempty"`
+	TableSize    uint64 `protobuf:"varint,5,opt,name=tableSize,proto3" json:"tableSize,omitempty"`
+}
+
+func (m *PartitionLoad) Reset()         { *m = PartitionLoad{} }
+func (m *PartitionLoad) String() string { return proto.CompactTextString(m) }
+func (*PartitionLoad) ProtoMessage()    {}
+func (*PartitionLoad) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{18}
 }
-func (m *RegisterPSResponse) XXX_Unmarshal(b []byte) error {
+func (m *PartitionLoad) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterPSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PartitionLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterPSResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PartitionLoad.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1071,47 +1313,72 @@ func (m *RegisterPSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RegisterPSResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterPSResponse.Merge(m, src)
+func (m *PartitionLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionLoad.Merge(m, src)
 }
-func (m *RegisterPSResponse) XXX_Size() int {
+func (m *PartitionLoad) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterPSResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterPSResponse.DiscardUnknown(m)
+func (m *PartitionLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionLoad.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterPSResponse proto.InternalMessageInfo
+var xxx_messageInfo_PartitionLoad proto.InternalMessageInfo
 
-func (m *RegisterPSResponse) GetCode() pb.Code {
+func (m *PartitionLoad) GetPartID() uint64 {
 	if m != nil {
-		return m.Code
+		return m.PartID
 	}
-	return pb.Code_OK
+	return 0
 }
 
-func (m *RegisterPSResponse) GetId() uint64 {
+func (m *PartitionLoad) GetQps() uint64 {
 	if m != nil {
-		return m.Id
+		return m.Qps
 	}
 	return 0
 }
 
-type GetPSInfoRequest struct {
+func (m *PartitionLoad) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
 }
 
-func (m *GetPSInfoRequest) Reset()         { *m = GetPSInfoRequest{} }
-func (m *GetPSInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPSInfoRequest) ProtoMessage()    {}
-func (*GetPSInfoRequest) Descriptor() ([]byte, []int) {
+func (m *PartitionLoad) GetMemtableSize() uint64 {
+	if m != nil {
+		return m.MemtableSize
+	}
+	return 0
+}
+
+func (m *PartitionLoad) GetTableSize() uint64 {
+	if m != nil {
+		return m.TableSize
+	}
+	return 0
+}
+
+//PS renews the lease of all its partitions, a partition can only be served
+//before the lease expires and with the same psversion
+type RenewLeaseRequest struct {
+	PSID  uint64           `protobuf:"varint,1,opt,name=PSID,proto3" json:"PSID,omitempty"`
+	Loads []*PartitionLoad `protobuf:"bytes,2,rep,name=loads,proto3" json:"loads,omitempty"`
+}
+
+func (m *RenewLeaseRequest) Reset()         { *m = RenewLeaseRequest{} }
+func (m *RenewLeaseRequest) String() string { return proto.CompactTextString(m) }
+func (*RenewLeaseRequest) ProtoMessage()    {}
+func (*RenewLeaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{19}
 }
-func (m *GetPSInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *RenewLeaseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetPSInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenewLeaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetPSInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenewLeaseRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1121,34 +1388,51 @@ func (m *GetPSInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GetPSInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPSInfoRequest.Merge(m, src)
+func (m *RenewLeaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewLeaseRequest.Merge(m, src)
 }
-func (m *GetPSInfoRequest) XXX_Size() int {
+func (m *RenewLeaseRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetPSInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPSInfoRequest.DiscardUnknown(m)
+func (m *RenewLeaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewLeaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPSInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_RenewLeaseRequest proto.InternalMessageInfo
 
-type GetPSInfoResponse struct {
-	Servers []*PSDetail `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+func (m *RenewLeaseRequest) GetPSID() uint64 {
+	if m != nil {
+		return m.PSID
+	}
+	return 0
 }
 
-func (m *GetPSInfoResponse) Reset()         { *m = GetPSInfoResponse{} }
-func (m *GetPSInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPSInfoResponse) ProtoMessage()    {}
-func (*GetPSInfoResponse) Descriptor() ([]byte, []int) {
+func (m *RenewLeaseRequest) GetLoads() []*PartitionLoad {
+	if m != nil {
+		return m.Loads
+	}
+	return nil
+}
+
+type RenewLeaseResponse struct {
+	Code    pb.Code           `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string            `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Leases  []*PartitionLease `protobuf:"bytes,3,rep,name=leases,proto3" json:"leases,omitempty"`
+	Ttl     int64             `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (m *RenewLeaseResponse) Reset()         { *m = RenewLeaseResponse{} }
+func (m *RenewLeaseResponse) String() string { return proto.CompactTextString(m) }
+func (*RenewLeaseResponse) ProtoMessage()    {}
+func (*RenewLeaseResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{20}
 }
-func (m *GetPSInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *RenewLeaseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetPSInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenewLeaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetPSInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenewLeaseResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1158,43 +1442,63 @@ func (m *GetPSInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GetPSInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPSInfoResponse.Merge(m, src)
+func (m *RenewLeaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewLeaseResponse.Merge(m, src)
 }
-func (m *GetPSInfoResponse) XXX_Size() int {
+func (m *RenewLeaseResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetPSInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPSInfoResponse.DiscardUnknown(m)
+func (m *RenewLeaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewLeaseResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPSInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_RenewLeaseResponse proto.InternalMessageInfo
 
-func (m *GetPSInfoResponse) GetServers() []*PSDetail {
+func (m *RenewLeaseResponse) GetCode() pb.Code {
 	if m != nil {
-		return m.Servers
+		return m.Code
+	}
+	return pb.Code_OK
+}
+
+func (m *RenewLeaseResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
+}
+
+func (m *RenewLeaseResponse) GetLeases() []*PartitionLease {
+	if m != nil {
+		return m.Leases
 	}
 	return nil
 }
 
-type BootstrapRequest struct {
-	LogID  uint64 `protobuf:"varint,1,opt,name=logID,proto3" json:"logID,omitempty"`
-	RowID  uint64 `protobuf:"varint,2,opt,name=rowID,proto3" json:"rowID,omitempty"`
-	Parent uint64 `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
+func (m *RenewLeaseResponse) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
 }
 
-func (m *BootstrapRequest) Reset()         { *m = BootstrapRequest{} }
-func (m *BootstrapRequest) String() string { return proto.CompactTextString(m) }
-func (*BootstrapRequest) ProtoMessage()    {}
-func (*BootstrapRequest) Descriptor() ([]byte, []int) {
+type SetRowStreamTablesRequest struct {
+	PartitionID uint64          `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Locs        *TableLocations `protobuf:"bytes,2,opt,name=locs,proto3" json:"locs,omitempty"`
+}
+
+func (m *SetRowStreamTablesRequest) Reset()         { *m = SetRowStreamTablesRequest{} }
+func (m *SetRowStreamTablesRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRowStreamTablesRequest) ProtoMessage()    {}
+func (*SetRowStreamTablesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{21}
 }
-func (m *BootstrapRequest) XXX_Unmarshal(b []byte) error {
+func (m *SetRowStreamTablesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BootstrapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetRowStreamTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BootstrapRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetRowStreamTablesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1204,55 +1508,48 @@ func (m *BootstrapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *BootstrapRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BootstrapRequest.Merge(m, src)
+func (m *SetRowStreamTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRowStreamTablesRequest.Merge(m, src)
 }
-func (m *BootstrapRequest) XXX_Size() int {
+func (m *SetRowStreamTablesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BootstrapRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BootstrapRequest.DiscardUnknown(m)
+func (m *SetRowStreamTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRowStreamTablesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BootstrapRequest proto.InternalMessageInfo
-
-func (m *BootstrapRequest) GetLogID() uint64 {
-	if m != nil {
-		return m.LogID
-	}
-	return 0
-}
+var xxx_messageInfo_SetRowStreamTablesRequest proto.InternalMessageInfo
 
-func (m *BootstrapRequest) GetRowID() uint64 {
+func (m *SetRowStreamTablesRequest) GetPartitionID() uint64 {
 	if m != nil {
-		return m.RowID
+		return m.PartitionID
 	}
 	return 0
 }
 
-func (m *BootstrapRequest) GetParent() uint64 {
+func (m *SetRowStreamTablesRequest) GetLocs() *TableLocations {
 	if m != nil {
-		return m.Parent
+		return m.Locs
 	}
-	return 0
+	return nil
 }
 
-type BootstrapResponse struct {
-	PartID uint64 `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+type SetRowStreamTablesResponse struct {
+	Code pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
 }
 
-func (m *BootstrapResponse) Reset()         { *m = BootstrapResponse{} }
-func (m *BootstrapResponse) String() string { return proto.CompactTextString(m) }
-func (*BootstrapResponse) ProtoMessage()    {}
-func (*BootstrapResponse) Descriptor() ([]byte, []int) {
+func (m *SetRowStreamTablesResponse) Reset()         { *m = SetRowStreamTablesResponse{} }
+func (m *SetRowStreamTablesResponse) String() string { return proto.CompactTextString(m) }
+func (*SetRowStreamTablesResponse) ProtoMessage()    {}
+func (*SetRowStreamTablesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{22}
 }
-func (m *BootstrapResponse) XXX_Unmarshal(b []byte) error {
+func (m *SetRowStreamTablesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BootstrapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetRowStreamTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BootstrapResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetRowStreamTablesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1262,45 +1559,40 @@ func (m *BootstrapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *BootstrapResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BootstrapResponse.Merge(m, src)
+func (m *SetRowStreamTablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRowStreamTablesResponse.Merge(m, src)
 }
-func (m *BootstrapResponse) XXX_Size() int {
+func (m *SetRowStreamTablesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BootstrapResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BootstrapResponse.DiscardUnknown(m)
+func (m *SetRowStreamTablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRowStreamTablesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BootstrapResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetRowStreamTablesResponse proto.InternalMessageInfo
 
-func (m *BootstrapResponse) GetPartID() uint64 {
+func (m *SetRowStreamTablesResponse) GetCode() pb.Code {
 	if m != nil {
-		return m.PartID
+		return m.Code
 	}
-	return 0
+	return pb.Code_OK
 }
 
-type PutRequest struct {
-	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	ExpiresAt uint64 `protobuf:"varint,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
-	Psversion uint64 `protobuf:"varint,4,opt,name=psversion,proto3" json:"psversion,omitempty"`
-	Partid    uint64 `protobuf:"varint,5,opt,name=partid,proto3" json:"partid,omitempty"`
+type GetRegionsRequest struct {
 }
 
-func (m *PutRequest) Reset()         { *m = PutRequest{} }
-func (m *PutRequest) String() string { return proto.CompactTextString(m) }
-func (*PutRequest) ProtoMessage()    {}
-func (*PutRequest) Descriptor() ([]byte, []int) {
+func (m *GetRegionsRequest) Reset()         { *m = GetRegionsRequest{} }
+func (m *GetRegionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRegionsRequest) ProtoMessage()    {}
+func (*GetRegionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{23}
 }
-func (m *PutRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetRegionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetRegionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PutRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetRegionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1310,69 +1602,35 @@ func (m *PutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutRequest.Merge(m, src)
+func (m *GetRegionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegionsRequest.Merge(m, src)
 }
-func (m *PutRequest) XXX_Size() int {
+func (m *GetRegionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PutRequest proto.InternalMessageInfo
-
-func (m *PutRequest) GetKey() []byte {
-	if m != nil {
-		return m.Key
-	}
-	return nil
-}
-
-func (m *PutRequest) GetValue() []byte {
-	if m != nil {
-		return m.Value
-	}
-	return nil
-}
-
-func (m *PutRequest) GetExpiresAt() uint64 {
-	if m != nil {
-		return m.ExpiresAt
-	}
-	return 0
-}
-
-func (m *PutRequest) GetPsversion() uint64 {
-	if m != nil {
-		return m.Psversion
-	}
-	return 0
+func (m *GetRegionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegionsRequest.DiscardUnknown(m)
 }
 
-func (m *PutRequest) GetPartid() uint64 {
-	if m != nil {
-		return m.Partid
-	}
-	return 0
-}
+var xxx_messageInfo_GetRegionsRequest proto.InternalMessageInfo
 
-type PutResponse struct {
-	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+type GetRegionsResponse struct {
+	Code    pb.Code       `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	Regions []*RegionInfo `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"`
 }
 
-func (m *PutResponse) Reset()         { *m = PutResponse{} }
-func (m *PutResponse) String() string { return proto.CompactTextString(m) }
-func (*PutResponse) ProtoMessage()    {}
-func (*PutResponse) Descriptor() ([]byte, []int) {
+func (m *GetRegionsResponse) Reset()         { *m = GetRegionsResponse{} }
+func (m *GetRegionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRegionsResponse) ProtoMessage()    {}
+func (*GetRegionsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{24}
 }
-func (m *PutResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetRegionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetRegionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PutResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetRegionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1382,43 +1640,48 @@ func (m *PutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutResponse.Merge(m, src)
+func (m *GetRegionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegionsResponse.Merge(m, src)
 }
-func (m *PutResponse) XXX_Size() int {
+func (m *GetRegionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutResponse.DiscardUnknown(m)
+func (m *GetRegionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetRegionsResponse proto.InternalMessageInfo
 
-func (m *PutResponse) GetKey() []byte {
+func (m *GetRegionsResponse) GetCode() pb.Code {
 	if m != nil {
-		return m.Key
+		return m.Code
+	}
+	return pb.Code_OK
+}
+
+func (m *GetRegionsResponse) GetRegions() []*RegionInfo {
+	if m != nil {
+		return m.Regions
 	}
 	return nil
 }
 
-type DeleteRequest struct {
-	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
-	Partid    uint64 `protobuf:"varint,5,opt,name=partid,proto3" json:"partid,omitempty"`
+type RegisterPSRequest struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 }
 
-func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()    {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
+func (m *RegisterPSRequest) Reset()         { *m = RegisterPSRequest{} }
+func (m *RegisterPSRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterPSRequest) ProtoMessage()    {}
+func (*RegisterPSRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{25}
 }
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *RegisterPSRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterPSRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterPSRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1428,56 +1691,42 @@ func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *RegisterPSRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterPSRequest.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_Size() int {
+func (m *RegisterPSRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
-
-func (m *DeleteRequest) GetKey() []byte {
-	if m != nil {
-		return m.Key
-	}
-	return nil
+func (m *RegisterPSRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterPSRequest.DiscardUnknown(m)
 }
 
-func (m *DeleteRequest) GetPsversion() uint64 {
-	if m != nil {
-		return m.Psversion
-	}
-	return 0
-}
+var xxx_messageInfo_RegisterPSRequest proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetPartid() uint64 {
+func (m *RegisterPSRequest) GetAddr() string {
 	if m != nil {
-		return m.Partid
+		return m.Addr
 	}
-	return 0
+	return ""
 }
 
-type DeleteResponse struct {
-	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
+type RegisterPSResponse struct {
+	Code pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	Id   uint64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
-func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteResponse) ProtoMessage()    {}
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
+func (m *RegisterPSResponse) Reset()         { *m = RegisterPSResponse{} }
+func (m *RegisterPSResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterPSResponse) ProtoMessage()    {}
+func (*RegisterPSResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{26}
 }
-func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+func (m *RegisterPSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterPSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterPSResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1487,50 +1736,47 @@ func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *DeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteResponse.Merge(m, src)
+func (m *RegisterPSResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterPSResponse.Merge(m, src)
 }
-func (m *DeleteResponse) XXX_Size() int {
+func (m *RegisterPSResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
-}
+func (m *RegisterPSResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterPSResponse.DiscardUnknown(m)
+}
 
-var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+var xxx_messageInfo_RegisterPSResponse proto.InternalMessageInfo
 
-func (m *DeleteResponse) GetKey() []byte {
+func (m *RegisterPSResponse) GetCode() pb.Code {
 	if m != nil {
-		return m.Key
+		return m.Code
 	}
-	return nil
+	return pb.Code_OK
 }
 
-func (m *DeleteResponse) GetPsversion() uint64 {
+func (m *RegisterPSResponse) GetId() uint64 {
 	if m != nil {
-		return m.Psversion
+		return m.Id
 	}
 	return 0
 }
 
-type GetRequest struct {
-	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
-	Partid    uint64 `protobuf:"varint,5,opt,name=partid,proto3" json:"partid,omitempty"`
+type GetPSInfoRequest struct {
 }
 
-func (m *GetRequest) Reset()         { *m = GetRequest{} }
-func (m *GetRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRequest) ProtoMessage()    {}
-func (*GetRequest) Descriptor() ([]byte, []int) {
+func (m *GetPSInfoRequest) Reset()         { *m = GetPSInfoRequest{} }
+func (m *GetPSInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPSInfoRequest) ProtoMessage()    {}
+func (*GetPSInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{27}
 }
-func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetPSInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetPSInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetPSInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1540,56 +1786,34 @@ func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRequest.Merge(m, src)
+func (m *GetPSInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPSInfoRequest.Merge(m, src)
 }
-func (m *GetRequest) XXX_Size() int {
+func (m *GetPSInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetRequest proto.InternalMessageInfo
-
-func (m *GetRequest) GetKey() []byte {
-	if m != nil {
-		return m.Key
-	}
-	return nil
-}
-
-func (m *GetRequest) GetPsversion() uint64 {
-	if m != nil {
-		return m.Psversion
-	}
-	return 0
+func (m *GetPSInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPSInfoRequest.DiscardUnknown(m)
 }
 
-func (m *GetRequest) GetPartid() uint64 {
-	if m != nil {
-		return m.Partid
-	}
-	return 0
-}
+var xxx_messageInfo_GetPSInfoRequest proto.InternalMessageInfo
 
-type GetResponse struct {
-	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+type GetPSInfoResponse struct {
+	Servers []*PSDetail `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
 }
 
-func (m *GetResponse) Reset()         { *m = GetResponse{} }
-func (m *GetResponse) String() string { return proto.CompactTextString(m) }
-func (*GetResponse) ProtoMessage()    {}
-func (*GetResponse) Descriptor() ([]byte, []int) {
+func (m *GetPSInfoResponse) Reset()         { *m = GetPSInfoResponse{} }
+func (m *GetPSInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPSInfoResponse) ProtoMessage()    {}
+func (*GetPSInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{28}
 }
-func (m *GetResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetPSInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetPSInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetPSInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1599,52 +1823,47 @@ func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetResponse.Merge(m, src)
+func (m *GetPSInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPSInfoResponse.Merge(m, src)
 }
-func (m *GetResponse) XXX_Size() int {
+func (m *GetPSInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+func (m *GetPSInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPSInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetResponse proto.InternalMessageInfo
-
-func (m *GetResponse) GetKey() []byte {
-	if m != nil {
-		return m.Key
-	}
-	return nil
-}
+var xxx_messageInfo_GetPSInfoResponse proto.InternalMessageInfo
 
-func (m *GetResponse) GetValue() []byte {
+func (m *GetPSInfoResponse) GetServers() []*PSDetail {
 	if m != nil {
-		return m.Value
+		return m.Servers
 	}
 	return nil
 }
 
-type RequestOp struct {
-	// Types that are valid to be assigned to Request:
-	//	*RequestOp_RequestPut
-	//	*RequestOp_RequestDelete
-	//	*RequestOp_RequestGet
-	Request isRequestOp_Request `protobuf_oneof:"request"`
+type BootstrapRequest struct {
+	LogID            uint64          `protobuf:"varint,1,opt,name=logID,proto3" json:"logID,omitempty"`
+	RowID            uint64          `protobuf:"varint,2,opt,name=rowID,proto3" json:"rowID,omitempty"`
+	Parent           uint64          `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	Compression      CompressionType `protobuf:"varint,4,opt,name=compression,proto3,enum=pspb.CompressionType" json:"compression,omitempty"`
+	PrefixBloomLen   uint32          `protobuf:"varint,5,opt,name=prefixBloomLen,proto3" json:"prefixBloomLen,omitempty"`
+	MaxVersions      uint32          `protobuf:"varint,6,opt,name=maxVersions,proto3" json:"maxVersions,omitempty"`
+	VersionRetention uint64          `protobuf:"varint,7,opt,name=versionRetention,proto3" json:"versionRetention,omitempty"`
 }
 
-func (m *RequestOp) Reset()         { *m = RequestOp{} }
-func (m *RequestOp) String() string { return proto.CompactTextString(m) }
-func (*RequestOp) ProtoMessage()    {}
-func (*RequestOp) Descriptor() ([]byte, []int) {
+func (m *BootstrapRequest) Reset()         { *m = BootstrapRequest{} }
+func (m *BootstrapRequest) String() string { return proto.CompactTextString(m) }
+func (*BootstrapRequest) ProtoMessage()    {}
+func (*BootstrapRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{29}
 }
-func (m *RequestOp) XXX_Unmarshal(b []byte) error {
+func (m *BootstrapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RequestOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BootstrapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RequestOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BootstrapRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1654,95 +1873,84 @@ func (m *RequestOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RequestOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RequestOp.Merge(m, src)
+func (m *BootstrapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BootstrapRequest.Merge(m, src)
 }
-func (m *RequestOp) XXX_Size() int {
+func (m *BootstrapRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RequestOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_RequestOp.DiscardUnknown(m)
+func (m *BootstrapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BootstrapRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RequestOp proto.InternalMessageInfo
+var xxx_messageInfo_BootstrapRequest proto.InternalMessageInfo
 
-type isRequestOp_Request interface {
-	isRequestOp_Request()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *BootstrapRequest) GetLogID() uint64 {
+	if m != nil {
+		return m.LogID
+	}
+	return 0
 }
 
-type RequestOp_RequestPut struct {
-	RequestPut *PutRequest `protobuf:"bytes,1,opt,name=request_put,json=requestPut,proto3,oneof" json:"request_put,omitempty"`
-}
-type RequestOp_RequestDelete struct {
-	RequestDelete *DeleteRequest `protobuf:"bytes,2,opt,name=request_delete,json=requestDelete,proto3,oneof" json:"request_delete,omitempty"`
-}
-type RequestOp_RequestGet struct {
-	RequestGet *GetRequest `protobuf:"bytes,3,opt,name=request_get,json=requestGet,proto3,oneof" json:"request_get,omitempty"`
+func (m *BootstrapRequest) GetRowID() uint64 {
+	if m != nil {
+		return m.RowID
+	}
+	return 0
 }
 
-func (*RequestOp_RequestPut) isRequestOp_Request()    {}
-func (*RequestOp_RequestDelete) isRequestOp_Request() {}
-func (*RequestOp_RequestGet) isRequestOp_Request()    {}
-
-func (m *RequestOp) GetRequest() isRequestOp_Request {
+func (m *BootstrapRequest) GetParent() uint64 {
 	if m != nil {
-		return m.Request
+		return m.Parent
 	}
-	return nil
+	return 0
 }
 
-func (m *RequestOp) GetRequestPut() *PutRequest {
-	if x, ok := m.GetRequest().(*RequestOp_RequestPut); ok {
-		return x.RequestPut
+func (m *BootstrapRequest) GetCompression() CompressionType {
+	if m != nil {
+		return m.Compression
 	}
-	return nil
+	return CompressionType_none
 }
 
-func (m *RequestOp) GetRequestDelete() *DeleteRequest {
-	if x, ok := m.GetRequest().(*RequestOp_RequestDelete); ok {
-		return x.RequestDelete
+func (m *BootstrapRequest) GetPrefixBloomLen() uint32 {
+	if m != nil {
+		return m.PrefixBloomLen
 	}
-	return nil
+	return 0
 }
 
-func (m *RequestOp) GetRequestGet() *GetRequest {
-	if x, ok := m.GetRequest().(*RequestOp_RequestGet); ok {
-		return x.RequestGet
+func (m *BootstrapRequest) GetMaxVersions() uint32 {
+	if m != nil {
+		return m.MaxVersions
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*RequestOp) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*RequestOp_RequestPut)(nil),
-		(*RequestOp_RequestDelete)(nil),
-		(*RequestOp_RequestGet)(nil),
+func (m *BootstrapRequest) GetVersionRetention() uint64 {
+	if m != nil {
+		return m.VersionRetention
 	}
+	return 0
 }
 
-type ResponseOp struct {
-	// Types that are valid to be assigned to Response:
-	//	*ResponseOp_ResponsePut
-	//	*ResponseOp_ResponseDelete
-	//	*ResponseOp_ResponseGet
-	Response isResponseOp_Response `protobuf_oneof:"response"`
+type BootstrapResponse struct {
+	PartID uint64 `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	Parent uint64 `protobuf:"varint,2,opt,name=parent,proto3" json:"parent,omitempty"`
 }
 
-func (m *ResponseOp) Reset()         { *m = ResponseOp{} }
-func (m *ResponseOp) String() string { return proto.CompactTextString(m) }
-func (*ResponseOp) ProtoMessage()    {}
-func (*ResponseOp) Descriptor() ([]byte, []int) {
+func (m *BootstrapResponse) Reset()         { *m = BootstrapResponse{} }
+func (m *BootstrapResponse) String() string { return proto.CompactTextString(m) }
+func (*BootstrapResponse) ProtoMessage()    {}
+func (*BootstrapResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3e3c719c85d382a4, []int{30}
 }
-func (m *ResponseOp) XXX_Unmarshal(b []byte) error {
+func (m *BootstrapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResponseOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BootstrapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResponseOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BootstrapResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1752,91 +1960,144 @@ func (m *ResponseOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ResponseOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResponseOp.Merge(m, src)
+func (m *BootstrapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BootstrapResponse.Merge(m, src)
 }
-func (m *ResponseOp) XXX_Size() int {
+func (m *BootstrapResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResponseOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResponseOp.DiscardUnknown(m)
+func (m *BootstrapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BootstrapResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResponseOp proto.InternalMessageInfo
+var xxx_messageInfo_BootstrapResponse proto.InternalMessageInfo
 
-type isResponseOp_Response interface {
-	isResponseOp_Response()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *BootstrapResponse) GetPartID() uint64 {
+	if m != nil {
+		return m.PartID
+	}
+	return 0
 }
 
-type ResponseOp_ResponsePut struct {
-	ResponsePut *PutResponse `protobuf:"bytes,1,opt,name=response_put,json=responsePut,proto3,oneof" json:"response_put,omitempty"`
-}
-type ResponseOp_ResponseDelete struct {
-	ResponseDelete *DeleteResponse `protobuf:"bytes,2,opt,name=response_delete,json=responseDelete,proto3,oneof" json:"response_delete,omitempty"`
-}
-type ResponseOp_ResponseGet struct {
-	ResponseGet *GetResponse `protobuf:"bytes,3,opt,name=response_get,json=responseGet,proto3,oneof" json:"response_get,omitempty"`
+func (m *BootstrapResponse) GetParent() uint64 {
+	if m != nil {
+		return m.Parent
+	}
+	return 0
 }
 
-func (*ResponseOp_ResponsePut) isResponseOp_Response()    {}
-func (*ResponseOp_ResponseDelete) isResponseOp_Response() {}
-func (*ResponseOp_ResponseGet) isResponseOp_Response()    {}
+//split partition into [startKey, splitKey) which keeps partID and streams
+//and [splitKey, endKey) which is a new partition
+type SplitPartitionRequest struct {
+	PartID       uint64          `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	SplitKey     []byte          `protobuf:"bytes,2,opt,name=splitKey,proto3" json:"splitKey,omitempty"`
+	LogID        uint64          `protobuf:"varint,3,opt,name=logID,proto3" json:"logID,omitempty"`
+	RowID        uint64          `protobuf:"varint,4,opt,name=rowID,proto3" json:"rowID,omitempty"`
+	Locs         *TableLocations `protobuf:"bytes,5,opt,name=locs,proto3" json:"locs,omitempty"`
+	BlobID       uint64          `protobuf:"varint,6,opt,name=blobID,proto3" json:"blobID,omitempty"`
+	SharedExtent uint64          `protobuf:"varint,7,opt,name=sharedExtent,proto3" json:"sharedExtent,omitempty"`
+}
 
-func (m *ResponseOp) GetResponse() isResponseOp_Response {
+func (m *SplitPartitionRequest) Reset()         { *m = SplitPartitionRequest{} }
+func (m *SplitPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*SplitPartitionRequest) ProtoMessage()    {}
+func (*SplitPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{31}
+}
+func (m *SplitPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitPartitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SplitPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitPartitionRequest.Merge(m, src)
+}
+func (m *SplitPartitionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitPartitionRequest proto.InternalMessageInfo
+
+func (m *SplitPartitionRequest) GetPartID() uint64 {
 	if m != nil {
-		return m.Response
+		return m.PartID
 	}
-	return nil
+	return 0
 }
 
-func (m *ResponseOp) GetResponsePut() *PutResponse {
-	if x, ok := m.GetResponse().(*ResponseOp_ResponsePut); ok {
-		return x.ResponsePut
+func (m *SplitPartitionRequest) GetSplitKey() []byte {
+	if m != nil {
+		return m.SplitKey
 	}
 	return nil
 }
 
-func (m *ResponseOp) GetResponseDelete() *DeleteResponse {
-	if x, ok := m.GetResponse().(*ResponseOp_ResponseDelete); ok {
-		return x.ResponseDelete
+func (m *SplitPartitionRequest) GetLogID() uint64 {
+	if m != nil {
+		return m.LogID
 	}
-	return nil
+	return 0
 }
 
-func (m *ResponseOp) GetResponseGet() *GetResponse {
-	if x, ok := m.GetResponse().(*ResponseOp_ResponseGet); ok {
-		return x.ResponseGet
+func (m *SplitPartitionRequest) GetRowID() uint64 {
+	if m != nil {
+		return m.RowID
+	}
+	return 0
+}
+
+func (m *SplitPartitionRequest) GetLocs() *TableLocations {
+	if m != nil {
+		return m.Locs
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ResponseOp) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ResponseOp_ResponsePut)(nil),
-		(*ResponseOp_ResponseDelete)(nil),
-		(*ResponseOp_ResponseGet)(nil),
+func (m *SplitPartitionRequest) GetBlobID() uint64 {
+	if m != nil {
+		return m.BlobID
 	}
+	return 0
 }
 
-type BatchRequest struct {
-	Req []*RequestOp `protobuf:"bytes,1,rep,name=req,proto3" json:"req,omitempty"`
+func (m *SplitPartitionRequest) GetSharedExtent() uint64 {
+	if m != nil {
+		return m.SharedExtent
+	}
+	return 0
 }
 
-func (m *BatchRequest) Reset()         { *m = BatchRequest{} }
-func (m *BatchRequest) String() string { return proto.CompactTextString(m) }
-func (*BatchRequest) ProtoMessage()    {}
-func (*BatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{31}
+type SplitPartitionResponse struct {
+	Code      pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes   string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	NewPartID uint64  `protobuf:"varint,3,opt,name=newPartID,proto3" json:"newPartID,omitempty"`
 }
-func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *SplitPartitionResponse) Reset()         { *m = SplitPartitionResponse{} }
+func (m *SplitPartitionResponse) String() string { return proto.CompactTextString(m) }
+func (*SplitPartitionResponse) ProtoMessage()    {}
+func (*SplitPartitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{32}
+}
+func (m *SplitPartitionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SplitPartitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SplitPartitionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1846,41 +2107,58 @@ func (m *BatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *BatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchRequest.Merge(m, src)
+func (m *SplitPartitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitPartitionResponse.Merge(m, src)
 }
-func (m *BatchRequest) XXX_Size() int {
+func (m *SplitPartitionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchRequest.DiscardUnknown(m)
+func (m *SplitPartitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitPartitionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchRequest proto.InternalMessageInfo
+var xxx_messageInfo_SplitPartitionResponse proto.InternalMessageInfo
 
-func (m *BatchRequest) GetReq() []*RequestOp {
+func (m *SplitPartitionResponse) GetCode() pb.Code {
 	if m != nil {
-		return m.Req
+		return m.Code
 	}
-	return nil
+	return pb.Code_OK
 }
 
-type BatchResponse struct {
-	Res []*RequestOp `protobuf:"bytes,1,rep,name=res,proto3" json:"res,omitempty"`
+func (m *SplitPartitionResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
 }
 
-func (m *BatchResponse) Reset()         { *m = BatchResponse{} }
-func (m *BatchResponse) String() string { return proto.CompactTextString(m) }
-func (*BatchResponse) ProtoMessage()    {}
-func (*BatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{32}
+func (m *SplitPartitionResponse) GetNewPartID() uint64 {
+	if m != nil {
+		return m.NewPartID
+	}
+	return 0
 }
-func (m *BatchResponse) XXX_Unmarshal(b []byte) error {
+
+//merge partID and rightPartID whose ranges are contiguous, partID survives
+type MergePartitionRequest struct {
+	PartID      uint64          `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	RightPartID uint64          `protobuf:"varint,2,opt,name=rightPartID,proto3" json:"rightPartID,omitempty"`
+	Locs        *TableLocations `protobuf:"bytes,4,opt,name=locs,proto3" json:"locs,omitempty"`
+}
+
+func (m *MergePartitionRequest) Reset()         { *m = MergePartitionRequest{} }
+func (m *MergePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*MergePartitionRequest) ProtoMessage()    {}
+func (*MergePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{33}
+}
+func (m *MergePartitionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MergePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MergePartitionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1890,46 +2168,56 @@ func (m *BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *BatchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchResponse.Merge(m, src)
+func (m *MergePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergePartitionRequest.Merge(m, src)
 }
-func (m *BatchResponse) XXX_Size() int {
+func (m *MergePartitionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchResponse.DiscardUnknown(m)
+func (m *MergePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergePartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchResponse proto.InternalMessageInfo
+var xxx_messageInfo_MergePartitionRequest proto.InternalMessageInfo
 
-func (m *BatchResponse) GetRes() []*RequestOp {
+func (m *MergePartitionRequest) GetPartID() uint64 {
 	if m != nil {
-		return m.Res
+		return m.PartID
+	}
+	return 0
+}
+
+func (m *MergePartitionRequest) GetRightPartID() uint64 {
+	if m != nil {
+		return m.RightPartID
+	}
+	return 0
+}
+
+func (m *MergePartitionRequest) GetLocs() *TableLocations {
+	if m != nil {
+		return m.Locs
 	}
 	return nil
 }
 
-//return message KeyValue?
-type RangeRequest struct {
-	Prefix    []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	Start     []byte `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	Limit     uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Partid    uint64 `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
-	Psversion uint64 `protobuf:"varint,5,opt,name=psversion,proto3" json:"psversion,omitempty"`
+type MergePartitionResponse struct {
+	Code    pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
 
-func (m *RangeRequest) Reset()         { *m = RangeRequest{} }
-func (m *RangeRequest) String() string { return proto.CompactTextString(m) }
-func (*RangeRequest) ProtoMessage()    {}
-func (*RangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{33}
+func (m *MergePartitionResponse) Reset()         { *m = MergePartitionResponse{} }
+func (m *MergePartitionResponse) String() string { return proto.CompactTextString(m) }
+func (*MergePartitionResponse) ProtoMessage()    {}
+func (*MergePartitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{34}
 }
-func (m *RangeRequest) XXX_Unmarshal(b []byte) error {
+func (m *MergePartitionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MergePartitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RangeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MergePartitionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1939,70 +2227,102 @@ func (m *RangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RangeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RangeRequest.Merge(m, src)
+func (m *MergePartitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergePartitionResponse.Merge(m, src)
 }
-func (m *RangeRequest) XXX_Size() int {
+func (m *MergePartitionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RangeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RangeRequest.DiscardUnknown(m)
+func (m *MergePartitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergePartitionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RangeRequest proto.InternalMessageInfo
+var xxx_messageInfo_MergePartitionResponse proto.InternalMessageInfo
 
-func (m *RangeRequest) GetPrefix() []byte {
+func (m *MergePartitionResponse) GetCode() pb.Code {
 	if m != nil {
-		return m.Prefix
+		return m.Code
 	}
-	return nil
+	return pb.Code_OK
 }
 
-func (m *RangeRequest) GetStart() []byte {
+func (m *MergePartitionResponse) GetCodeDes() string {
 	if m != nil {
-		return m.Start
+		return m.CodeDes
 	}
-	return nil
+	return ""
 }
 
-func (m *RangeRequest) GetLimit() uint32 {
-	if m != nil {
-		return m.Limit
+//streams of merged partitions which are still read by partID
+type SetMergedStreamsRequest struct {
+	PartID uint64         `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	Merged *MergedStreams `protobuf:"bytes,2,opt,name=merged,proto3" json:"merged,omitempty"`
+}
+
+func (m *SetMergedStreamsRequest) Reset()         { *m = SetMergedStreamsRequest{} }
+func (m *SetMergedStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMergedStreamsRequest) ProtoMessage()    {}
+func (*SetMergedStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{35}
+}
+func (m *SetMergedStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetMergedStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetMergedStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *SetMergedStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMergedStreamsRequest.Merge(m, src)
+}
+func (m *SetMergedStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetMergedStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMergedStreamsRequest.DiscardUnknown(m)
 }
 
-func (m *RangeRequest) GetPartid() uint64 {
+var xxx_messageInfo_SetMergedStreamsRequest proto.InternalMessageInfo
+
+func (m *SetMergedStreamsRequest) GetPartID() uint64 {
 	if m != nil {
-		return m.Partid
+		return m.PartID
 	}
 	return 0
 }
 
-func (m *RangeRequest) GetPsversion() uint64 {
+func (m *SetMergedStreamsRequest) GetMerged() *MergedStreams {
 	if m != nil {
-		return m.Psversion
+		return m.Merged
 	}
-	return 0
+	return nil
 }
 
-type RangeResponse struct {
-	Truncated uint32   `protobuf:"varint,1,opt,name=truncated,proto3" json:"truncated,omitempty"`
-	Keys      [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+type SetMergedStreamsResponse struct {
+	Code    pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
 
-func (m *RangeResponse) Reset()         { *m = RangeResponse{} }
-func (m *RangeResponse) String() string { return proto.CompactTextString(m) }
-func (*RangeResponse) ProtoMessage()    {}
-func (*RangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e3c719c85d382a4, []int{34}
+func (m *SetMergedStreamsResponse) Reset()         { *m = SetMergedStreamsResponse{} }
+func (m *SetMergedStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetMergedStreamsResponse) ProtoMessage()    {}
+func (*SetMergedStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{36}
 }
-func (m *RangeResponse) XXX_Unmarshal(b []byte) error {
+func (m *SetMergedStreamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetMergedStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RangeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetMergedStreamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2012,2832 +2332,18800 @@ func (m *RangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *RangeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RangeResponse.Merge(m, src)
+func (m *SetMergedStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMergedStreamsResponse.Merge(m, src)
 }
-func (m *RangeResponse) XXX_Size() int {
+func (m *SetMergedStreamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RangeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RangeResponse.DiscardUnknown(m)
+func (m *SetMergedStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMergedStreamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RangeResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetMergedStreamsResponse proto.InternalMessageInfo
 
-func (m *RangeResponse) GetTruncated() uint32 {
+func (m *SetMergedStreamsResponse) GetCode() pb.Code {
 	if m != nil {
-		return m.Truncated
+		return m.Code
 	}
-	return 0
+	return pb.Code_OK
 }
 
-func (m *RangeResponse) GetKeys() [][]byte {
+func (m *SetMergedStreamsResponse) GetCodeDes() string {
 	if m != nil {
-		return m.Keys
+		return m.CodeDes
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("pspb.RawBlockType", RawBlockType_name, RawBlockType_value)
-	proto.RegisterType((*MixedLog)(nil), "pspb.MixedLog")
-	proto.RegisterType((*Range)(nil), "pspb.Range")
-	proto.RegisterType((*Location)(nil), "pspb.Location")
-	proto.RegisterType((*BlobStreams)(nil), "pspb.BlobStreams")
-	proto.RegisterType((*TableLocations)(nil), "pspb.TableLocations")
-	proto.RegisterType((*PartitionMeta)(nil), "pspb.PartitionMeta")
-	proto.RegisterType((*PSDetail)(nil), "pspb.PSDetail")
-	proto.RegisterType((*RegionInfo)(nil), "pspb.RegionInfo")
-	proto.RegisterType((*RawBlockMeta)(nil), "pspb.RawBlockMeta")
-	proto.RegisterType((*BlockOffset)(nil), "pspb.BlockOffset")
-	proto.RegisterType((*TableIndex)(nil), "pspb.TableIndex")
-	proto.RegisterType((*GetPartitionMetaRequest)(nil), "pspb.GetPartitionMetaRequest")
-	proto.RegisterType((*GetPartitionMetaResponse)(nil), "pspb.GetPartitionMetaResponse")
-	proto.RegisterType((*SetRowStreamTablesRequest)(nil), "pspb.SetRowStreamTablesRequest")
-	proto.RegisterType((*SetRowStreamTablesResponse)(nil), "pspb.SetRowStreamTablesResponse")
-	proto.RegisterType((*GetRegionsRequest)(nil), "pspb.GetRegionsRequest")
-	proto.RegisterType((*GetRegionsResponse)(nil), "pspb.GetRegionsResponse")
-	proto.RegisterType((*RegisterPSRequest)(nil), "pspb.RegisterPSRequest")
-	proto.RegisterType((*RegisterPSResponse)(nil), "pspb.RegisterPSResponse")
-	proto.RegisterType((*GetPSInfoRequest)(nil), "pspb.GetPSInfoRequest")
-	proto.RegisterType((*GetPSInfoResponse)(nil), "pspb.GetPSInfoResponse")
-	proto.RegisterType((*BootstrapRequest)(nil), "pspb.BootstrapRequest")
-	proto.RegisterType((*BootstrapResponse)(nil), "pspb.BootstrapResponse")
-	proto.RegisterType((*PutRequest)(nil), "pspb.PutRequest")
-	proto.RegisterType((*PutResponse)(nil), "pspb.PutResponse")
-	proto.RegisterType((*DeleteRequest)(nil), "pspb.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "pspb.DeleteResponse")
-	proto.RegisterType((*GetRequest)(nil), "pspb.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "pspb.GetResponse")
-	proto.RegisterType((*RequestOp)(nil), "pspb.RequestOp")
-	proto.RegisterType((*ResponseOp)(nil), "pspb.ResponseOp")
-	proto.RegisterType((*BatchRequest)(nil), "pspb.BatchRequest")
-	proto.RegisterType((*BatchResponse)(nil), "pspb.BatchResponse")
-	proto.RegisterType((*RangeRequest)(nil), "pspb.RangeRequest")
-	proto.RegisterType((*RangeResponse)(nil), "pspb.RangeResponse")
+type SetDiscardRequest struct {
+	PartID  uint64        `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	Discard *DiscardStats `protobuf:"bytes,2,opt,name=discard,proto3" json:"discard,omitempty"`
 }
 
-func init() { proto.RegisterFile("pspb.proto", fileDescriptor_3e3c719c85d382a4) }
-
-var fileDescriptor_3e3c719c85d382a4 = []byte{
-	// 1426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xdf, 0xb5, 0xd7, 0x89, 0xfd, 0x1c, 0xbb, 0xce, 0x34, 0x6a, 0x8c, 0x29, 0x6e, 0x3a, 0xaa,
-	0xda, 0xa8, 0x85, 0x48, 0xb8, 0x14, 0x21, 0xfe, 0x14, 0xea, 0xa6, 0xa4, 0x51, 0x5b, 0x12, 0x8d,
-	0x4b, 0x11, 0x17, 0xd0, 0xda, 0x3b, 0x31, 0xab, 0xd8, 0xbb, 0xdb, 0xdd, 0x71, 0x9a, 0x70, 0x47,
-	0xea, 0x91, 0xef, 0xc0, 0xf7, 0xe0, 0x0c, 0x27, 0x7a, 0xe4, 0x84, 0x50, 0xf3, 0x45, 0xd0, 0xfc,
-	0xdb, 0x9d, 0xcd, 0xda, 0x6d, 0x0e, 0xdc, 0xf6, 0xfd, 0x7f, 0x6f, 0xe6, 0xfd, 0xde, 0x9b, 0x05,
-	0x88, 0x92, 0x68, 0xb8, 0x15, 0xc5, 0x21, 0x0b, 0x91, 0xc3, 0xbf, 0x3b, 0x55, 0x4d, 0xe3, 0x6b,
-	0x50, 0x7d, 0xe2, 0x1f, 0x53, 0xef, 0x71, 0x38, 0x46, 0x6d, 0x58, 0x0e, 0x0f, 0x0e, 0x12, 0xca,
-	0x92, 0xb6, 0xbd, 0x51, 0xde, 0x6c, 0x10, 0x4d, 0xe2, 0xcf, 0xa0, 0x42, 0xdc, 0x60, 0x4c, 0x51,
-	0x07, 0xaa, 0x09, 0x73, 0x63, 0xf6, 0x88, 0x9e, 0xb4, 0xed, 0x0d, 0x7b, 0x73, 0x85, 0xa4, 0x34,
-	0xba, 0x04, 0x4b, 0x34, 0xf0, 0xb8, 0xa4, 0x24, 0x24, 0x8a, 0xc2, 0x77, 0xa1, 0xfa, 0x38, 0x1c,
-	0xb9, 0xcc, 0x0f, 0x03, 0x6e, 0x4f, 0x8f, 0x19, 0x0d, 0xd8, 0xee, 0xb6, 0xb0, 0x77, 0x48, 0x4a,
-	0x73, 0x7b, 0x19, 0x4f, 0xd8, 0x37, 0x88, 0xa2, 0xf0, 0x55, 0xa8, 0xf7, 0x27, 0xe1, 0x70, 0xc0,
-	0x62, 0xea, 0x4e, 0x13, 0x84, 0xc0, 0x19, 0x4e, 0xc2, 0xa1, 0x48, 0xd1, 0x21, 0xe2, 0x1b, 0x7f,
-	0x04, 0xcd, 0xa7, 0xee, 0x70, 0x42, 0x75, 0x9c, 0x04, 0x61, 0x70, 0x26, 0xe1, 0x48, 0x16, 0x52,
-	0xef, 0x35, 0xb7, 0xc4, 0x11, 0x68, 0x31, 0x11, 0x32, 0xfc, 0x4b, 0x09, 0x1a, 0xfb, 0x6e, 0xcc,
-	0x7c, 0xce, 0x7b, 0x42, 0x99, 0x8b, 0x6e, 0x40, 0x85, 0xfb, 0x4b, 0x44, 0x6e, 0xf5, 0xde, 0xaa,
-	0x34, 0x33, 0xa2, 0x13, 0x29, 0x47, 0x97, 0xa1, 0x36, 0x09, 0xc7, 0x92, 0x29, 0xd2, 0x75, 0x48,
-	0xc6, 0xe0, 0xd2, 0x38, 0x7c, 0xa1, 0xa4, 0x65, 0x29, 0x4d, 0x19, 0x68, 0x53, 0xa5, 0xe6, 0x88,
-	0x18, 0x6b, 0x32, 0x46, 0x3e, 0x7d, 0x99, 0x20, 0x3f, 0x91, 0xc8, 0x8d, 0x69, 0xc0, 0xda, 0x15,
-	0xe1, 0x44, 0x51, 0xfc, 0xa2, 0x3c, 0x3f, 0x19, 0xb9, 0xb1, 0xd7, 0x5e, 0x12, 0x47, 0xad, 0x49,
-	0xf4, 0x2e, 0x94, 0xe2, 0x71, 0x7b, 0x59, 0x78, 0xae, 0x4b, 0xcf, 0xe2, 0xe2, 0x48, 0x29, 0x1e,
-	0x73, 0x77, 0xbc, 0xdc, 0xdd, 0xed, 0x76, 0x55, 0xba, 0x93, 0x14, 0xfe, 0x04, 0xaa, 0xfb, 0x83,
-	0x6d, 0xca, 0x5c, 0x7f, 0xc2, 0x4f, 0x77, 0x7f, 0x90, 0x5e, 0x8e, 0xf8, 0xe6, 0xe1, 0x5c, 0xcf,
-	0x8b, 0x69, 0x92, 0x88, 0x52, 0x6b, 0x44, 0x93, 0xd8, 0x07, 0x20, 0x74, 0xec, 0x87, 0xc1, 0x6e,
-	0x70, 0x10, 0xaa, 0xe0, 0xf6, 0xdb, 0x82, 0x97, 0xcc, 0xe0, 0x69, 0xc0, 0xb2, 0x11, 0x10, 0x81,
-	0xc3, 0x23, 0x88, 0x13, 0xaa, 0x11, 0xf1, 0x8d, 0xff, 0xb1, 0x61, 0x85, 0xb8, 0x2f, 0xfa, 0x93,
-	0x70, 0x74, 0x28, 0xee, 0xea, 0x3a, 0x38, 0xec, 0x24, 0xa2, 0x22, 0x5e, 0xb3, 0x87, 0x74, 0x3c,
-	0xa9, 0xf1, 0xf4, 0x24, 0xa2, 0x44, 0xc8, 0xd1, 0x75, 0x68, 0xde, 0x0f, 0xa7, 0x11, 0xcf, 0x97,
-	0x7a, 0x03, 0xff, 0x67, 0xaa, 0xda, 0xeb, 0x0c, 0x17, 0xdd, 0x84, 0xd6, 0xb7, 0xc1, 0x19, 0xcd,
-	0xb2, 0xd0, 0x2c, 0xf0, 0x51, 0x17, 0xe0, 0x28, 0x7a, 0xa0, 0x1b, 0xd9, 0x11, 0xa9, 0x1b, 0x1c,
-	0xde, 0xe6, 0x47, 0xd1, 0x9e, 0x6c, 0xe6, 0x8a, 0xf0, 0x91, 0xd2, 0xfc, 0x20, 0x12, 0xfa, 0xfc,
-	0x9b, 0xd9, 0x54, 0xdc, 0x9d, 0x43, 0x14, 0x85, 0x07, 0xa2, 0xcd, 0x47, 0x87, 0x4a, 0xad, 0x05,
-	0xe5, 0xc3, 0x14, 0x64, 0xfc, 0x33, 0x87, 0x9d, 0xd2, 0x42, 0xec, 0x94, 0x73, 0xd8, 0xf9, 0xcd,
-	0x06, 0x10, 0xad, 0xb5, 0x1b, 0x78, 0xf4, 0x18, 0xdd, 0xca, 0x23, 0xdc, 0xec, 0x70, 0x1d, 0x38,
-	0x05, 0x3d, 0xda, 0x80, 0xfa, 0x70, 0x12, 0x86, 0xd3, 0xaf, 0xfd, 0x09, 0xa3, 0xb1, 0x02, 0xb5,
-	0xc9, 0x42, 0xd7, 0xa0, 0x41, 0x13, 0xe6, 0x4f, 0x5d, 0x66, 0x9c, 0x97, 0x43, 0xf2, 0x4c, 0xee,
-	0x27, 0x98, 0x4d, 0xf7, 0x0e, 0x44, 0x10, 0xd9, 0xf6, 0x0d, 0x62, 0xb2, 0xf0, 0x07, 0xb0, 0xbe,
-	0x43, 0x59, 0x0e, 0x8a, 0x84, 0x3e, 0x9f, 0xd1, 0x84, 0xcd, 0xeb, 0x47, 0xec, 0x42, 0xbb, 0xa8,
-	0x9e, 0x44, 0x61, 0x90, 0x50, 0x74, 0x19, 0x9c, 0x51, 0xe8, 0xe9, 0xae, 0xa8, 0x6e, 0x45, 0xc3,
-	0xad, 0xfb, 0xa1, 0x47, 0x89, 0xe0, 0xa2, 0x1b, 0xe0, 0x4c, 0x29, 0x73, 0xdb, 0x25, 0x51, 0xfc,
-	0x45, 0x59, 0x7c, 0xde, 0x91, 0x50, 0xc0, 0x63, 0x78, 0x67, 0x40, 0x19, 0xd1, 0x98, 0x15, 0x47,
-	0x98, 0xe8, 0x9c, 0x36, 0xa0, 0x1e, 0x69, 0x9b, 0x34, 0x35, 0x93, 0x95, 0x42, 0xbc, 0xf4, 0x36,
-	0x88, 0xe3, 0x4f, 0xa1, 0x33, 0x2f, 0xd0, 0x79, 0xaa, 0xc1, 0x17, 0x61, 0x75, 0x87, 0x32, 0x09,
-	0x40, 0x9d, 0x1c, 0xfe, 0x01, 0x90, 0xc9, 0x3c, 0xd7, 0xb1, 0xdc, 0x84, 0xe5, 0x58, 0x1a, 0xa8,
-	0x93, 0x69, 0x29, 0x34, 0xa5, 0xd8, 0x26, 0x5a, 0x01, 0xdf, 0x80, 0x55, 0xce, 0x4e, 0x18, 0x8d,
-	0xf7, 0x07, 0xc6, 0x2d, 0x09, 0xc0, 0xda, 0x06, 0x60, 0xfb, 0x80, 0x4c, 0xc5, 0x73, 0x25, 0xd2,
-	0x84, 0x92, 0xef, 0xa9, 0xe6, 0x2e, 0xf9, 0x1e, 0x46, 0xd0, 0xe2, 0x37, 0x3d, 0x10, 0x29, 0xa8,
-	0x02, 0xbf, 0x10, 0x55, 0x6b, 0x9e, 0x72, 0xbb, 0x09, 0xcb, 0x09, 0x8d, 0x8f, 0x68, 0x7c, 0x66,
-	0xe2, 0xeb, 0xb9, 0x46, 0xb4, 0x18, 0x3f, 0x83, 0x56, 0x3f, 0x0c, 0x59, 0xc2, 0x62, 0x37, 0xd2,
-	0xe9, 0xaf, 0x41, 0x65, 0x12, 0x8e, 0xd3, 0xab, 0x94, 0x04, 0xe7, 0xc6, 0xe1, 0x8b, 0x14, 0x6c,
-	0x92, 0x30, 0x66, 0x72, 0xd9, 0x9c, 0xc9, 0xf8, 0x16, 0xac, 0x1a, 0x7e, 0x55, 0x5a, 0x52, 0x39,
-	0x5b, 0x76, 0x8a, 0xc2, 0x2f, 0x6d, 0x80, 0xfd, 0x19, 0xd3, 0xf1, 0x8b, 0x58, 0x5f, 0x83, 0xca,
-	0x91, 0x3b, 0x99, 0x51, 0x85, 0x3a, 0x49, 0xf0, 0xbd, 0xf2, 0xe0, 0x38, 0xf2, 0x63, 0x9a, 0xdc,
-	0xd3, 0xe1, 0x33, 0x06, 0x97, 0x46, 0x09, 0xaf, 0xd1, 0x0f, 0x03, 0x35, 0x93, 0x32, 0x86, 0x4e,
-	0xc5, 0xf7, 0x8c, 0x5d, 0xc2, 0x7c, 0x0f, 0x5f, 0x81, 0xba, 0xc8, 0x44, 0x65, 0x5c, 0x48, 0x05,
-	0x7f, 0x07, 0x8d, 0x6d, 0x3a, 0xa1, 0x8c, 0x2e, 0xce, 0x36, 0x17, 0xb9, 0x74, 0xde, 0xc8, 0x5f,
-	0x41, 0x53, 0x3b, 0x5e, 0x14, 0xfc, 0xcd, 0x9e, 0xf1, 0x53, 0x00, 0xd1, 0xeb, 0xff, 0x6f, 0x5e,
-	0x77, 0xa0, 0x2e, 0xbc, 0x2e, 0x4c, 0x6a, 0xee, 0xe5, 0xe0, 0xdf, 0x6d, 0xa8, 0xa9, 0x54, 0xf6,
-	0x22, 0x74, 0x1b, 0xea, 0xb1, 0x24, 0x7e, 0x8c, 0x66, 0x4c, 0x2d, 0x45, 0x05, 0xab, 0xec, 0xe6,
-	0x1f, 0x5a, 0x04, 0x94, 0xda, 0xfe, 0x8c, 0xa1, 0xcf, 0xa1, 0xa9, 0x8d, 0x3c, 0x71, 0x32, 0x6a,
-	0x80, 0xa8, 0x41, 0x95, 0xbb, 0x86, 0x87, 0x16, 0x69, 0x28, 0x65, 0xc9, 0x37, 0x43, 0x8e, 0xd5,
-	0x22, 0x48, 0x43, 0x66, 0xc7, 0x64, 0x84, 0xdc, 0xa1, 0xac, 0x5f, 0xe3, 0xd0, 0x97, 0xc0, 0xfa,
-	0xd3, 0xe6, 0xdb, 0x5c, 0x56, 0xbd, 0x17, 0xa1, 0x8f, 0x61, 0x25, 0x56, 0x94, 0x51, 0xc2, 0xaa,
-	0x51, 0x82, 0x14, 0x3e, 0xb4, 0x48, 0x5d, 0x2b, 0xf2, 0x22, 0xbe, 0x84, 0x0b, 0xa9, 0x5d, 0xae,
-	0x8a, 0xb5, 0x7c, 0x15, 0xa9, 0x75, 0x53, 0xab, 0xab, 0x3a, 0xcc, 0xc0, 0x59, 0x21, 0xab, 0x46,
-	0x21, 0xc5, 0xc0, 0xbc, 0x14, 0x80, 0xaa, 0x26, 0xf1, 0x87, 0xb0, 0xd2, 0x77, 0xd9, 0xe8, 0x27,
-	0xdd, 0x1b, 0x57, 0xa1, 0x1c, 0xd3, 0xe7, 0x6a, 0x36, 0x5c, 0xd0, 0xd3, 0x4d, 0x5d, 0x16, 0xe1,
-	0x32, 0xdc, 0x83, 0x86, 0x32, 0x51, 0x17, 0x2f, 0x6c, 0x92, 0x37, 0xd8, 0x24, 0x1c, 0xc7, 0x2b,
-	0xf2, 0x89, 0xa3, 0xe2, 0xf0, 0x9e, 0x8a, 0xe9, 0x81, 0x7f, 0xac, 0xfa, 0x45, 0x51, 0xbc, 0x65,
-	0xc4, 0x3b, 0x59, 0xb7, 0x8c, 0x20, 0xc4, 0xdc, 0xf1, 0xa7, 0xbe, 0x5e, 0xda, 0x92, 0x30, 0xfa,
-	0xd2, 0x31, 0xfb, 0x32, 0xdf, 0xcd, 0x95, 0xb3, 0x58, 0xb8, 0x07, 0x0d, 0x95, 0x49, 0x3a, 0x69,
-	0x6b, 0x2c, 0x9e, 0x05, 0x23, 0xbe, 0x87, 0x45, 0x36, 0x0d, 0x92, 0x31, 0xf8, 0xc4, 0x3e, 0xa4,
-	0x27, 0x72, 0xde, 0xaf, 0x10, 0xf1, 0x7d, 0x13, 0x67, 0x2f, 0x2c, 0xfe, 0x7e, 0x42, 0x55, 0x70,
-	0x3c, 0x97, 0xb9, 0x2d, 0x8b, 0x7f, 0xf1, 0xb5, 0xd8, 0xb2, 0x7b, 0x7f, 0x95, 0x61, 0x3d, 0x5b,
-	0x98, 0x6e, 0xe0, 0x8e, 0x69, 0x3c, 0xa0, 0xf1, 0x91, 0x3f, 0xa2, 0xe8, 0x7b, 0x40, 0xc5, 0x5d,
-	0x86, 0xae, 0xc8, 0x93, 0x5b, 0xb8, 0x4e, 0x3b, 0x1b, 0x8b, 0x15, 0xd4, 0x6d, 0x5a, 0xe8, 0x9e,
-	0x7c, 0x68, 0xca, 0x65, 0x82, 0xd6, 0xb3, 0xf5, 0x94, 0xdb, 0x43, 0x9d, 0x76, 0x51, 0x60, 0xba,
-	0xc8, 0x16, 0xa3, 0x76, 0x51, 0xd8, 0x9f, 0xda, 0x45, 0x71, 0x87, 0x62, 0x0b, 0x0d, 0xe4, 0x3a,
-	0xca, 0xfd, 0x32, 0xbc, 0x97, 0xea, 0xcf, 0x7b, 0xbf, 0x74, 0xba, 0x8b, 0xc4, 0xa9, 0xd3, 0xbb,
-	0x50, 0x4b, 0xf7, 0x19, 0xba, 0x94, 0xa9, 0x9b, 0x4b, 0xaf, 0xb3, 0x5e, 0xe0, 0x9b, 0xf6, 0xe9,
-	0xe2, 0xd1, 0xf6, 0x67, 0x37, 0x9c, 0xb6, 0x2f, 0x6c, 0x28, 0x6c, 0xf5, 0x5e, 0x96, 0xa0, 0x9e,
-	0xe6, 0xf6, 0xe8, 0x19, 0xea, 0x41, 0x45, 0xe0, 0x00, 0xa9, 0x27, 0xb5, 0x89, 0xa3, 0xce, 0xc5,
-	0x1c, 0x2f, 0xcd, 0xe1, 0x7d, 0x28, 0x73, 0xe8, 0x17, 0xe6, 0x5b, 0xa7, 0x38, 0x2e, 0xa4, 0xf6,
-	0x0e, 0x4d, 0xb5, 0xb3, 0xd1, 0xd4, 0x29, 0x62, 0x1c, 0x5b, 0xe8, 0x0e, 0x2c, 0xa9, 0xc1, 0x30,
-	0x6f, 0x0c, 0x76, 0xe6, 0x4e, 0x15, 0x6c, 0xf1, 0x32, 0xe4, 0x2f, 0x2b, 0x32, 0xff, 0x44, 0xf2,
-	0x65, 0xe4, 0x00, 0x83, 0xad, 0x7e, 0xfb, 0x8f, 0xd7, 0x5d, 0xfb, 0xd5, 0xeb, 0xae, 0xfd, 0xef,
-	0xeb, 0xae, 0xfd, 0xeb, 0x69, 0xd7, 0x7a, 0x75, 0xda, 0xb5, 0xfe, 0x3e, 0xed, 0x5a, 0xc3, 0x25,
-	0xf1, 0xb7, 0x7c, 0xfb, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x9d, 0xde, 0x16, 0x4b, 0x0f,
-	0x00, 0x00,
+func (m *SetDiscardRequest) Reset()         { *m = SetDiscardRequest{} }
+func (m *SetDiscardRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDiscardRequest) ProtoMessage()    {}
+func (*SetDiscardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{37}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// PartitionManagerServiceClient is the client API for PartitionManagerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PartitionManagerServiceClient interface {
-	SetRowStreamTables(ctx context.Context, in *SetRowStreamTablesRequest, opts ...grpc.CallOption) (*SetRowStreamTablesResponse, error)
-	RegisterPS(ctx context.Context, in *RegisterPSRequest, opts ...grpc.CallOption) (*RegisterPSResponse, error)
-	GetRegions(ctx context.Context, in *GetRegionsRequest, opts ...grpc.CallOption) (*GetRegionsResponse, error)
-	GetPartitionMeta(ctx context.Context, in *GetPartitionMetaRequest, opts ...grpc.CallOption) (*GetPartitionMetaResponse, error)
-	GetPSInfo(ctx context.Context, in *GetPSInfoRequest, opts ...grpc.CallOption) (*GetPSInfoResponse, error)
-	Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error)
+func (m *SetDiscardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type partitionManagerServiceClient struct {
-	cc *grpc.ClientConn
+func (m *SetDiscardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDiscardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewPartitionManagerServiceClient(cc *grpc.ClientConn) PartitionManagerServiceClient {
-	return &partitionManagerServiceClient{cc}
+func (m *SetDiscardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDiscardRequest.Merge(m, src)
+}
+func (m *SetDiscardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDiscardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDiscardRequest.DiscardUnknown(m)
 }
 
-func (c *partitionManagerServiceClient) SetRowStreamTables(ctx context.Context, in *SetRowStreamTablesRequest, opts ...grpc.CallOption) (*SetRowStreamTablesResponse, error) {
-	out := new(SetRowStreamTablesResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/SetRowStreamTables", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetDiscardRequest proto.InternalMessageInfo
+
+func (m *SetDiscardRequest) GetPartID() uint64 {
+	if m != nil {
+		return m.PartID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *partitionManagerServiceClient) RegisterPS(ctx context.Context, in *RegisterPSRequest, opts ...grpc.CallOption) (*RegisterPSResponse, error) {
-	out := new(RegisterPSResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/RegisterPS", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetDiscardRequest) GetDiscard() *DiscardStats {
+	if m != nil {
+		return m.Discard
 	}
-	return out, nil
+	return nil
 }
 
-func (c *partitionManagerServiceClient) GetRegions(ctx context.Context, in *GetRegionsRequest, opts ...grpc.CallOption) (*GetRegionsResponse, error) {
-	out := new(GetRegionsResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/GetRegions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SetDiscardResponse struct {
+	Code    pb.Code       `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string        `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Discard *DiscardStats `protobuf:"bytes,3,opt,name=discard,proto3" json:"discard,omitempty"`
 }
 
-func (c *partitionManagerServiceClient) GetPartitionMeta(ctx context.Context, in *GetPartitionMetaRequest, opts ...grpc.CallOption) (*GetPartitionMetaResponse, error) {
-	out := new(GetPartitionMetaResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/GetPartitionMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetDiscardResponse) Reset()         { *m = SetDiscardResponse{} }
+func (m *SetDiscardResponse) String() string { return proto.CompactTextString(m) }
+func (*SetDiscardResponse) ProtoMessage()    {}
+func (*SetDiscardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{38}
+}
+func (m *SetDiscardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDiscardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDiscardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SetDiscardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDiscardResponse.Merge(m, src)
+}
+func (m *SetDiscardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDiscardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDiscardResponse.DiscardUnknown(m)
 }
 
-func (c *partitionManagerServiceClient) GetPSInfo(ctx context.Context, in *GetPSInfoRequest, opts ...grpc.CallOption) (*GetPSInfoResponse, error) {
-	out := new(GetPSInfoResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/GetPSInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetDiscardResponse proto.InternalMessageInfo
+
+func (m *SetDiscardResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	return out, nil
+	return pb.Code_OK
 }
 
-func (c *partitionManagerServiceClient) Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error) {
-	out := new(BootstrapResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/Bootstrap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetDiscardResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return out, nil
+	return ""
 }
 
-// PartitionManagerServiceServer is the server API for PartitionManagerService service.
-type PartitionManagerServiceServer interface {
-	SetRowStreamTables(context.Context, *SetRowStreamTablesRequest) (*SetRowStreamTablesResponse, error)
-	RegisterPS(context.Context, *RegisterPSRequest) (*RegisterPSResponse, error)
-	GetRegions(context.Context, *GetRegionsRequest) (*GetRegionsResponse, error)
-	GetPartitionMeta(context.Context, *GetPartitionMetaRequest) (*GetPartitionMetaResponse, error)
-	GetPSInfo(context.Context, *GetPSInfoRequest) (*GetPSInfoResponse, error)
-	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
+func (m *SetDiscardResponse) GetDiscard() *DiscardStats {
+	if m != nil {
+		return m.Discard
+	}
+	return nil
 }
 
-// UnimplementedPartitionManagerServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedPartitionManagerServiceServer struct {
+//tables of partitions other than partID, they may be in the row stream of partID after split
+type GetSharedTablesRequest struct {
+	PartID uint64 `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
 }
 
-func (*UnimplementedPartitionManagerServiceServer) SetRowStreamTables(ctx context.Context, req *SetRowStreamTablesRequest) (*SetRowStreamTablesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRowStreamTables not implemented")
+func (m *GetSharedTablesRequest) Reset()         { *m = GetSharedTablesRequest{} }
+func (m *GetSharedTablesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSharedTablesRequest) ProtoMessage()    {}
+func (*GetSharedTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{39}
 }
-func (*UnimplementedPartitionManagerServiceServer) RegisterPS(ctx context.Context, req *RegisterPSRequest) (*RegisterPSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterPS not implemented")
+func (m *GetSharedTablesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedPartitionManagerServiceServer) GetRegions(ctx context.Context, req *GetRegionsRequest) (*GetRegionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRegions not implemented")
+func (m *GetSharedTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSharedTablesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedPartitionManagerServiceServer) GetPartitionMeta(ctx context.Context, req *GetPartitionMetaRequest) (*GetPartitionMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionMeta not implemented")
+func (m *GetSharedTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSharedTablesRequest.Merge(m, src)
 }
-func (*UnimplementedPartitionManagerServiceServer) GetPSInfo(ctx context.Context, req *GetPSInfoRequest) (*GetPSInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPSInfo not implemented")
+func (m *GetSharedTablesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedPartitionManagerServiceServer) Bootstrap(ctx context.Context, req *BootstrapRequest) (*BootstrapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bootstrap not implemented")
+func (m *GetSharedTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSharedTablesRequest.DiscardUnknown(m)
 }
 
-func RegisterPartitionManagerServiceServer(s *grpc.Server, srv PartitionManagerServiceServer) {
-	s.RegisterService(&_PartitionManagerService_serviceDesc, srv)
-}
+var xxx_messageInfo_GetSharedTablesRequest proto.InternalMessageInfo
 
-func _PartitionManagerService_SetRowStreamTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRowStreamTablesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionManagerServiceServer).SetRowStreamTables(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionManagerService/SetRowStreamTables",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionManagerServiceServer).SetRowStreamTables(ctx, req.(*SetRowStreamTablesRequest))
+func (m *GetSharedTablesRequest) GetPartID() uint64 {
+	if m != nil {
+		return m.PartID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _PartitionManagerService_RegisterPS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterPSRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionManagerServiceServer).RegisterPS(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionManagerService/RegisterPS",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionManagerServiceServer).RegisterPS(ctx, req.(*RegisterPSRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetSharedTablesResponse struct {
+	Code    pb.Code     `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string      `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Locs    []*Location `protobuf:"bytes,3,rep,name=locs,proto3" json:"locs,omitempty"`
 }
 
-func _PartitionManagerService_GetRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionManagerServiceServer).GetRegions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionManagerService/GetRegions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionManagerServiceServer).GetRegions(ctx, req.(*GetRegionsRequest))
+func (m *GetSharedTablesResponse) Reset()         { *m = GetSharedTablesResponse{} }
+func (m *GetSharedTablesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSharedTablesResponse) ProtoMessage()    {}
+func (*GetSharedTablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{40}
+}
+func (m *GetSharedTablesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSharedTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSharedTablesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetSharedTablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSharedTablesResponse.Merge(m, src)
+}
+func (m *GetSharedTablesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSharedTablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSharedTablesResponse.DiscardUnknown(m)
 }
 
-func _PartitionManagerService_GetPartitionMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartitionMetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionManagerServiceServer).GetPartitionMeta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionManagerService/GetPartitionMeta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionManagerServiceServer).GetPartitionMeta(ctx, req.(*GetPartitionMetaRequest))
+var xxx_messageInfo_GetSharedTablesResponse proto.InternalMessageInfo
+
+func (m *GetSharedTablesResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return pb.Code_OK
 }
 
-func _PartitionManagerService_GetPSInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPSInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionManagerServiceServer).GetPSInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionManagerService/GetPSInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionManagerServiceServer).GetPSInfo(ctx, req.(*GetPSInfoRequest))
+func (m *GetSharedTablesResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PartitionManagerService_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BootstrapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionManagerServiceServer).Bootstrap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionManagerService/Bootstrap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionManagerServiceServer).Bootstrap(ctx, req.(*BootstrapRequest))
+func (m *GetSharedTablesResponse) GetLocs() []*Location {
+	if m != nil {
+		return m.Locs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _PartitionManagerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pspb.PartitionManagerService",
-	HandlerType: (*PartitionManagerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetRowStreamTables",
-			Handler:    _PartitionManagerService_SetRowStreamTables_Handler,
-		},
-		{
-			MethodName: "RegisterPS",
-			Handler:    _PartitionManagerService_RegisterPS_Handler,
-		},
-		{
-			MethodName: "GetRegions",
-			Handler:    _PartitionManagerService_GetRegions_Handler,
-		},
-		{
-			MethodName: "GetPartitionMeta",
-			Handler:    _PartitionManagerService_GetPartitionMeta_Handler,
-		},
-		{
-			MethodName: "GetPSInfo",
-			Handler:    _PartitionManagerService_GetPSInfo_Handler,
-		},
-		{
-			MethodName: "Bootstrap",
-			Handler:    _PartitionManagerService_Bootstrap_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pspb.proto",
+//append streamID to blob streams of partID, values bigger than the threshold of PS are written to it
+type AddBlobStreamRequest struct {
+	PartID   uint64 `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	StreamID uint64 `protobuf:"varint,2,opt,name=streamID,proto3" json:"streamID,omitempty"`
 }
 
-// PartitionKVClient is the client API for PartitionKV service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PartitionKVClient interface {
-	//
-	//option (google.api.http) = {
-	//post: "/v3/kv/txn"
-	//body: "*"
-	//};
-	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
-	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
-	Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error)
+func (m *AddBlobStreamRequest) Reset()         { *m = AddBlobStreamRequest{} }
+func (m *AddBlobStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*AddBlobStreamRequest) ProtoMessage()    {}
+func (*AddBlobStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{41}
 }
-
-type partitionKVClient struct {
-	cc *grpc.ClientConn
+func (m *AddBlobStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewPartitionKVClient(cc *grpc.ClientConn) PartitionKVClient {
-	return &partitionKVClient{cc}
+func (m *AddBlobStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddBlobStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddBlobStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddBlobStreamRequest.Merge(m, src)
+}
+func (m *AddBlobStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddBlobStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddBlobStreamRequest.DiscardUnknown(m)
 }
 
-func (c *partitionKVClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
-	out := new(BatchResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Batch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AddBlobStreamRequest proto.InternalMessageInfo
+
+func (m *AddBlobStreamRequest) GetPartID() uint64 {
+	if m != nil {
+		return m.PartID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *partitionKVClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
-	out := new(PutResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Put", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddBlobStreamRequest) GetStreamID() uint64 {
+	if m != nil {
+		return m.StreamID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *partitionKVClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AddBlobStreamResponse struct {
+	Code    pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
 }
 
-func (c *partitionKVClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddBlobStreamResponse) Reset()         { *m = AddBlobStreamResponse{} }
+func (m *AddBlobStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*AddBlobStreamResponse) ProtoMessage()    {}
+func (*AddBlobStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{42}
+}
+func (m *AddBlobStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddBlobStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddBlobStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AddBlobStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddBlobStreamResponse.Merge(m, src)
+}
+func (m *AddBlobStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddBlobStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddBlobStreamResponse.DiscardUnknown(m)
 }
 
-func (c *partitionKVClient) Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error) {
-	out := new(RangeResponse)
-	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Range", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AddBlobStreamResponse proto.InternalMessageInfo
+
+func (m *AddBlobStreamResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	return out, nil
+	return pb.Code_OK
 }
 
-// PartitionKVServer is the server API for PartitionKV service.
-type PartitionKVServer interface {
-	//
-	//option (google.api.http) = {
-	//post: "/v3/kv/txn"
-	//body: "*"
-	//};
-	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
-	Put(context.Context, *PutRequest) (*PutResponse, error)
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	Range(context.Context, *RangeRequest) (*RangeResponse, error)
+func (m *AddBlobStreamResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
 }
 
-// UnimplementedPartitionKVServer can be embedded to have forward compatible implementations.
-type UnimplementedPartitionKVServer struct {
+//move partID to PS PSID, the source PS closes it gracefully, then the target opens it
+type ReassignPartitionRequest struct {
+	PartID uint64 `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	PSID   uint64 `protobuf:"varint,2,opt,name=PSID,proto3" json:"PSID,omitempty"`
 }
 
-func (*UnimplementedPartitionKVServer) Batch(ctx context.Context, req *BatchRequest) (*BatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+func (m *ReassignPartitionRequest) Reset()         { *m = ReassignPartitionRequest{} }
+func (m *ReassignPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReassignPartitionRequest) ProtoMessage()    {}
+func (*ReassignPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{43}
 }
-func (*UnimplementedPartitionKVServer) Put(ctx context.Context, req *PutRequest) (*PutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+func (m *ReassignPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedPartitionKVServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *ReassignPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReassignPartitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedPartitionKVServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *ReassignPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignPartitionRequest.Merge(m, src)
 }
-func (*UnimplementedPartitionKVServer) Range(ctx context.Context, req *RangeRequest) (*RangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Range not implemented")
+func (m *ReassignPartitionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReassignPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignPartitionRequest.DiscardUnknown(m)
 }
 
-func RegisterPartitionKVServer(s *grpc.Server, srv PartitionKVServer) {
-	s.RegisterService(&_PartitionKV_serviceDesc, srv)
+var xxx_messageInfo_ReassignPartitionRequest proto.InternalMessageInfo
+
+func (m *ReassignPartitionRequest) GetPartID() uint64 {
+	if m != nil {
+		return m.PartID
+	}
+	return 0
 }
 
-func _PartitionKV_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ReassignPartitionRequest) GetPSID() uint64 {
+	if m != nil {
+		return m.PSID
 	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Batch(ctx, in)
+	return 0
+}
+
+type ReassignPartitionResponse struct {
+	Code    pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+}
+
+func (m *ReassignPartitionResponse) Reset()         { *m = ReassignPartitionResponse{} }
+func (m *ReassignPartitionResponse) String() string { return proto.CompactTextString(m) }
+func (*ReassignPartitionResponse) ProtoMessage()    {}
+func (*ReassignPartitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{44}
+}
+func (m *ReassignPartitionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReassignPartitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReassignPartitionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Batch",
+}
+func (m *ReassignPartitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignPartitionResponse.Merge(m, src)
+}
+func (m *ReassignPartitionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReassignPartitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignPartitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReassignPartitionResponse proto.InternalMessageInfo
+
+func (m *ReassignPartitionResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Batch(ctx, req.(*BatchRequest))
+	return pb.Code_OK
+}
+
+func (m *ReassignPartitionResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PartitionKV_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type PartitionMove struct {
+	PartID uint64  `protobuf:"varint,1,opt,name=partID,proto3" json:"partID,omitempty"`
+	From   uint64  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     uint64  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Load   float64 `protobuf:"fixed64,4,opt,name=load,proto3" json:"load,omitempty"`
+}
+
+func (m *PartitionMove) Reset()         { *m = PartitionMove{} }
+func (m *PartitionMove) String() string { return proto.CompactTextString(m) }
+func (*PartitionMove) ProtoMessage()    {}
+func (*PartitionMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{45}
+}
+func (m *PartitionMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Put(ctx, in)
+}
+func (m *PartitionMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionMove.Merge(m, src)
+}
+func (m *PartitionMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionMove proto.InternalMessageInfo
+
+func (m *PartitionMove) GetPartID() uint64 {
+	if m != nil {
+		return m.PartID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Put",
+	return 0
+}
+
+func (m *PartitionMove) GetFrom() uint64 {
+	if m != nil {
+		return m.From
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Put(ctx, req.(*PutRequest))
+	return 0
+}
+
+func (m *PartitionMove) GetTo() uint64 {
+	if m != nil {
+		return m.To
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _PartitionKV_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PartitionMove) GetLoad() float64 {
+	if m != nil {
+		return m.Load
 	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Get(ctx, req.(*GetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _PartitionKV_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Delete(ctx, req.(*DeleteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+//move partitions from PSes over the target load to the least loaded ones,
+//dryRun only returns the moves
+type BalanceRequest struct {
+	DryRun bool `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
 }
 
-func _PartitionKV_Range_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RangeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PartitionKVServer).Range(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pspb.PartitionKV/Range",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PartitionKVServer).Range(ctx, req.(*RangeRequest))
+func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
+func (m *BalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*BalanceRequest) ProtoMessage()    {}
+func (*BalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{46}
+}
+func (m *BalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _PartitionKV_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pspb.PartitionKV",
-	HandlerType: (*PartitionKVServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Batch",
-			Handler:    _PartitionKV_Batch_Handler,
-		},
-		{
-			MethodName: "Put",
-			Handler:    _PartitionKV_Put_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _PartitionKV_Get_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _PartitionKV_Delete_Handler,
-		},
-		{
-			MethodName: "Range",
-			Handler:    _PartitionKV_Range_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pspb.proto",
+func (m *BalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceRequest.Merge(m, src)
+}
+func (m *BalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceRequest.DiscardUnknown(m)
 }
 
-func (m *MixedLog) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BalanceRequest proto.InternalMessageInfo
+
+func (m *BalanceRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MixedLog) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BalanceResponse struct {
+	Code    pb.Code          `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string           `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Moves   []*PartitionMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
 }
 
-func (m *MixedLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Offsets) > 0 {
-		dAtA2 := make([]byte, len(m.Offsets)*10)
-		var j1 int
-		for _, num := range m.Offsets {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+func (m *BalanceResponse) Reset()         { *m = BalanceResponse{} }
+func (m *BalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*BalanceResponse) ProtoMessage()    {}
+func (*BalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{47}
+}
+func (m *BalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintPspb(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Range) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceResponse.Merge(m, src)
 }
-
-func (m *Range) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceResponse.DiscardUnknown(m)
 }
 
-func (m *Range) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EndKey) > 0 {
-		i -= len(m.EndKey)
-		copy(dAtA[i:], m.EndKey)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.EndKey)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_BalanceResponse proto.InternalMessageInfo
+
+func (m *BalanceResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	if len(m.StartKey) > 0 {
-		i -= len(m.StartKey)
-		copy(dAtA[i:], m.StartKey)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.StartKey)))
-		i--
-		dAtA[i] = 0xa
+	return pb.Code_OK
+}
+
+func (m *BalanceResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Location) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BalanceResponse) GetMoves() []*PartitionMove {
+	if m != nil {
+		return m.Moves
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Location) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PutRequest struct {
+	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ExpiresAt uint64 `protobuf:"varint,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	Psversion uint64 `protobuf:"varint,4,opt,name=psversion,proto3" json:"psversion,omitempty"`
+	Partid    uint64 `protobuf:"varint,5,opt,name=partid,proto3" json:"partid,omitempty"`
 }
 
-func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Offset != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
+func (m *PutRequest) Reset()         { *m = PutRequest{} }
+func (m *PutRequest) String() string { return proto.CompactTextString(m) }
+func (*PutRequest) ProtoMessage()    {}
+func (*PutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{48}
+}
+func (m *PutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *PutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutRequest.Merge(m, src)
+}
+func (m *PutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutRequest proto.InternalMessageInfo
+
+func (m *PutRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BlobStreams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PutRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BlobStreams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PutRequest) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
 
-func (m *BlobStreams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Blob) > 0 {
-		dAtA4 := make([]byte, len(m.Blob)*10)
-		var j3 int
-		for _, num := range m.Blob {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintPspb(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0xa
+func (m *PutRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TableLocations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PutRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TableLocations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PutResponse struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (m *TableLocations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Locs) > 0 {
-		for iNdEx := len(m.Locs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PutResponse) Reset()         { *m = PutResponse{} }
+func (m *PutResponse) String() string { return proto.CompactTextString(m) }
+func (*PutResponse) ProtoMessage()    {}
+func (*PutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{49}
+}
+func (m *PutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PartitionMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutResponse.Merge(m, src)
 }
-
-func (m *PartitionMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutResponse.DiscardUnknown(m)
 }
 
-func (m *PartitionMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PartID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Rg != nil {
-		{
-			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Discard) > 0 {
-		i -= len(m.Discard)
-		copy(dAtA[i:], m.Discard)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Discard)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Parent != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Parent))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Locs != nil {
-		{
-			size, err := m.Locs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.RowStream != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.RowStream))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LogStream != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.LogStream))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Blobs != nil {
-		{
-			size, err := m.Blobs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_PutResponse proto.InternalMessageInfo
+
+func (m *PutResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PSDetail) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PutResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PSDetail) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeleteRequest struct {
+	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
+	Partid    uint64 `protobuf:"varint,5,opt,name=partid,proto3" json:"partid,omitempty"`
 }
 
-func (m *PSDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{50}
+}
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PSID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RegionInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeleteRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RegionInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
+	}
+	return 0
 }
 
-func (m *RegionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PSID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PartID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Rg != nil {
-		{
-			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
+type DeleteResponse struct {
+	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
+	Seq       uint64 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
+func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteResponse) ProtoMessage()    {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{51}
+}
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
+}
+func (m *DeleteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
 }
 
-func (m *RawBlockMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+
+func (m *DeleteResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RawBlockMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteResponse) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
 }
 
-func (m *RawBlockMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SeqNum != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.SeqNum))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.VpOffset != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.VpOffset))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.VpExtentID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.VpExtentID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UnCompressedSize != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.UnCompressedSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CompressedSize != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.CompressedSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Type != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+func (m *DeleteResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BlockOffset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+//DeleteRange deletes keys in [start, end) of one partition, start must be in the partition
+type DeleteRangeRequest struct {
+	Start     []byte `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End       []byte `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Psversion uint64 `protobuf:"varint,3,opt,name=psversion,proto3" json:"psversion,omitempty"`
+	Partid    uint64 `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
 }
 
-func (m *BlockOffset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteRangeRequest) Reset()         { *m = DeleteRangeRequest{} }
+func (m *DeleteRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRangeRequest) ProtoMessage()    {}
+func (*DeleteRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{52}
+}
+func (m *DeleteRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRangeRequest.Merge(m, src)
+}
+func (m *DeleteRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRangeRequest.DiscardUnknown(m)
 }
 
-func (m *BlockOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Offset != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_DeleteRangeRequest proto.InternalMessageInfo
+
+func (m *DeleteRangeRequest) GetStart() []byte {
+	if m != nil {
+		return m.Start
 	}
-	if m.ExtentID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.ExtentID))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *DeleteRangeRequest) GetEnd() []byte {
+	if m != nil {
+		return m.End
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *DeleteRangeRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TableIndex) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeleteRangeRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TableIndex) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeleteRangeResponse struct {
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (m *TableIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumOfBlocks != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.NumOfBlocks))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.EstimatedSize != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.EstimatedSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BloomFilter) > 0 {
-		i -= len(m.BloomFilter)
-		copy(dAtA[i:], m.BloomFilter)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.BloomFilter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Offsets) > 0 {
-		for iNdEx := len(m.Offsets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Offsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DeleteRangeResponse) Reset()         { *m = DeleteRangeResponse{} }
+func (m *DeleteRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRangeResponse) ProtoMessage()    {}
+func (*DeleteRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{53}
+}
+func (m *DeleteRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeleteRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRangeResponse.Merge(m, src)
+}
+func (m *DeleteRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRangeResponse.DiscardUnknown(m)
 }
 
-func (m *GetPartitionMetaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteRangeResponse proto.InternalMessageInfo
+
+func (m *DeleteRangeResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetPartitionMetaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetRequest struct {
+	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
+	Version   uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Partid    uint64 `protobuf:"varint,5,opt,name=partid,proto3" json:"partid,omitempty"`
 }
 
-func (m *GetPartitionMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PSID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
-		i--
-		dAtA[i] = 0x8
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{54}
+}
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(m, src)
+}
+func (m *GetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
 }
 
-func (m *GetPartitionMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+
+func (m *GetRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetPartitionMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
 }
 
-func (m *GetPartitionMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Meta) > 0 {
-		for iNdEx := len(m.Meta) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Meta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Code != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+func (m *GetRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SetRowStreamTablesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SetRowStreamTablesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetResponse struct {
+	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value    []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	NotFound bool   `protobuf:"varint,3,opt,name=notFound,proto3" json:"notFound,omitempty"`
 }
 
-func (m *SetRowStreamTablesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Locs != nil {
-		{
-			size, err := m.Locs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
+func (m *GetResponse) Reset()         { *m = GetResponse{} }
+func (m *GetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResponse) ProtoMessage()    {}
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{55}
+}
+func (m *GetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.PartitionID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PartitionID))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *GetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResponse.Merge(m, src)
+}
+func (m *GetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+
+func (m *GetResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SetRowStreamTablesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SetRowStreamTablesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetResponse) GetNotFound() bool {
+	if m != nil {
+		return m.NotFound
+	}
+	return false
 }
 
-func (m *SetRowStreamTablesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+//GetVersions lists versions of a key from the newest to the oldest
+type GetVersionsRequest struct {
+	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
+	Before    uint64 `protobuf:"varint,3,opt,name=before,proto3" json:"before,omitempty"`
+	Limit     uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Values    bool   `protobuf:"varint,5,opt,name=values,proto3" json:"values,omitempty"`
+	Partid    uint64 `protobuf:"varint,6,opt,name=partid,proto3" json:"partid,omitempty"`
+}
+
+func (m *GetVersionsRequest) Reset()         { *m = GetVersionsRequest{} }
+func (m *GetVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVersionsRequest) ProtoMessage()    {}
+func (*GetVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{56}
+}
+func (m *GetVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionsRequest.Merge(m, src)
+}
+func (m *GetVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionsRequest.DiscardUnknown(m)
 }
 
-func (m *GetRegionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetVersionsRequest proto.InternalMessageInfo
+
+func (m *GetVersionsRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetVersionsRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
 }
 
-func (m *GetRegionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GetVersionsRequest) GetBefore() uint64 {
+	if m != nil {
+		return m.Before
+	}
+	return 0
 }
 
-func (m *GetRegionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetVersionsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetVersionsRequest) GetValues() bool {
+	if m != nil {
+		return m.Values
+	}
+	return false
 }
 
-func (m *GetRegionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Regions) > 0 {
-		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Regions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Code != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+func (m *GetVersionsRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RegisterPSRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type KeyVersion struct {
+	Version   uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Deleted   bool   `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	ExpiresAt uint64 `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (m *RegisterPSRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeyVersion) Reset()         { *m = KeyVersion{} }
+func (m *KeyVersion) String() string { return proto.CompactTextString(m) }
+func (*KeyVersion) ProtoMessage()    {}
+func (*KeyVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{57}
 }
-
-func (m *RegisterPSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+func (m *KeyVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *KeyVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyVersion.Merge(m, src)
+}
+func (m *KeyVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyVersion.DiscardUnknown(m)
 }
 
-func (m *RegisterPSResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_KeyVersion proto.InternalMessageInfo
+
+func (m *KeyVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RegisterPSResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeyVersion) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *RegisterPSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Code != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+func (m *KeyVersion) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *GetPSInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyVersion) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetPSInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetVersionsResponse struct {
+	Versions  []*KeyVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Truncated bool          `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
-func (m *GetPSInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GetVersionsResponse) Reset()         { *m = GetVersionsResponse{} }
+func (m *GetVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVersionsResponse) ProtoMessage()    {}
+func (*GetVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{58}
 }
-
-func (m *GetPSInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetPSInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionsResponse.Merge(m, src)
+}
+func (m *GetVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionsResponse.DiscardUnknown(m)
 }
 
-func (m *GetPSInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Servers) > 0 {
-		for iNdEx := len(m.Servers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Servers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_GetVersionsResponse proto.InternalMessageInfo
+
+func (m *GetVersionsResponse) GetVersions() []*KeyVersion {
+	if m != nil {
+		return m.Versions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BootstrapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetVersionsResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *BootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+//value of an object written in chunks by AutumnLib.PutObject, it is written after all
+//chunks, chunk i is at key + "\x00" + uploadID + "/" + %08d of i
+type ObjectManifest struct {
+	UploadID  string `protobuf:"bytes,1,opt,name=uploadID,proto3" json:"uploadID,omitempty"`
+	Length    uint64 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	ChunkSize uint32 `protobuf:"varint,3,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+	NumChunks uint32 `protobuf:"varint,4,opt,name=numChunks,proto3" json:"numChunks,omitempty"`
 }
 
-func (m *BootstrapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Parent != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Parent))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.RowID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.RowID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.LogID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.LogID))
-		i--
-		dAtA[i] = 0x8
+func (m *ObjectManifest) Reset()         { *m = ObjectManifest{} }
+func (m *ObjectManifest) String() string { return proto.CompactTextString(m) }
+func (*ObjectManifest) ProtoMessage()    {}
+func (*ObjectManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{59}
+}
+func (m *ObjectManifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectManifest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ObjectManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectManifest.Merge(m, src)
+}
+func (m *ObjectManifest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectManifest.DiscardUnknown(m)
 }
 
-func (m *BootstrapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ObjectManifest proto.InternalMessageInfo
+
+func (m *ObjectManifest) GetUploadID() string {
+	if m != nil {
+		return m.UploadID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ObjectManifest) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
 }
 
-func (m *BootstrapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PartID != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
-		i--
-		dAtA[i] = 0x8
+func (m *ObjectManifest) GetChunkSize() uint32 {
+	if m != nil {
+		return m.ChunkSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ObjectManifest) GetNumChunks() uint32 {
+	if m != nil {
+		return m.NumChunks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Condition struct {
+	Type    CondType `protobuf:"varint,1,opt,name=type,proto3,enum=pspb.CondType" json:"type,omitempty"`
+	Version uint64   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Value   []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Psversion != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExpiresAt != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
-		i--
-		dAtA[i] = 0x18
+func (m *Condition) Reset()         { *m = Condition{} }
+func (m *Condition) String() string { return proto.CompactTextString(m) }
+func (*Condition) ProtoMessage()    {}
+func (*Condition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{60}
+}
+func (m *Condition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Condition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Condition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Condition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Condition.Merge(m, src)
+}
+func (m *Condition) XXX_Size() int {
+	return m.Size()
+}
+func (m *Condition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Condition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Condition proto.InternalMessageInfo
+
+func (m *Condition) GetType() CondType {
+	if m != nil {
+		return m.Type
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return CondType_always
+}
+
+func (m *Condition) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Condition) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CondPutRequest struct {
+	Put  *PutRequest `protobuf:"bytes,1,opt,name=put,proto3" json:"put,omitempty"`
+	Cond *Condition  `protobuf:"bytes,2,opt,name=cond,proto3" json:"cond,omitempty"`
 }
 
-func (m *PutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (m *CondPutRequest) Reset()         { *m = CondPutRequest{} }
+func (m *CondPutRequest) String() string { return proto.CompactTextString(m) }
+func (*CondPutRequest) ProtoMessage()    {}
+func (*CondPutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{61}
+}
+func (m *CondPutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CondPutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CondPutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CondPutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CondPutRequest.Merge(m, src)
+}
+func (m *CondPutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CondPutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CondPutRequest.DiscardUnknown(m)
 }
 
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CondPutRequest proto.InternalMessageInfo
+
+func (m *CondPutRequest) GetPut() *PutRequest {
+	if m != nil {
+		return m.Put
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CondPutRequest) GetCond() *Condition {
+	if m != nil {
+		return m.Cond
+	}
+	return nil
 }
 
-func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Psversion != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type CondPutResponse struct {
+	Code    pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Seq     uint64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CondPutResponse) Reset()         { *m = CondPutResponse{} }
+func (m *CondPutResponse) String() string { return proto.CompactTextString(m) }
+func (*CondPutResponse) ProtoMessage()    {}
+func (*CondPutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{62}
+}
+func (m *CondPutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CondPutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CondPutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CondPutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CondPutResponse.Merge(m, src)
+}
+func (m *CondPutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CondPutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CondPutResponse.DiscardUnknown(m)
 }
 
-func (m *DeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Psversion != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_CondPutResponse proto.InternalMessageInfo
+
+func (m *CondPutResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return pb.Code_OK
+}
+
+func (m *CondPutResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CondPutResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CondDeleteRequest struct {
+	Delete *DeleteRequest `protobuf:"bytes,1,opt,name=delete,proto3" json:"delete,omitempty"`
+	Cond   *Condition     `protobuf:"bytes,2,opt,name=cond,proto3" json:"cond,omitempty"`
 }
 
-func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Psversion != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (m *CondDeleteRequest) Reset()         { *m = CondDeleteRequest{} }
+func (m *CondDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*CondDeleteRequest) ProtoMessage()    {}
+func (*CondDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{63}
+}
+func (m *CondDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CondDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CondDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CondDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CondDeleteRequest.Merge(m, src)
+}
+func (m *CondDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CondDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CondDeleteRequest.DiscardUnknown(m)
 }
 
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CondDeleteRequest proto.InternalMessageInfo
+
+func (m *CondDeleteRequest) GetDelete() *DeleteRequest {
+	if m != nil {
+		return m.Delete
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CondDeleteRequest) GetCond() *Condition {
+	if m != nil {
+		return m.Cond
+	}
+	return nil
 }
 
-func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type CondDeleteResponse struct {
+	Code    pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Seq     uint64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (m *RequestOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CondDeleteResponse) Reset()         { *m = CondDeleteResponse{} }
+func (m *CondDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*CondDeleteResponse) ProtoMessage()    {}
+func (*CondDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{64}
+}
+func (m *CondDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CondDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CondDeleteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *RequestOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CondDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CondDeleteResponse.Merge(m, src)
+}
+func (m *CondDeleteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CondDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CondDeleteResponse.DiscardUnknown(m)
 }
 
-func (m *RequestOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size := m.Request.Size()
-			i -= size
-			if _, err := m.Request.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+var xxx_messageInfo_CondDeleteResponse proto.InternalMessageInfo
+
+func (m *CondDeleteResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	return len(dAtA) - i, nil
+	return pb.Code_OK
 }
 
-func (m *RequestOp_RequestPut) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CondDeleteResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
 }
 
-func (m *RequestOp_RequestPut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RequestPut != nil {
-		{
-			size, err := m.RequestPut.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *CondDeleteResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *RequestOp_RequestDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type SplitPartRequest struct {
+	Partid    uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+	SplitKey  []byte `protobuf:"bytes,2,opt,name=splitKey,proto3" json:"splitKey,omitempty"`
+	Psversion uint64 `protobuf:"varint,3,opt,name=psversion,proto3" json:"psversion,omitempty"`
 }
 
-func (m *RequestOp_RequestDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RequestDelete != nil {
-		{
-			size, err := m.RequestDelete.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
+func (m *SplitPartRequest) Reset()         { *m = SplitPartRequest{} }
+func (m *SplitPartRequest) String() string { return proto.CompactTextString(m) }
+func (*SplitPartRequest) ProtoMessage()    {}
+func (*SplitPartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{65}
+}
+func (m *SplitPartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitPartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitPartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *RequestOp_RequestGet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SplitPartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitPartRequest.Merge(m, src)
+}
+func (m *SplitPartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitPartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitPartRequest.DiscardUnknown(m)
 }
 
-func (m *RequestOp_RequestGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RequestGet != nil {
-		{
-			size, err := m.RequestGet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_SplitPartRequest proto.InternalMessageInfo
+
+func (m *SplitPartRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *ResponseOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *SplitPartRequest) GetSplitKey() []byte {
+	if m != nil {
+		return m.SplitKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResponseOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SplitPartRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
 }
 
-func (m *ResponseOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Response != nil {
-		{
-			size := m.Response.Size()
-			i -= size
-			if _, err := m.Response.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+type SplitPartResponse struct {
+	Code      pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes   string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	NewPartID uint64  `protobuf:"varint,3,opt,name=newPartID,proto3" json:"newPartID,omitempty"`
+	SplitKey  []byte  `protobuf:"bytes,4,opt,name=splitKey,proto3" json:"splitKey,omitempty"`
+}
+
+func (m *SplitPartResponse) Reset()         { *m = SplitPartResponse{} }
+func (m *SplitPartResponse) String() string { return proto.CompactTextString(m) }
+func (*SplitPartResponse) ProtoMessage()    {}
+func (*SplitPartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{66}
+}
+func (m *SplitPartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitPartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitPartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SplitPartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitPartResponse.Merge(m, src)
+}
+func (m *SplitPartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitPartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitPartResponse.DiscardUnknown(m)
 }
 
-func (m *ResponseOp_ResponsePut) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SplitPartResponse proto.InternalMessageInfo
+
+func (m *SplitPartResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
+	}
+	return pb.Code_OK
 }
 
-func (m *ResponseOp_ResponsePut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResponsePut != nil {
-		{
-			size, err := m.ResponsePut.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *SplitPartResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *ResponseOp_ResponseDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *SplitPartResponse) GetNewPartID() uint64 {
+	if m != nil {
+		return m.NewPartID
+	}
+	return 0
 }
 
-func (m *ResponseOp_ResponseDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResponseDelete != nil {
-		{
-			size, err := m.ResponseDelete.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *SplitPartResponse) GetSplitKey() []byte {
+	if m != nil {
+		return m.SplitKey
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *ResponseOp_ResponseGet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+//merge the partition with the next one on the same PS
+type MergePartRequest struct {
+	Partid    uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
 }
 
-func (m *ResponseOp_ResponseGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResponseGet != nil {
-		{
-			size, err := m.ResponseGet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPspb(dAtA, i, uint64(size))
+func (m *MergePartRequest) Reset()         { *m = MergePartRequest{} }
+func (m *MergePartRequest) String() string { return proto.CompactTextString(m) }
+func (*MergePartRequest) ProtoMessage()    {}
+func (*MergePartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{67}
+}
+func (m *MergePartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergePartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergePartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *BatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MergePartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergePartRequest.Merge(m, src)
+}
+func (m *MergePartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergePartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergePartRequest.DiscardUnknown(m)
 }
 
-func (m *BatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MergePartRequest proto.InternalMessageInfo
+
+func (m *MergePartRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
+	}
+	return 0
 }
 
-func (m *BatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Req) > 0 {
-		for iNdEx := len(m.Req) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Req[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MergePartRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
+}
+
+type MergePartResponse struct {
+	Code        pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes     string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	RightPartID uint64  `protobuf:"varint,3,opt,name=rightPartID,proto3" json:"rightPartID,omitempty"`
+}
+
+func (m *MergePartResponse) Reset()         { *m = MergePartResponse{} }
+func (m *MergePartResponse) String() string { return proto.CompactTextString(m) }
+func (*MergePartResponse) ProtoMessage()    {}
+func (*MergePartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{68}
+}
+func (m *MergePartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergePartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergePartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MergePartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergePartResponse.Merge(m, src)
+}
+func (m *MergePartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergePartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergePartResponse.DiscardUnknown(m)
 }
 
-func (m *BatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MergePartResponse proto.InternalMessageInfo
+
+func (m *MergePartResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return pb.Code_OK
 }
 
-func (m *BatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MergePartResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
+	}
+	return ""
 }
 
-func (m *BatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Res) > 0 {
-		for iNdEx := len(m.Res) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Res[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPspb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MergePartResponse) GetRightPartID() uint64 {
+	if m != nil {
+		return m.RightPartID
+	}
+	return 0
+}
+
+//called by PM when reassigning partitions
+type CompactionStats struct {
+	NumTables     uint32 `protobuf:"varint,1,opt,name=numTables,proto3" json:"numTables,omitempty"`
+	PendingTables uint32 `protobuf:"varint,2,opt,name=pendingTables,proto3" json:"pendingTables,omitempty"`
+	PendingBytes  uint64 `protobuf:"varint,3,opt,name=pendingBytes,proto3" json:"pendingBytes,omitempty"`
+	Running       bool   `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+	NumMinor      uint64 `protobuf:"varint,5,opt,name=numMinor,proto3" json:"numMinor,omitempty"`
+	NumMajor      uint64 `protobuf:"varint,6,opt,name=numMajor,proto3" json:"numMajor,omitempty"`
+	LastStart     int64  `protobuf:"varint,7,opt,name=lastStart,proto3" json:"lastStart,omitempty"`
+	LastEnd       int64  `protobuf:"varint,8,opt,name=lastEnd,proto3" json:"lastEnd,omitempty"`
+}
+
+func (m *CompactionStats) Reset()         { *m = CompactionStats{} }
+func (m *CompactionStats) String() string { return proto.CompactTextString(m) }
+func (*CompactionStats) ProtoMessage()    {}
+func (*CompactionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{69}
+}
+func (m *CompactionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CompactionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionStats.Merge(m, src)
+}
+func (m *CompactionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionStats.DiscardUnknown(m)
 }
 
-func (m *RangeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CompactionStats proto.InternalMessageInfo
+
+func (m *CompactionStats) GetNumTables() uint32 {
+	if m != nil {
+		return m.NumTables
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RangeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CompactionStats) GetPendingTables() uint32 {
+	if m != nil {
+		return m.PendingTables
+	}
+	return 0
 }
 
-func (m *RangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Psversion != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
-		i--
-		dAtA[i] = 0x28
+func (m *CompactionStats) GetPendingBytes() uint64 {
+	if m != nil {
+		return m.PendingBytes
 	}
-	if m.Partid != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *CompactionStats) GetRunning() bool {
+	if m != nil {
+		return m.Running
 	}
-	if m.Limit != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x18
+	return false
+}
+
+func (m *CompactionStats) GetNumMinor() uint64 {
+	if m != nil {
+		return m.NumMinor
 	}
-	if len(m.Start) > 0 {
-		i -= len(m.Start)
-		copy(dAtA[i:], m.Start)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Start)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *CompactionStats) GetNumMajor() uint64 {
+	if m != nil {
+		return m.NumMajor
 	}
-	if len(m.Prefix) > 0 {
-		i -= len(m.Prefix)
-		copy(dAtA[i:], m.Prefix)
-		i = encodeVarintPspb(dAtA, i, uint64(len(m.Prefix)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *CompactionStats) GetLastStart() int64 {
+	if m != nil {
+		return m.LastStart
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RangeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CompactionStats) GetLastEnd() int64 {
+	if m != nil {
+		return m.LastEnd
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RangeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+//block cache shared by all partitions of a PS
+type BlockCacheStats struct {
+	MaxCost     int64  `protobuf:"varint,1,opt,name=maxCost,proto3" json:"maxCost,omitempty"`
+	Hits        uint64 `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses      uint64 `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	KeysAdded   uint64 `protobuf:"varint,4,opt,name=keysAdded,proto3" json:"keysAdded,omitempty"`
+	KeysEvicted uint64 `protobuf:"varint,5,opt,name=keysEvicted,proto3" json:"keysEvicted,omitempty"`
+	CostAdded   uint64 `protobuf:"varint,6,opt,name=costAdded,proto3" json:"costAdded,omitempty"`
+	CostEvicted uint64 `protobuf:"varint,7,opt,name=costEvicted,proto3" json:"costEvicted,omitempty"`
 }
 
-func (m *RangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintPspb(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *BlockCacheStats) Reset()         { *m = BlockCacheStats{} }
+func (m *BlockCacheStats) String() string { return proto.CompactTextString(m) }
+func (*BlockCacheStats) ProtoMessage()    {}
+func (*BlockCacheStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{70}
+}
+func (m *BlockCacheStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockCacheStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockCacheStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Truncated != 0 {
-		i = encodeVarintPspb(dAtA, i, uint64(m.Truncated))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *BlockCacheStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockCacheStats.Merge(m, src)
+}
+func (m *BlockCacheStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockCacheStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockCacheStats.DiscardUnknown(m)
 }
 
-func encodeVarintPspb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPspb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_BlockCacheStats proto.InternalMessageInfo
+
+func (m *BlockCacheStats) GetMaxCost() int64 {
+	if m != nil {
+		return m.MaxCost
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *MixedLog) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BlockCacheStats) GetHits() uint64 {
+	if m != nil {
+		return m.Hits
 	}
-	var l int
-	_ = l
-	if len(m.Offsets) > 0 {
-		l = 0
-		for _, e := range m.Offsets {
-			l += sovPspb(uint64(e))
-		}
-		n += 1 + sovPspb(uint64(l)) + l
+	return 0
+}
+
+func (m *BlockCacheStats) GetMisses() uint64 {
+	if m != nil {
+		return m.Misses
 	}
-	return n
+	return 0
 }
 
-func (m *Range) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BlockCacheStats) GetKeysAdded() uint64 {
+	if m != nil {
+		return m.KeysAdded
 	}
-	var l int
-	_ = l
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	return 0
+}
+
+func (m *BlockCacheStats) GetKeysEvicted() uint64 {
+	if m != nil {
+		return m.KeysEvicted
 	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	return 0
+}
+
+func (m *BlockCacheStats) GetCostAdded() uint64 {
+	if m != nil {
+		return m.CostAdded
 	}
-	return n
+	return 0
 }
 
-func (m *Location) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExtentID != 0 {
-		n += 1 + sovPspb(uint64(m.ExtentID))
+func (m *BlockCacheStats) GetCostEvicted() uint64 {
+	if m != nil {
+		return m.CostEvicted
 	}
-	if m.Offset != 0 {
-		n += 1 + sovPspb(uint64(m.Offset))
+	return 0
+}
+
+//blocks written by tables of a partition since it is opened
+type CompressionStats struct {
+	Compression     CompressionType `protobuf:"varint,1,opt,name=compression,proto3,enum=pspb.CompressionType" json:"compression,omitempty"`
+	RawBytes        uint64          `protobuf:"varint,2,opt,name=rawBytes,proto3" json:"rawBytes,omitempty"`
+	CompressedBytes uint64          `protobuf:"varint,3,opt,name=compressedBytes,proto3" json:"compressedBytes,omitempty"`
+}
+
+func (m *CompressionStats) Reset()         { *m = CompressionStats{} }
+func (m *CompressionStats) String() string { return proto.CompactTextString(m) }
+func (*CompressionStats) ProtoMessage()    {}
+func (*CompressionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{71}
+}
+func (m *CompressionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompressionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompressionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *CompressionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompressionStats.Merge(m, src)
+}
+func (m *CompressionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompressionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompressionStats.DiscardUnknown(m)
 }
 
-func (m *BlobStreams) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_CompressionStats proto.InternalMessageInfo
+
+func (m *CompressionStats) GetCompression() CompressionType {
+	if m != nil {
+		return m.Compression
 	}
-	var l int
-	_ = l
-	if len(m.Blob) > 0 {
-		l = 0
-		for _, e := range m.Blob {
-			l += sovPspb(uint64(e))
-		}
-		n += 1 + sovPspb(uint64(l)) + l
+	return CompressionType_none
+}
+
+func (m *CompressionStats) GetRawBytes() uint64 {
+	if m != nil {
+		return m.RawBytes
 	}
-	return n
+	return 0
 }
 
-func (m *TableLocations) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CompressionStats) GetCompressedBytes() uint64 {
+	if m != nil {
+		return m.CompressedBytes
 	}
-	var l int
-	_ = l
-	if len(m.Locs) > 0 {
-		for _, e := range m.Locs {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
+	return 0
+}
+
+type PartStatsRequest struct {
+	Partid uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+}
+
+func (m *PartStatsRequest) Reset()         { *m = PartStatsRequest{} }
+func (m *PartStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*PartStatsRequest) ProtoMessage()    {}
+func (*PartStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{72}
+}
+func (m *PartStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *PartStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartStatsRequest.Merge(m, src)
+}
+func (m *PartStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartStatsRequest.DiscardUnknown(m)
 }
 
-func (m *PartitionMeta) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PartStatsRequest proto.InternalMessageInfo
+
+func (m *PartStatsRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	var l int
-	_ = l
-	if m.Blobs != nil {
-		l = m.Blobs.Size()
-		n += 1 + l + sovPspb(uint64(l))
+	return 0
+}
+
+type PartStatsResponse struct {
+	Code             pb.Code           `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes          string            `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+	Reads            uint64            `protobuf:"varint,3,opt,name=reads,proto3" json:"reads,omitempty"`
+	Writes           uint64            `protobuf:"varint,4,opt,name=writes,proto3" json:"writes,omitempty"`
+	WriteBytes       uint64            `protobuf:"varint,5,opt,name=writeBytes,proto3" json:"writeBytes,omitempty"`
+	MemtableSize     uint64            `protobuf:"varint,6,opt,name=memtableSize,proto3" json:"memtableSize,omitempty"`
+	TableSize        uint64            `protobuf:"varint,7,opt,name=tableSize,proto3" json:"tableSize,omitempty"`
+	Compaction       *CompactionStats  `protobuf:"bytes,8,opt,name=compaction,proto3" json:"compaction,omitempty"`
+	BlockCache       *BlockCacheStats  `protobuf:"bytes,9,opt,name=blockCache,proto3" json:"blockCache,omitempty"`
+	BlockCompression *CompressionStats `protobuf:"bytes,10,opt,name=blockCompression,proto3" json:"blockCompression,omitempty"`
+}
+
+func (m *PartStatsResponse) Reset()         { *m = PartStatsResponse{} }
+func (m *PartStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*PartStatsResponse) ProtoMessage()    {}
+func (*PartStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{73}
+}
+func (m *PartStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.LogStream != 0 {
-		n += 1 + sovPspb(uint64(m.LogStream))
+}
+func (m *PartStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartStatsResponse.Merge(m, src)
+}
+func (m *PartStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartStatsResponse proto.InternalMessageInfo
+
+func (m *PartStatsResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	if m.RowStream != 0 {
-		n += 1 + sovPspb(uint64(m.RowStream))
+	return pb.Code_OK
+}
+
+func (m *PartStatsResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	if m.Locs != nil {
-		l = m.Locs.Size()
-		n += 1 + l + sovPspb(uint64(l))
+	return ""
+}
+
+func (m *PartStatsResponse) GetReads() uint64 {
+	if m != nil {
+		return m.Reads
 	}
-	if m.Parent != 0 {
-		n += 1 + sovPspb(uint64(m.Parent))
+	return 0
+}
+
+func (m *PartStatsResponse) GetWrites() uint64 {
+	if m != nil {
+		return m.Writes
 	}
-	l = len(m.Discard)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	return 0
+}
+
+func (m *PartStatsResponse) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
 	}
-	if m.Rg != nil {
-		l = m.Rg.Size()
-		n += 1 + l + sovPspb(uint64(l))
+	return 0
+}
+
+func (m *PartStatsResponse) GetMemtableSize() uint64 {
+	if m != nil {
+		return m.MemtableSize
 	}
-	if m.PartID != 0 {
-		n += 1 + sovPspb(uint64(m.PartID))
+	return 0
+}
+
+func (m *PartStatsResponse) GetTableSize() uint64 {
+	if m != nil {
+		return m.TableSize
 	}
-	return n
+	return 0
 }
 
-func (m *PSDetail) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PartStatsResponse) GetCompaction() *CompactionStats {
+	if m != nil {
+		return m.Compaction
 	}
-	var l int
-	_ = l
-	if m.PSID != 0 {
-		n += 1 + sovPspb(uint64(m.PSID))
+	return nil
+}
+
+func (m *PartStatsResponse) GetBlockCache() *BlockCacheStats {
+	if m != nil {
+		return m.BlockCache
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+	return nil
+}
+
+func (m *PartStatsResponse) GetBlockCompression() *CompressionStats {
+	if m != nil {
+		return m.BlockCompression
 	}
-	return n
+	return nil
 }
 
-func (m *RegionInfo) Size() (n int) {
-	if m == nil {
-		return 0
+type OpenPartRequest struct {
+	Partid    uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
+}
+
+func (m *OpenPartRequest) Reset()         { *m = OpenPartRequest{} }
+func (m *OpenPartRequest) String() string { return proto.CompactTextString(m) }
+func (*OpenPartRequest) ProtoMessage()    {}
+func (*OpenPartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{74}
+}
+func (m *OpenPartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenPartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenPartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Rg != nil {
-		l = m.Rg.Size()
-		n += 1 + l + sovPspb(uint64(l))
+}
+func (m *OpenPartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenPartRequest.Merge(m, src)
+}
+func (m *OpenPartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenPartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenPartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenPartRequest proto.InternalMessageInfo
+
+func (m *OpenPartRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	if m.PartID != 0 {
-		n += 1 + sovPspb(uint64(m.PartID))
+	return 0
+}
+
+func (m *OpenPartRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
 	}
-	if m.PSID != 0 {
-		n += 1 + sovPspb(uint64(m.PSID))
+	return 0
+}
+
+type OpenPartResponse struct {
+	Code    pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+}
+
+func (m *OpenPartResponse) Reset()         { *m = OpenPartResponse{} }
+func (m *OpenPartResponse) String() string { return proto.CompactTextString(m) }
+func (*OpenPartResponse) ProtoMessage()    {}
+func (*OpenPartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{75}
+}
+func (m *OpenPartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenPartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenPartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+}
+func (m *OpenPartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenPartResponse.Merge(m, src)
+}
+func (m *OpenPartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenPartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenPartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenPartResponse proto.InternalMessageInfo
+
+func (m *OpenPartResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	return n
+	return pb.Code_OK
 }
 
-func (m *RawBlockMeta) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OpenPartResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPspb(uint64(m.Type))
-	}
-	if m.CompressedSize != 0 {
-		n += 1 + sovPspb(uint64(m.CompressedSize))
-	}
-	if m.UnCompressedSize != 0 {
-		n += 1 + sovPspb(uint64(m.UnCompressedSize))
-	}
-	if m.VpExtentID != 0 {
-		n += 1 + sovPspb(uint64(m.VpExtentID))
-	}
-	if m.VpOffset != 0 {
-		n += 1 + sovPspb(uint64(m.VpOffset))
-	}
-	if m.SeqNum != 0 {
-		n += 1 + sovPspb(uint64(m.SeqNum))
-	}
-	return n
+	return ""
 }
 
-func (m *BlockOffset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.ExtentID != 0 {
-		n += 1 + sovPspb(uint64(m.ExtentID))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovPspb(uint64(m.Offset))
-	}
-	return n
+type ClosePartRequest struct {
+	Partid    uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
 }
 
-func (m *TableIndex) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Offsets) > 0 {
-		for _, e := range m.Offsets {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
+func (m *ClosePartRequest) Reset()         { *m = ClosePartRequest{} }
+func (m *ClosePartRequest) String() string { return proto.CompactTextString(m) }
+func (*ClosePartRequest) ProtoMessage()    {}
+func (*ClosePartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{76}
+}
+func (m *ClosePartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClosePartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClosePartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.BloomFilter)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.EstimatedSize != 0 {
-		n += 1 + sovPspb(uint64(m.EstimatedSize))
-	}
-	if m.NumOfBlocks != 0 {
-		n += 1 + sovPspb(uint64(m.NumOfBlocks))
-	}
-	return n
+}
+func (m *ClosePartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosePartRequest.Merge(m, src)
+}
+func (m *ClosePartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClosePartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosePartRequest.DiscardUnknown(m)
 }
 
-func (m *GetPartitionMetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PSID != 0 {
-		n += 1 + sovPspb(uint64(m.PSID))
+var xxx_messageInfo_ClosePartRequest proto.InternalMessageInfo
+
+func (m *ClosePartRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	return n
+	return 0
 }
 
-func (m *GetPartitionMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPspb(uint64(m.Code))
+func (m *ClosePartRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
 	}
-	if len(m.Meta) > 0 {
-		for _, e := range m.Meta {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
+	return 0
+}
+
+type ClosePartResponse struct {
+	Code    pb.Code `protobuf:"varint,1,opt,name=code,proto3,enum=pb.Code" json:"code,omitempty"`
+	CodeDes string  `protobuf:"bytes,2,opt,name=codeDes,proto3" json:"codeDes,omitempty"`
+}
+
+func (m *ClosePartResponse) Reset()         { *m = ClosePartResponse{} }
+func (m *ClosePartResponse) String() string { return proto.CompactTextString(m) }
+func (*ClosePartResponse) ProtoMessage()    {}
+func (*ClosePartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{77}
+}
+func (m *ClosePartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClosePartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClosePartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ClosePartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosePartResponse.Merge(m, src)
+}
+func (m *ClosePartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClosePartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosePartResponse.DiscardUnknown(m)
 }
 
-func (m *SetRowStreamTablesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PartitionID != 0 {
-		n += 1 + sovPspb(uint64(m.PartitionID))
-	}
-	if m.Locs != nil {
-		l = m.Locs.Size()
-		n += 1 + l + sovPspb(uint64(l))
+var xxx_messageInfo_ClosePartResponse proto.InternalMessageInfo
+
+func (m *ClosePartResponse) GetCode() pb.Code {
+	if m != nil {
+		return m.Code
 	}
-	return n
+	return pb.Code_OK
 }
 
-func (m *SetRowStreamTablesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPspb(uint64(m.Code))
+func (m *ClosePartResponse) GetCodeDes() string {
+	if m != nil {
+		return m.CodeDes
 	}
-	return n
+	return ""
 }
 
-func (m *GetRegionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+//watch puts and deletes of keys having prefix from startSeq
+type WatchRequest struct {
+	Prefix    []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	StartSeq  uint64 `protobuf:"varint,2,opt,name=startSeq,proto3" json:"startSeq,omitempty"`
+	Partid    uint64 `protobuf:"varint,3,opt,name=partid,proto3" json:"partid,omitempty"`
+	Psversion uint64 `protobuf:"varint,4,opt,name=psversion,proto3" json:"psversion,omitempty"`
 }
 
-func (m *GetRegionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPspb(uint64(m.Code))
-	}
-	if len(m.Regions) > 0 {
-		for _, e := range m.Regions {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{78}
+}
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
 }
 
-func (m *RegisterPSRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
 	}
-	return n
+	return nil
 }
 
-func (m *RegisterPSResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPspb(uint64(m.Code))
-	}
-	if m.Id != 0 {
-		n += 1 + sovPspb(uint64(m.Id))
+func (m *WatchRequest) GetStartSeq() uint64 {
+	if m != nil {
+		return m.StartSeq
 	}
-	return n
+	return 0
 }
 
-func (m *GetPSInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WatchRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *GetPSInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Servers) > 0 {
-		for _, e := range m.Servers {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
-		}
+func (m *WatchRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
 	}
-	return n
+	return 0
 }
 
-func (m *BootstrapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LogID != 0 {
-		n += 1 + sovPspb(uint64(m.LogID))
-	}
-	if m.RowID != 0 {
-		n += 1 + sovPspb(uint64(m.RowID))
-	}
-	if m.Parent != 0 {
-		n += 1 + sovPspb(uint64(m.Parent))
-	}
-	return n
+type WatchEvent struct {
+	Key         []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value       []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Seq         uint64 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	Delete      bool   `protobuf:"varint,4,opt,name=delete,proto3" json:"delete,omitempty"`
+	ExpiresAt   uint64 `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	DeleteRange bool   `protobuf:"varint,6,opt,name=deleteRange,proto3" json:"deleteRange,omitempty"`
+	End         []byte `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (m *BootstrapResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PartID != 0 {
-		n += 1 + sovPspb(uint64(m.PartID))
+func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
+func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchEvent) ProtoMessage()    {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{79}
+}
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(m, src)
+}
+func (m *WatchEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
 }
 
-func (m *PutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.ExpiresAt != 0 {
-		n += 1 + sovPspb(uint64(m.ExpiresAt))
-	}
-	if m.Psversion != 0 {
-		n += 1 + sovPspb(uint64(m.Psversion))
-	}
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
+
+func (m *WatchEvent) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return n
+	return nil
 }
 
-func (m *PutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+func (m *WatchEvent) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return n
+	return nil
 }
 
-func (m *DeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.Psversion != 0 {
-		n += 1 + sovPspb(uint64(m.Psversion))
-	}
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
+func (m *WatchEvent) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return n
+	return 0
 }
 
-func (m *DeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.Psversion != 0 {
-		n += 1 + sovPspb(uint64(m.Psversion))
+func (m *WatchEvent) GetDelete() bool {
+	if m != nil {
+		return m.Delete
 	}
-	return n
+	return false
 }
 
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.Psversion != 0 {
-		n += 1 + sovPspb(uint64(m.Psversion))
-	}
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
+func (m *WatchEvent) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return n
+	return 0
 }
 
-func (m *GetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
+func (m *WatchEvent) GetDeleteRange() bool {
+	if m != nil {
+		return m.DeleteRange
 	}
-	return n
+	return false
 }
 
-func (m *RequestOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		n += m.Request.Size()
+func (m *WatchEvent) GetEnd() []byte {
+	if m != nil {
+		return m.End
 	}
-	return n
+	return nil
 }
 
-func (m *RequestOp_RequestPut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestPut != nil {
-		l = m.RequestPut.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	return n
+type WatchResponse struct {
+	Events []*WatchEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
-func (m *RequestOp_RequestDelete) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestDelete != nil {
-		l = m.RequestDelete.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	return n
+
+func (m *WatchResponse) Reset()         { *m = WatchResponse{} }
+func (m *WatchResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchResponse) ProtoMessage()    {}
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{80}
 }
-func (m *RequestOp_RequestGet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestGet != nil {
-		l = m.RequestGet.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	return n
+func (m *WatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *ResponseOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Response != nil {
-		n += m.Response.Size()
+func (m *WatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *WatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchResponse.Merge(m, src)
+}
+func (m *WatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchResponse.DiscardUnknown(m)
 }
 
-func (m *ResponseOp_ResponsePut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ResponsePut != nil {
-		l = m.ResponsePut.Size()
-		n += 1 + l + sovPspb(uint64(l))
+var xxx_messageInfo_WatchResponse proto.InternalMessageInfo
+
+func (m *WatchResponse) GetEvents() []*WatchEvent {
+	if m != nil {
+		return m.Events
 	}
-	return n
+	return nil
 }
-func (m *ResponseOp_ResponseDelete) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ResponseDelete != nil {
-		l = m.ResponseDelete.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	return n
+
+//Export streams the newest version of each key of the partition in the order of keys
+type ExportRequest struct {
+	Partid    uint64 `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+	Psversion uint64 `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
 }
-func (m *ResponseOp_ResponseGet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ResponseGet != nil {
-		l = m.ResponseGet.Size()
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	return n
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{81}
 }
-func (m *BatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Req) > 0 {
-		for _, e := range m.Req {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
+}
+
+type ExportEntry struct {
+	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Version   uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Deleted   bool   `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	ExpiresAt uint64 `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (m *ExportEntry) Reset()         { *m = ExportEntry{} }
+func (m *ExportEntry) String() string { return proto.CompactTextString(m) }
+func (*ExportEntry) ProtoMessage()    {}
+func (*ExportEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{82}
+}
+func (m *ExportEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportEntry.Merge(m, src)
+}
+func (m *ExportEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportEntry proto.InternalMessageInfo
+
+func (m *ExportEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ExportEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ExportEntry) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ExportEntry) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *ExportEntry) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type ExportResponse struct {
+	Entries []*ExportEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Seq     uint64         `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{83}
+}
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetEntries() []*ExportEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *ExportResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+//requests of one Ingest copy tables built by table.Builder, blocks of a table are
+//sent in the order of its index, then the index is sent. Blocks are appended to
+//row stream as they are, tables are sorted by key and attached to the partition
+//when the client closes the stream. partid and psversion of the first request are used
+type IngestRequest struct {
+	Partid    uint64      `protobuf:"varint,1,opt,name=partid,proto3" json:"partid,omitempty"`
+	Psversion uint64      `protobuf:"varint,2,opt,name=psversion,proto3" json:"psversion,omitempty"`
+	Blocks    []*pb.Block `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Index     *TableIndex `protobuf:"bytes,4,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *IngestRequest) Reset()         { *m = IngestRequest{} }
+func (m *IngestRequest) String() string { return proto.CompactTextString(m) }
+func (*IngestRequest) ProtoMessage()    {}
+func (*IngestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{84}
+}
+func (m *IngestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IngestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IngestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IngestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngestRequest.Merge(m, src)
+}
+func (m *IngestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IngestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IngestRequest proto.InternalMessageInfo
+
+func (m *IngestRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
+	}
+	return 0
+}
+
+func (m *IngestRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
+}
+
+func (m *IngestRequest) GetBlocks() []*pb.Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *IngestRequest) GetIndex() *TableIndex {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
+type IngestResponse struct {
+	Seq    uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Tables uint32 `protobuf:"varint,2,opt,name=tables,proto3" json:"tables,omitempty"`
+	Blocks uint64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *IngestResponse) Reset()         { *m = IngestResponse{} }
+func (m *IngestResponse) String() string { return proto.CompactTextString(m) }
+func (*IngestResponse) ProtoMessage()    {}
+func (*IngestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{85}
+}
+func (m *IngestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IngestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IngestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IngestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngestResponse.Merge(m, src)
+}
+func (m *IngestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IngestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IngestResponse proto.InternalMessageInfo
+
+func (m *IngestResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *IngestResponse) GetTables() uint32 {
+	if m != nil {
+		return m.Tables
+	}
+	return 0
+}
+
+func (m *IngestResponse) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+type RequestOp struct {
+	// Types that are valid to be assigned to Request:
+	//	*RequestOp_RequestPut
+	//	*RequestOp_RequestDelete
+	//	*RequestOp_RequestGet
+	Request isRequestOp_Request `protobuf_oneof:"request"`
+}
+
+func (m *RequestOp) Reset()         { *m = RequestOp{} }
+func (m *RequestOp) String() string { return proto.CompactTextString(m) }
+func (*RequestOp) ProtoMessage()    {}
+func (*RequestOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{86}
+}
+func (m *RequestOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestOp.Merge(m, src)
+}
+func (m *RequestOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestOp proto.InternalMessageInfo
+
+type isRequestOp_Request interface {
+	isRequestOp_Request()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type RequestOp_RequestPut struct {
+	RequestPut *PutRequest `protobuf:"bytes,1,opt,name=request_put,json=requestPut,proto3,oneof" json:"request_put,omitempty"`
+}
+type RequestOp_RequestDelete struct {
+	RequestDelete *DeleteRequest `protobuf:"bytes,2,opt,name=request_delete,json=requestDelete,proto3,oneof" json:"request_delete,omitempty"`
+}
+type RequestOp_RequestGet struct {
+	RequestGet *GetRequest `protobuf:"bytes,3,opt,name=request_get,json=requestGet,proto3,oneof" json:"request_get,omitempty"`
+}
+
+func (*RequestOp_RequestPut) isRequestOp_Request()    {}
+func (*RequestOp_RequestDelete) isRequestOp_Request() {}
+func (*RequestOp_RequestGet) isRequestOp_Request()    {}
+
+func (m *RequestOp) GetRequest() isRequestOp_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *RequestOp) GetRequestPut() *PutRequest {
+	if x, ok := m.GetRequest().(*RequestOp_RequestPut); ok {
+		return x.RequestPut
+	}
+	return nil
+}
+
+func (m *RequestOp) GetRequestDelete() *DeleteRequest {
+	if x, ok := m.GetRequest().(*RequestOp_RequestDelete); ok {
+		return x.RequestDelete
+	}
+	return nil
+}
+
+func (m *RequestOp) GetRequestGet() *GetRequest {
+	if x, ok := m.GetRequest().(*RequestOp_RequestGet); ok {
+		return x.RequestGet
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*RequestOp) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*RequestOp_RequestPut)(nil),
+		(*RequestOp_RequestDelete)(nil),
+		(*RequestOp_RequestGet)(nil),
+	}
+}
+
+type ResponseOp struct {
+	// Types that are valid to be assigned to Response:
+	//	*ResponseOp_ResponsePut
+	//	*ResponseOp_ResponseDelete
+	//	*ResponseOp_ResponseGet
+	Response isResponseOp_Response `protobuf_oneof:"response"`
+}
+
+func (m *ResponseOp) Reset()         { *m = ResponseOp{} }
+func (m *ResponseOp) String() string { return proto.CompactTextString(m) }
+func (*ResponseOp) ProtoMessage()    {}
+func (*ResponseOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{87}
+}
+func (m *ResponseOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseOp.Merge(m, src)
+}
+func (m *ResponseOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseOp proto.InternalMessageInfo
+
+type isResponseOp_Response interface {
+	isResponseOp_Response()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ResponseOp_ResponsePut struct {
+	ResponsePut *PutResponse `protobuf:"bytes,1,opt,name=response_put,json=responsePut,proto3,oneof" json:"response_put,omitempty"`
+}
+type ResponseOp_ResponseDelete struct {
+	ResponseDelete *DeleteResponse `protobuf:"bytes,2,opt,name=response_delete,json=responseDelete,proto3,oneof" json:"response_delete,omitempty"`
+}
+type ResponseOp_ResponseGet struct {
+	ResponseGet *GetResponse `protobuf:"bytes,3,opt,name=response_get,json=responseGet,proto3,oneof" json:"response_get,omitempty"`
+}
+
+func (*ResponseOp_ResponsePut) isResponseOp_Response()    {}
+func (*ResponseOp_ResponseDelete) isResponseOp_Response() {}
+func (*ResponseOp_ResponseGet) isResponseOp_Response()    {}
+
+func (m *ResponseOp) GetResponse() isResponseOp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *ResponseOp) GetResponsePut() *PutResponse {
+	if x, ok := m.GetResponse().(*ResponseOp_ResponsePut); ok {
+		return x.ResponsePut
+	}
+	return nil
+}
+
+func (m *ResponseOp) GetResponseDelete() *DeleteResponse {
+	if x, ok := m.GetResponse().(*ResponseOp_ResponseDelete); ok {
+		return x.ResponseDelete
+	}
+	return nil
+}
+
+func (m *ResponseOp) GetResponseGet() *GetResponse {
+	if x, ok := m.GetResponse().(*ResponseOp_ResponseGet); ok {
+		return x.ResponseGet
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ResponseOp) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ResponseOp_ResponsePut)(nil),
+		(*ResponseOp_ResponseDelete)(nil),
+		(*ResponseOp_ResponseGet)(nil),
+	}
+}
+
+type BatchRequest struct {
+	Req []*RequestOp `protobuf:"bytes,1,rep,name=req,proto3" json:"req,omitempty"`
+}
+
+func (m *BatchRequest) Reset()         { *m = BatchRequest{} }
+func (m *BatchRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchRequest) ProtoMessage()    {}
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{88}
+}
+func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRequest.Merge(m, src)
+}
+func (m *BatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRequest proto.InternalMessageInfo
+
+func (m *BatchRequest) GetReq() []*RequestOp {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+type BatchResponse struct {
+	Res []*ResponseOp `protobuf:"bytes,1,rep,name=res,proto3" json:"res,omitempty"`
+}
+
+func (m *BatchResponse) Reset()         { *m = BatchResponse{} }
+func (m *BatchResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchResponse) ProtoMessage()    {}
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{89}
+}
+func (m *BatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResponse.Merge(m, src)
+}
+func (m *BatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResponse proto.InternalMessageInfo
+
+func (m *BatchResponse) GetRes() []*ResponseOp {
+	if m != nil {
+		return m.Res
+	}
+	return nil
+}
+
+//return message KeyValue?
+type RangeRequest struct {
+	Prefix       []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Start        []byte `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	Limit        uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Partid       uint64 `protobuf:"varint,4,opt,name=partid,proto3" json:"partid,omitempty"`
+	Psversion    uint64 `protobuf:"varint,5,opt,name=psversion,proto3" json:"psversion,omitempty"`
+	End          []byte `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	Reverse      bool   `protobuf:"varint,7,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Values       bool   `protobuf:"varint,8,opt,name=values,proto3" json:"values,omitempty"`
+	Continuation []byte `protobuf:"bytes,9,opt,name=continuation,proto3" json:"continuation,omitempty"`
+	Version      uint64 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *RangeRequest) Reset()         { *m = RangeRequest{} }
+func (m *RangeRequest) String() string { return proto.CompactTextString(m) }
+func (*RangeRequest) ProtoMessage()    {}
+func (*RangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{90}
+}
+func (m *RangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeRequest.Merge(m, src)
+}
+func (m *RangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeRequest proto.InternalMessageInfo
+
+func (m *RangeRequest) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *RangeRequest) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *RangeRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RangeRequest) GetPartid() uint64 {
+	if m != nil {
+		return m.Partid
+	}
+	return 0
+}
+
+func (m *RangeRequest) GetPsversion() uint64 {
+	if m != nil {
+		return m.Psversion
+	}
+	return 0
+}
+
+func (m *RangeRequest) GetEnd() []byte {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *RangeRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *RangeRequest) GetValues() bool {
+	if m != nil {
+		return m.Values
+	}
+	return false
+}
+
+func (m *RangeRequest) GetContinuation() []byte {
+	if m != nil {
+		return m.Continuation
+	}
+	return nil
+}
+
+func (m *RangeRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type RangeResponse struct {
+	Truncated    uint32   `protobuf:"varint,1,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Keys         [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Values       [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Continuation []byte   `protobuf:"bytes,4,opt,name=continuation,proto3" json:"continuation,omitempty"`
+}
+
+func (m *RangeResponse) Reset()         { *m = RangeResponse{} }
+func (m *RangeResponse) String() string { return proto.CompactTextString(m) }
+func (*RangeResponse) ProtoMessage()    {}
+func (*RangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3c719c85d382a4, []int{91}
+}
+func (m *RangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeResponse.Merge(m, src)
+}
+func (m *RangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeResponse proto.InternalMessageInfo
+
+func (m *RangeResponse) GetTruncated() uint32 {
+	if m != nil {
+		return m.Truncated
+	}
+	return 0
+}
+
+func (m *RangeResponse) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *RangeResponse) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *RangeResponse) GetContinuation() []byte {
+	if m != nil {
+		return m.Continuation
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("pspb.RawBlockType", RawBlockType_name, RawBlockType_value)
+	proto.RegisterEnum("pspb.CompressionType", CompressionType_name, CompressionType_value)
+	proto.RegisterEnum("pspb.CondType", CondType_name, CondType_value)
+	proto.RegisterType((*MixedLog)(nil), "pspb.MixedLog")
+	proto.RegisterType((*Range)(nil), "pspb.Range")
+	proto.RegisterType((*Location)(nil), "pspb.Location")
+	proto.RegisterType((*BlobStreams)(nil), "pspb.BlobStreams")
+	proto.RegisterType((*DiscardStats)(nil), "pspb.DiscardStats")
+	proto.RegisterMapType((map[uint64]int64)(nil), "pspb.DiscardStats.DiscardsEntry")
+	proto.RegisterType((*MergedStreams)(nil), "pspb.MergedStreams")
+	proto.RegisterType((*TableLocations)(nil), "pspb.TableLocations")
+	proto.RegisterType((*PartitionMeta)(nil), "pspb.PartitionMeta")
+	proto.RegisterType((*PSDetail)(nil), "pspb.PSDetail")
+	proto.RegisterType((*RegionInfo)(nil), "pspb.RegionInfo")
+	proto.RegisterType((*RawBlockMeta)(nil), "pspb.RawBlockMeta")
+	proto.RegisterType((*BlockOffset)(nil), "pspb.BlockOffset")
+	proto.RegisterType((*TableIndex)(nil), "pspb.TableIndex")
+	proto.RegisterType((*VersionTime)(nil), "pspb.VersionTime")
+	proto.RegisterType((*RangeDelete)(nil), "pspb.RangeDelete")
+	proto.RegisterType((*GetPartitionMetaRequest)(nil), "pspb.GetPartitionMetaRequest")
+	proto.RegisterType((*GetPartitionMetaResponse)(nil), "pspb.GetPartitionMetaResponse")
+	proto.RegisterType((*PartitionLease)(nil), "pspb.PartitionLease")
+	proto.RegisterType((*PartitionLoad)(nil), "pspb.PartitionLoad")
+	proto.RegisterType((*RenewLeaseRequest)(nil), "pspb.RenewLeaseRequest")
+	proto.RegisterType((*RenewLeaseResponse)(nil), "pspb.RenewLeaseResponse")
+	proto.RegisterType((*SetRowStreamTablesRequest)(nil), "pspb.SetRowStreamTablesRequest")
+	proto.RegisterType((*SetRowStreamTablesResponse)(nil), "pspb.SetRowStreamTablesResponse")
+	proto.RegisterType((*GetRegionsRequest)(nil), "pspb.GetRegionsRequest")
+	proto.RegisterType((*GetRegionsResponse)(nil), "pspb.GetRegionsResponse")
+	proto.RegisterType((*RegisterPSRequest)(nil), "pspb.RegisterPSRequest")
+	proto.RegisterType((*RegisterPSResponse)(nil), "pspb.RegisterPSResponse")
+	proto.RegisterType((*GetPSInfoRequest)(nil), "pspb.GetPSInfoRequest")
+	proto.RegisterType((*GetPSInfoResponse)(nil), "pspb.GetPSInfoResponse")
+	proto.RegisterType((*BootstrapRequest)(nil), "pspb.BootstrapRequest")
+	proto.RegisterType((*BootstrapResponse)(nil), "pspb.BootstrapResponse")
+	proto.RegisterType((*SplitPartitionRequest)(nil), "pspb.SplitPartitionRequest")
+	proto.RegisterType((*SplitPartitionResponse)(nil), "pspb.SplitPartitionResponse")
+	proto.RegisterType((*MergePartitionRequest)(nil), "pspb.MergePartitionRequest")
+	proto.RegisterType((*MergePartitionResponse)(nil), "pspb.MergePartitionResponse")
+	proto.RegisterType((*SetMergedStreamsRequest)(nil), "pspb.SetMergedStreamsRequest")
+	proto.RegisterType((*SetMergedStreamsResponse)(nil), "pspb.SetMergedStreamsResponse")
+	proto.RegisterType((*SetDiscardRequest)(nil), "pspb.SetDiscardRequest")
+	proto.RegisterType((*SetDiscardResponse)(nil), "pspb.SetDiscardResponse")
+	proto.RegisterType((*GetSharedTablesRequest)(nil), "pspb.GetSharedTablesRequest")
+	proto.RegisterType((*GetSharedTablesResponse)(nil), "pspb.GetSharedTablesResponse")
+	proto.RegisterType((*AddBlobStreamRequest)(nil), "pspb.AddBlobStreamRequest")
+	proto.RegisterType((*AddBlobStreamResponse)(nil), "pspb.AddBlobStreamResponse")
+	proto.RegisterType((*ReassignPartitionRequest)(nil), "pspb.ReassignPartitionRequest")
+	proto.RegisterType((*ReassignPartitionResponse)(nil), "pspb.ReassignPartitionResponse")
+	proto.RegisterType((*PartitionMove)(nil), "pspb.PartitionMove")
+	proto.RegisterType((*BalanceRequest)(nil), "pspb.BalanceRequest")
+	proto.RegisterType((*BalanceResponse)(nil), "pspb.BalanceResponse")
+	proto.RegisterType((*PutRequest)(nil), "pspb.PutRequest")
+	proto.RegisterType((*PutResponse)(nil), "pspb.PutResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "pspb.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "pspb.DeleteResponse")
+	proto.RegisterType((*DeleteRangeRequest)(nil), "pspb.DeleteRangeRequest")
+	proto.RegisterType((*DeleteRangeResponse)(nil), "pspb.DeleteRangeResponse")
+	proto.RegisterType((*GetRequest)(nil), "pspb.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "pspb.GetResponse")
+	proto.RegisterType((*GetVersionsRequest)(nil), "pspb.GetVersionsRequest")
+	proto.RegisterType((*KeyVersion)(nil), "pspb.KeyVersion")
+	proto.RegisterType((*GetVersionsResponse)(nil), "pspb.GetVersionsResponse")
+	proto.RegisterType((*ObjectManifest)(nil), "pspb.ObjectManifest")
+	proto.RegisterType((*Condition)(nil), "pspb.Condition")
+	proto.RegisterType((*CondPutRequest)(nil), "pspb.CondPutRequest")
+	proto.RegisterType((*CondPutResponse)(nil), "pspb.CondPutResponse")
+	proto.RegisterType((*CondDeleteRequest)(nil), "pspb.CondDeleteRequest")
+	proto.RegisterType((*CondDeleteResponse)(nil), "pspb.CondDeleteResponse")
+	proto.RegisterType((*SplitPartRequest)(nil), "pspb.SplitPartRequest")
+	proto.RegisterType((*SplitPartResponse)(nil), "pspb.SplitPartResponse")
+	proto.RegisterType((*MergePartRequest)(nil), "pspb.MergePartRequest")
+	proto.RegisterType((*MergePartResponse)(nil), "pspb.MergePartResponse")
+	proto.RegisterType((*CompactionStats)(nil), "pspb.CompactionStats")
+	proto.RegisterType((*BlockCacheStats)(nil), "pspb.BlockCacheStats")
+	proto.RegisterType((*CompressionStats)(nil), "pspb.CompressionStats")
+	proto.RegisterType((*PartStatsRequest)(nil), "pspb.PartStatsRequest")
+	proto.RegisterType((*PartStatsResponse)(nil), "pspb.PartStatsResponse")
+	proto.RegisterType((*OpenPartRequest)(nil), "pspb.OpenPartRequest")
+	proto.RegisterType((*OpenPartResponse)(nil), "pspb.OpenPartResponse")
+	proto.RegisterType((*ClosePartRequest)(nil), "pspb.ClosePartRequest")
+	proto.RegisterType((*ClosePartResponse)(nil), "pspb.ClosePartResponse")
+	proto.RegisterType((*WatchRequest)(nil), "pspb.WatchRequest")
+	proto.RegisterType((*WatchEvent)(nil), "pspb.WatchEvent")
+	proto.RegisterType((*WatchResponse)(nil), "pspb.WatchResponse")
+	proto.RegisterType((*ExportRequest)(nil), "pspb.ExportRequest")
+	proto.RegisterType((*ExportEntry)(nil), "pspb.ExportEntry")
+	proto.RegisterType((*ExportResponse)(nil), "pspb.ExportResponse")
+	proto.RegisterType((*IngestRequest)(nil), "pspb.IngestRequest")
+	proto.RegisterType((*IngestResponse)(nil), "pspb.IngestResponse")
+	proto.RegisterType((*RequestOp)(nil), "pspb.RequestOp")
+	proto.RegisterType((*ResponseOp)(nil), "pspb.ResponseOp")
+	proto.RegisterType((*BatchRequest)(nil), "pspb.BatchRequest")
+	proto.RegisterType((*BatchResponse)(nil), "pspb.BatchResponse")
+	proto.RegisterType((*RangeRequest)(nil), "pspb.RangeRequest")
+	proto.RegisterType((*RangeResponse)(nil), "pspb.RangeResponse")
+}
+
+func init() { proto.RegisterFile("pspb.proto", fileDescriptor_3e3c719c85d382a4) }
+
+var fileDescriptor_3e3c719c85d382a4 = []byte{
+	// 3680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3b, 0x4d, 0x6f, 0x1c, 0xc7,
+	0x72, 0x9c, 0xfd, 0xe0, 0x2e, 0x6b, 0xb9, 0xcb, 0x65, 0x4b, 0x22, 0x57, 0x63, 0x3d, 0x3e, 0xba,
+	0xf3, 0x60, 0xf1, 0x49, 0x8a, 0xf0, 0x4c, 0x3f, 0xc7, 0x8a, 0xe5, 0x38, 0x10, 0x45, 0x8a, 0x92,
+	0x25, 0x85, 0x74, 0xaf, 0x23, 0xc1, 0x08, 0x60, 0x63, 0xb8, 0xd3, 0x5c, 0x8d, 0xb5, 0x3b, 0xb3,
+	0x9a, 0x99, 0xe5, 0x87, 0x83, 0x00, 0x01, 0x02, 0xc3, 0x41, 0x10, 0x03, 0xce, 0x29, 0x87, 0xdc,
+	0xf2, 0x0b, 0xf2, 0x0b, 0x72, 0x4e, 0x6e, 0xbe, 0x25, 0x97, 0x00, 0x81, 0xfd, 0x1f, 0x82, 0x00,
+	0x39, 0x24, 0xe8, 0xcf, 0xe9, 0x9e, 0xd9, 0x25, 0x17, 0x59, 0xbf, 0x13, 0xa7, 0xaa, 0xab, 0xab,
+	0xab, 0xaa, 0xab, 0xab, 0xab, 0xaa, 0x97, 0x00, 0xa3, 0x64, 0x74, 0x74, 0x77, 0x14, 0x47, 0x69,
+	0x84, 0x2a, 0xec, 0xdb, 0xad, 0x2b, 0x18, 0xff, 0x0a, 0xea, 0xcf, 0x83, 0x33, 0xea, 0x3f, 0x8b,
+	0xfa, 0xa8, 0x03, 0xb5, 0xe8, 0xf8, 0x38, 0xa1, 0x69, 0xd2, 0x71, 0x36, 0xcb, 0x5b, 0x4d, 0xa2,
+	0x40, 0x7c, 0x1f, 0xaa, 0xc4, 0x0b, 0xfb, 0x14, 0xb9, 0x50, 0x4f, 0x52, 0x2f, 0x4e, 0x9f, 0xd2,
+	0xf3, 0x8e, 0xb3, 0xe9, 0x6c, 0x2d, 0x13, 0x0d, 0xa3, 0x35, 0x58, 0xa4, 0xa1, 0xcf, 0x46, 0x4a,
+	0x7c, 0x44, 0x42, 0xf8, 0x63, 0xa8, 0x3f, 0x8b, 0x7a, 0x5e, 0x1a, 0x44, 0x21, 0x9b, 0x4f, 0xcf,
+	0x52, 0x1a, 0xa6, 0x4f, 0x76, 0xf9, 0xfc, 0x0a, 0xd1, 0x30, 0x9b, 0x2f, 0xd6, 0xe3, 0xf3, 0x9b,
+	0x44, 0x42, 0xf8, 0x6d, 0x68, 0xec, 0x0c, 0xa2, 0xa3, 0x6e, 0x1a, 0x53, 0x6f, 0x98, 0x20, 0x04,
+	0x95, 0xa3, 0x41, 0x74, 0xc4, 0x45, 0xac, 0x10, 0xfe, 0x8d, 0xbf, 0x2b, 0xc1, 0xf2, 0x6e, 0x90,
+	0xf4, 0xbc, 0xd8, 0xef, 0xa6, 0x5e, 0x9a, 0xa0, 0x8f, 0xa0, 0xee, 0x0b, 0x58, 0xe8, 0xd2, 0xd8,
+	0xde, 0xbc, 0xcb, 0xad, 0x60, 0x52, 0x29, 0x20, 0xd9, 0x0b, 0xd3, 0xf8, 0x9c, 0xe8, 0x19, 0x08,
+	0xc3, 0x72, 0xf2, 0xca, 0x8b, 0xa9, 0xbf, 0xc7, 0x65, 0xe3, 0xf2, 0x54, 0x88, 0x85, 0x63, 0x34,
+	0x69, 0x3c, 0x0e, 0x7b, 0x5e, 0x4a, 0xfd, 0x2e, 0x7d, 0xd3, 0x29, 0x0b, 0x1a, 0x13, 0xc7, 0xad,
+	0xc5, 0xe7, 0xec, 0x9c, 0x77, 0x2a, 0x5c, 0x5c, 0x0d, 0xa3, 0x0d, 0x00, 0xf1, 0xfd, 0x28, 0x8e,
+	0x86, 0x9d, 0x2a, 0x1f, 0x35, 0x30, 0xee, 0x7d, 0x68, 0x5a, 0xe2, 0xa1, 0x36, 0x94, 0x5f, 0x4b,
+	0xab, 0x57, 0x08, 0xfb, 0x44, 0x57, 0xa1, 0x7a, 0xe2, 0x0d, 0xc6, 0x94, 0xcb, 0x57, 0x26, 0x02,
+	0xf8, 0xb0, 0x74, 0xcf, 0xc1, 0x1f, 0x40, 0xf3, 0x39, 0x8d, 0xfb, 0xd4, 0x37, 0x8c, 0x36, 0x88,
+	0xfa, 0x89, 0x32, 0x1a, 0xfb, 0x66, 0xb8, 0x38, 0x3a, 0x4d, 0x3a, 0x25, 0x81, 0x63, 0xdf, 0xf8,
+	0xb7, 0xd0, 0xfa, 0xcc, 0x3b, 0x1a, 0x50, 0xb5, 0x61, 0xcc, 0x16, 0x95, 0x41, 0xd4, 0x53, 0x56,
+	0x6c, 0x09, 0x2b, 0xaa, 0x61, 0xc2, 0xc7, 0xf0, 0xff, 0x96, 0xa1, 0x79, 0xe8, 0xc5, 0x69, 0xc0,
+	0x70, 0xcf, 0x69, 0xea, 0xa1, 0x9b, 0x50, 0x65, 0x1b, 0x93, 0x70, 0x71, 0x1b, 0xdb, 0xab, 0x62,
+	0x9a, 0xb1, 0x8d, 0x44, 0x8c, 0xa3, 0x1b, 0xb0, 0x34, 0x88, 0xfa, 0x02, 0x29, 0xed, 0x9c, 0x21,
+	0xd8, 0x68, 0x1c, 0x9d, 0xca, 0x51, 0x61, 0xe1, 0x0c, 0x81, 0xb6, 0xa4, 0x68, 0x15, 0xbe, 0xc6,
+	0x55, 0xb1, 0x86, 0x2d, 0xbe, 0x10, 0x90, 0xb9, 0xd6, 0xc8, 0x8b, 0xd9, 0x56, 0x56, 0x39, 0x13,
+	0x09, 0x31, 0x8f, 0x97, 0x9b, 0xde, 0x59, 0xe4, 0x3e, 0xab, 0x40, 0xf4, 0x16, 0x94, 0xe2, 0x7e,
+	0xa7, 0xc6, 0x39, 0x37, 0x04, 0x67, 0x7e, 0x02, 0x48, 0x29, 0xee, 0x33, 0x76, 0x4c, 0xdd, 0x27,
+	0xbb, 0x9d, 0xba, 0x60, 0x27, 0x20, 0x26, 0xee, 0x28, 0x39, 0xa1, 0x71, 0x12, 0x44, 0x61, 0x67,
+	0x49, 0x88, 0xab, 0x11, 0xe8, 0x03, 0x68, 0xf4, 0xa2, 0xe1, 0x28, 0xa6, 0x09, 0x1f, 0x87, 0x4d,
+	0x67, 0xab, 0xb5, 0x7d, 0x4d, 0xf0, 0x7e, 0x98, 0x0d, 0x7c, 0x76, 0x3e, 0xa2, 0xc4, 0xa4, 0x44,
+	0xef, 0x40, 0x6b, 0x14, 0xd3, 0xe3, 0xe0, 0x6c, 0x67, 0x10, 0x45, 0xc3, 0x67, 0x34, 0xec, 0x34,
+	0xf8, 0x01, 0xc9, 0x61, 0xd1, 0x26, 0x34, 0x86, 0xde, 0xd9, 0x0b, 0xb1, 0x5c, 0xd2, 0x59, 0xe6,
+	0x44, 0x26, 0x0a, 0xdd, 0x82, 0xb6, 0x94, 0x86, 0x50, 0xe6, 0xc5, 0x4c, 0x8e, 0x26, 0x97, 0xb3,
+	0x80, 0x47, 0xb7, 0x61, 0x71, 0xc8, 0x7d, 0xa8, 0xd3, 0xe2, 0x56, 0xb8, 0x22, 0x24, 0xb5, 0xfc,
+	0x8a, 0x48, 0x12, 0x7c, 0x0f, 0xea, 0x87, 0xdd, 0x5d, 0x9a, 0x7a, 0xc1, 0x80, 0xf9, 0xd5, 0x61,
+	0x57, 0x9f, 0x6f, 0xfe, 0xcd, 0x0c, 0xed, 0xf9, 0x3e, 0x53, 0x88, 0x6f, 0xf2, 0x12, 0x51, 0x20,
+	0xfe, 0xd6, 0x01, 0x20, 0xb4, 0x1f, 0x44, 0xe1, 0x93, 0xf0, 0x38, 0x92, 0x76, 0x77, 0x2e, 0xb3,
+	0x7b, 0xc9, 0xb2, 0xbb, 0x5a, 0xb1, 0x6c, 0xac, 0x88, 0xa0, 0xc2, 0x96, 0xe0, 0xce, 0xb1, 0x44,
+	0xf8, 0xb7, 0xbd, 0x3f, 0xd5, 0xdc, 0xfe, 0xe0, 0xbf, 0x2f, 0xc1, 0x32, 0xf1, 0x4e, 0x77, 0x06,
+	0x51, 0xef, 0x35, 0x77, 0xe2, 0x77, 0xa0, 0x92, 0x9e, 0x8f, 0x28, 0x97, 0xa6, 0xb5, 0x8d, 0x94,
+	0x34, 0x82, 0x82, 0x6f, 0x13, 0x1f, 0x67, 0xfb, 0xa3, 0xf6, 0x8f, 0xfa, 0xdd, 0xe0, 0x6b, 0x2a,
+	0x03, 0x58, 0x0e, 0xcb, 0xac, 0xff, 0xa7, 0x61, 0x8e, 0xb2, 0xcc, 0x29, 0x0b, 0x78, 0x16, 0x1e,
+	0x4e, 0x46, 0x7b, 0x2a, 0x54, 0x56, 0xb8, 0xac, 0x06, 0x86, 0x85, 0x96, 0x93, 0xd1, 0x81, 0x08,
+	0x97, 0x55, 0xce, 0x43, 0xc3, 0xcc, 0x4c, 0x09, 0x7d, 0xf3, 0x27, 0xe3, 0x21, 0x77, 0xea, 0x0a,
+	0x91, 0x50, 0xde, 0x01, 0x6b, 0xb3, 0x3a, 0x20, 0xee, 0xf2, 0x08, 0xdc, 0x7b, 0x2d, 0xf9, 0x1b,
+	0x91, 0x68, 0x59, 0x44, 0x22, 0x33, 0xac, 0x97, 0xa6, 0x86, 0xf5, 0xb2, 0x15, 0xd6, 0xff, 0xa7,
+	0x04, 0xc0, 0x0f, 0xeb, 0x93, 0xd0, 0xa7, 0x67, 0xe8, 0xb6, 0x7d, 0xf9, 0x98, 0x31, 0x43, 0x2d,
+	0xac, 0xef, 0x23, 0xe6, 0xe9, 0x47, 0xcc, 0xeb, 0x1f, 0x05, 0x83, 0x94, 0xc6, 0xf2, 0xbe, 0x31,
+	0x51, 0xe8, 0x57, 0xd0, 0xa4, 0x49, 0x1a, 0x0c, 0xbd, 0xd4, 0x30, 0x74, 0x85, 0xd8, 0x48, 0xc6,
+	0x27, 0x1c, 0x0f, 0x0f, 0x8e, 0xf9, 0x22, 0x22, 0x90, 0x34, 0x89, 0x89, 0x42, 0x77, 0x60, 0xd5,
+	0x38, 0x65, 0x72, 0xbd, 0x2a, 0x5f, 0xaf, 0x38, 0xc0, 0x1d, 0x8c, 0x23, 0xd9, 0x21, 0x5d, 0xe4,
+	0xdc, 0x32, 0x04, 0x7a, 0x1f, 0x96, 0x63, 0xe6, 0xcb, 0xbb, 0x74, 0x40, 0x53, 0x9a, 0x74, 0x6a,
+	0xa6, 0x9e, 0x24, 0x1b, 0x21, 0x16, 0x19, 0x9b, 0x26, 0x5d, 0xf4, 0xb3, 0x60, 0x48, 0x93, 0x4e,
+	0xdd, 0x9c, 0xf6, 0x22, 0x1b, 0x21, 0x16, 0x19, 0x93, 0xa5, 0x3f, 0x88, 0x8e, 0xbc, 0x01, 0xbb,
+	0x9d, 0x64, 0x30, 0xd2, 0x08, 0x7c, 0x1f, 0x1a, 0xc6, 0x54, 0xb6, 0xa5, 0x09, 0x7d, 0xa3, 0x2e,
+	0x97, 0x44, 0xdc, 0x5d, 0xe3, 0x30, 0x38, 0x63, 0xa3, 0xf2, 0x7e, 0xd1, 0x30, 0xde, 0x87, 0x86,
+	0x21, 0x2e, 0xbb, 0x87, 0x78, 0x12, 0x20, 0x3d, 0x42, 0x00, 0x8c, 0x25, 0x0d, 0x7d, 0xb9, 0x37,
+	0xec, 0x53, 0x2d, 0x52, 0xd6, 0x8b, 0xe0, 0xdf, 0x87, 0xf5, 0x7d, 0x9a, 0x5a, 0x57, 0x07, 0xa1,
+	0x6f, 0xc6, 0x34, 0x49, 0x27, 0x45, 0x11, 0xec, 0x41, 0xa7, 0x48, 0x9e, 0x8c, 0xa2, 0x30, 0xa1,
+	0xe8, 0x06, 0x54, 0x7a, 0x91, 0xaf, 0x0e, 0x6b, 0xfd, 0x2e, 0xf7, 0x69, 0x9f, 0x12, 0x8e, 0x45,
+	0x37, 0xa1, 0x32, 0xa4, 0xa9, 0xc7, 0xef, 0x3a, 0x1d, 0xca, 0x6c, 0x46, 0x9c, 0x00, 0x3f, 0x82,
+	0x96, 0x46, 0x3f, 0xa3, 0x5e, 0x42, 0xe5, 0xdd, 0x91, 0x25, 0x2c, 0x12, 0xb2, 0x83, 0x49, 0x29,
+	0x1f, 0x4c, 0xfe, 0xc1, 0x31, 0xae, 0xc4, 0x67, 0x91, 0xe7, 0x4f, 0xe5, 0xd3, 0x86, 0xf2, 0x9b,
+	0x51, 0x22, 0x39, 0xb0, 0x4f, 0x76, 0xf6, 0x4f, 0xe3, 0x20, 0xa5, 0x3b, 0xe7, 0xcc, 0x4b, 0x84,
+	0xb9, 0x0c, 0x0c, 0x4b, 0x3d, 0x86, 0x74, 0x98, 0xb2, 0xb3, 0xc3, 0x5d, 0x5b, 0x44, 0x07, 0x0b,
+	0xc7, 0xa4, 0xcb, 0x08, 0x64, 0xa8, 0xd3, 0x08, 0x4c, 0x60, 0x95, 0xd0, 0x90, 0x9e, 0x72, 0x0d,
+	0x2f, 0xb0, 0x38, 0xfa, 0x35, 0x54, 0x07, 0x91, 0xe7, 0x27, 0x53, 0x0c, 0xc7, 0x14, 0x23, 0x82,
+	0x02, 0xff, 0xad, 0x03, 0xc8, 0x64, 0x3a, 0xd3, 0xbe, 0x74, 0xa0, 0xc6, 0xfe, 0xee, 0x52, 0x7d,
+	0x2f, 0x48, 0x10, 0xdd, 0x81, 0xc5, 0x01, 0x63, 0xc4, 0x0c, 0x50, 0xce, 0xae, 0x77, 0x7b, 0x73,
+	0x88, 0xa4, 0x61, 0x46, 0x4c, 0xd3, 0x01, 0xb7, 0x44, 0x99, 0xb0, 0x4f, 0xdc, 0x87, 0xeb, 0x5d,
+	0x9a, 0x12, 0x95, 0x2c, 0xf0, 0x48, 0x93, 0x28, 0x55, 0x37, 0xa1, 0x31, 0x52, 0x8c, 0xb4, 0xc6,
+	0x26, 0x4a, 0xe7, 0x16, 0xa5, 0xcb, 0x72, 0x0b, 0xfc, 0x21, 0xb8, 0x93, 0x16, 0x9a, 0x45, 0x7d,
+	0x7c, 0x05, 0x56, 0xf7, 0x69, 0x2a, 0xae, 0x3f, 0x25, 0x1c, 0xfe, 0x02, 0x90, 0x89, 0x9c, 0xc9,
+	0x8e, 0xb7, 0xa0, 0x16, 0x8b, 0x09, 0x72, 0xa7, 0xda, 0x32, 0xaa, 0xe8, 0x9b, 0x95, 0x28, 0x02,
+	0x7c, 0x93, 0x6d, 0x7e, 0x3f, 0x48, 0x52, 0x1a, 0x1f, 0x76, 0x8d, 0xcd, 0xe7, 0xd7, 0xa5, 0x93,
+	0x5d, 0x97, 0x78, 0x07, 0x90, 0x49, 0x38, 0x93, 0x20, 0x2d, 0x28, 0x05, 0xbe, 0x74, 0xe6, 0x52,
+	0xe0, 0x63, 0x04, 0x6d, 0x76, 0x64, 0xbb, 0x5c, 0x04, 0xa9, 0xe0, 0x1f, 0xc1, 0xaa, 0x81, 0x93,
+	0x6c, 0xb7, 0xa0, 0x96, 0xd0, 0x98, 0x1d, 0x1f, 0x3b, 0xd5, 0x54, 0x69, 0x05, 0x51, 0xc3, 0xf8,
+	0x9b, 0x12, 0xb4, 0x77, 0xa2, 0x28, 0x4d, 0xd2, 0xd8, 0x1b, 0x29, 0xf9, 0xaf, 0x32, 0x47, 0xed,
+	0xeb, 0xbd, 0x14, 0x00, 0xc3, 0xc6, 0xd1, 0xa9, 0xbe, 0x94, 0x04, 0x60, 0x64, 0x83, 0x65, 0x2b,
+	0x1b, 0xcc, 0xdd, 0x8f, 0x95, 0x39, 0x12, 0xb4, 0xea, 0x2c, 0x09, 0xda, 0xe2, 0x6c, 0x09, 0x5a,
+	0x6d, 0x72, 0x82, 0x86, 0x1f, 0xc2, 0xaa, 0x61, 0x06, 0x69, 0xc6, 0x69, 0x51, 0x26, 0xd3, 0xb9,
+	0x64, 0xea, 0x8c, 0xff, 0xc3, 0x81, 0x6b, 0xdd, 0xd1, 0x20, 0xc8, 0xa2, 0xaa, 0xb2, 0xe8, 0x34,
+	0x4e, 0xac, 0xa8, 0x61, 0x13, 0xb2, 0x42, 0x4f, 0xc3, 0xd9, 0x2e, 0x94, 0x27, 0xee, 0x42, 0xc5,
+	0xdc, 0x05, 0x75, 0xc2, 0xaa, 0xb3, 0x64, 0xef, 0xac, 0x58, 0x78, 0xb2, 0xab, 0xf2, 0x19, 0x01,
+	0x15, 0xca, 0xb4, 0x5a, 0xb1, 0x4c, 0xc3, 0x21, 0xac, 0xe5, 0xd5, 0x9b, 0x33, 0x30, 0xdd, 0x80,
+	0xa5, 0x90, 0x9e, 0xca, 0x3c, 0x54, 0xd6, 0x24, 0x1a, 0x81, 0xff, 0x1c, 0xae, 0xf1, 0x0c, 0x79,
+	0x66, 0x73, 0x6e, 0x42, 0x23, 0x0e, 0xfa, 0xaf, 0x52, 0x2b, 0xb1, 0x35, 0x51, 0xb3, 0x97, 0x39,
+	0xf8, 0x10, 0xd6, 0xf2, 0x8b, 0xcf, 0xa7, 0x2c, 0xfe, 0x02, 0xd6, 0xbb, 0x34, 0xb5, 0x73, 0xfe,
+	0x4b, 0x14, 0xca, 0xea, 0x86, 0xd2, 0xe5, 0x75, 0x03, 0x81, 0x4e, 0x91, 0xff, 0x9c, 0x32, 0x7f,
+	0x0e, 0xab, 0x5d, 0x9a, 0xca, 0xe2, 0xf9, 0x32, 0x69, 0xef, 0x64, 0x15, 0xa0, 0x10, 0x17, 0x15,
+	0xfb, 0x04, 0xba, 0x2a, 0xc4, 0x5f, 0x03, 0x32, 0x59, 0xcf, 0x7d, 0xc5, 0xe9, 0xb5, 0xcb, 0x97,
+	0xaf, 0xfd, 0x1b, 0x58, 0xdb, 0xa7, 0x69, 0x97, 0x3b, 0xb7, 0x7d, 0x9b, 0x4d, 0xd1, 0x0d, 0x8f,
+	0x61, 0xbd, 0x30, 0x63, 0x4e, 0x91, 0x55, 0x37, 0xa0, 0x7c, 0x41, 0x37, 0xe0, 0x13, 0xb8, 0xfa,
+	0xc0, 0xf7, 0xb3, 0x5a, 0x7f, 0x96, 0x80, 0xc2, 0x09, 0xb3, 0xe2, 0x41, 0xc1, 0xf8, 0x00, 0xae,
+	0xe5, 0x78, 0xcd, 0xe9, 0x1c, 0x8f, 0xa0, 0x43, 0xa8, 0x97, 0x24, 0x41, 0x3f, 0x9c, 0xf9, 0x88,
+	0xaa, 0xc4, 0xa8, 0x64, 0xa4, 0xa2, 0x5d, 0xb8, 0x3e, 0x81, 0xcf, 0x9c, 0xc2, 0x7d, 0x69, 0xb6,
+	0x51, 0xa2, 0x13, 0x7a, 0x91, 0x44, 0xc7, 0xac, 0x6d, 0x24, 0x25, 0x62, 0xdf, 0xec, 0xe6, 0x4d,
+	0x23, 0x19, 0x90, 0x4a, 0x69, 0x24, 0x5a, 0x3e, 0x9e, 0xcf, 0xc3, 0x86, 0x43, 0xf8, 0x37, 0xde,
+	0x82, 0xd6, 0x8e, 0x37, 0xf0, 0xc2, 0x1e, 0x35, 0x74, 0xf6, 0xe3, 0x73, 0x32, 0x0e, 0xf9, 0x0a,
+	0x75, 0x22, 0x21, 0x9c, 0xc2, 0x8a, 0xa6, 0x9c, 0xd3, 0x67, 0x7e, 0x0d, 0xd5, 0x61, 0x74, 0xa2,
+	0x13, 0xb9, 0x42, 0xf2, 0x1d, 0x9d, 0x50, 0x22, 0x28, 0xf0, 0x5f, 0x3b, 0x00, 0x87, 0xe3, 0x54,
+	0x09, 0x57, 0x2c, 0x34, 0xad, 0x96, 0xd7, 0xb2, 0x6c, 0x79, 0xb1, 0x90, 0xbc, 0x77, 0x36, 0x0a,
+	0x62, 0x9a, 0x3c, 0x50, 0x77, 0x7a, 0x86, 0xb0, 0x13, 0xf5, 0x4a, 0xbe, 0x2b, 0x23, 0x4d, 0x1c,
+	0xf8, 0x46, 0x6b, 0x28, 0x0d, 0x7c, 0xfc, 0x2e, 0x34, 0xb8, 0x24, 0x52, 0xf9, 0xa2, 0x28, 0xb2,
+	0x9a, 0x29, 0x65, 0xd5, 0xcc, 0x4b, 0x68, 0xca, 0x02, 0x6e, 0xaa, 0xfc, 0x17, 0x16, 0x0d, 0x53,
+	0x65, 0x21, 0xd0, 0x52, 0x8c, 0xa7, 0x8a, 0x73, 0x31, 0xe7, 0x62, 0xe9, 0x15, 0x03, 0x92, 0x3c,
+	0x79, 0x7b, 0x25, 0x4b, 0xa3, 0x66, 0x2a, 0xe5, 0xac, 0xd5, 0xca, 0xd3, 0xf5, 0xa8, 0x58, 0x7a,
+	0xdc, 0x84, 0x2b, 0xd6, 0x9a, 0x99, 0x32, 0x76, 0xf1, 0x89, 0x43, 0x00, 0x9e, 0x02, 0xff, 0xff,
+	0xcc, 0xd8, 0x81, 0x9a, 0x2d, 0x5a, 0xed, 0x32, 0x03, 0x7f, 0x0a, 0x0d, 0xbe, 0xde, 0x54, 0xeb,
+	0x4e, 0xf6, 0x3b, 0x17, 0xea, 0x61, 0x94, 0x3e, 0x8a, 0xc6, 0xa1, 0x88, 0xe0, 0x75, 0xa2, 0x61,
+	0xfc, 0x8f, 0x0e, 0x4f, 0xe3, 0x55, 0x66, 0x37, 0x87, 0x4b, 0x1c, 0xd1, 0xe3, 0x28, 0x56, 0x0d,
+	0x0c, 0x09, 0xf1, 0x4c, 0x2b, 0x18, 0x06, 0xa9, 0xec, 0x59, 0x08, 0x80, 0x51, 0x73, 0xc9, 0x44,
+	0x56, 0x55, 0x27, 0x12, 0x32, 0xf4, 0x5e, 0xb4, 0xf4, 0x4e, 0x01, 0x9e, 0xd2, 0xf3, 0x17, 0x45,
+	0xbb, 0x39, 0xb6, 0xdd, 0x26, 0xab, 0xcf, 0xba, 0xa7, 0x7c, 0x3b, 0x95, 0xf6, 0x0a, 0x64, 0x3a,
+	0x51, 0x7d, 0x20, 0xe5, 0x91, 0xd3, 0x08, 0xec, 0xc1, 0x15, 0xcb, 0x32, 0xd2, 0xea, 0x77, 0xa0,
+	0x2e, 0xd7, 0x53, 0x25, 0x80, 0x2c, 0x62, 0x32, 0x11, 0x89, 0xa6, 0x60, 0x4b, 0xe8, 0x5e, 0x3b,
+	0x17, 0xab, 0x4e, 0x32, 0x04, 0xfe, 0x4b, 0x07, 0x5a, 0x07, 0x47, 0x5f, 0xd1, 0x5e, 0xfa, 0xdc,
+	0x0b, 0x83, 0x63, 0x66, 0x79, 0xd6, 0xd0, 0x18, 0xb1, 0x28, 0x28, 0xa3, 0xe9, 0x12, 0xd1, 0x30,
+	0xb3, 0xcf, 0x80, 0x86, 0xfd, 0xf4, 0x95, 0xca, 0x8e, 0x05, 0xc4, 0x16, 0xe9, 0xbd, 0x1a, 0x87,
+	0xaf, 0x8d, 0x56, 0x5d, 0x86, 0x60, 0xa3, 0xe1, 0x78, 0xf8, 0x90, 0xc1, 0xaa, 0x77, 0x94, 0x21,
+	0xf0, 0x97, 0xb0, 0xf4, 0x30, 0x0a, 0x7d, 0x1e, 0xe3, 0xd8, 0xbd, 0x69, 0xb4, 0x12, 0x5b, 0xaa,
+	0xa6, 0x08, 0x7d, 0xa3, 0x8d, 0x68, 0x98, 0xbf, 0x34, 0xc5, 0xfc, 0x65, 0xc3, 0xfc, 0xf8, 0x73,
+	0xd6, 0x76, 0x0c, 0x7d, 0x23, 0x5e, 0x62, 0x28, 0x8f, 0xc6, 0xa9, 0xec, 0x9e, 0x4a, 0xe3, 0x65,
+	0xc3, 0x84, 0x0d, 0xa2, 0xdf, 0x63, 0x51, 0x3c, 0x54, 0xd9, 0xce, 0x4a, 0x26, 0x89, 0xb8, 0xc2,
+	0xf8, 0x20, 0xfe, 0x33, 0x58, 0xd1, 0xac, 0xe7, 0x8c, 0xfe, 0xc5, 0xc8, 0x43, 0x61, 0x95, 0x31,
+	0xb7, 0x43, 0xe5, 0x6d, 0x58, 0x14, 0xce, 0x23, 0xa5, 0x97, 0xb7, 0x84, 0x45, 0x44, 0x24, 0xc9,
+	0x6c, 0x3a, 0x7c, 0x01, 0xc8, 0x5c, 0xe6, 0x67, 0x57, 0xc3, 0x87, 0xb6, 0xae, 0x2c, 0x72, 0x19,
+	0x44, 0xe0, 0x9b, 0xf7, 0x75, 0xe0, 0x5f, 0x58, 0x33, 0x5d, 0x18, 0x4a, 0xf1, 0x37, 0x0e, 0xac,
+	0x1a, 0xcb, 0xfc, 0x2e, 0x6b, 0x17, 0x4b, 0xca, 0x8a, 0x2d, 0x25, 0x7e, 0x0c, 0x6d, 0x5d, 0x5a,
+	0x5c, 0xa6, 0xed, 0xc5, 0x9d, 0xb1, 0x21, 0xac, 0x1a, 0x9c, 0xe6, 0x54, 0x28, 0x57, 0x3d, 0x95,
+	0x0b, 0xd5, 0x13, 0xfe, 0xab, 0x12, 0xf3, 0xe5, 0xe1, 0xc8, 0xeb, 0x31, 0xdf, 0x10, 0xaf, 0x83,
+	0xe2, 0xe0, 0x8a, 0x94, 0xb8, 0xe3, 0xe8, 0x83, 0x2b, 0x10, 0xac, 0x75, 0x3c, 0xa2, 0xa1, 0x1f,
+	0x84, 0x7d, 0x49, 0x21, 0xba, 0xf9, 0x36, 0x92, 0x15, 0x9f, 0x12, 0x61, 0xb6, 0xe9, 0x2c, 0x1c,
+	0x93, 0x3b, 0x1e, 0x87, 0x61, 0x10, 0xf6, 0xb9, 0x3d, 0xeb, 0x44, 0x81, 0xfc, 0xe6, 0x18, 0x0f,
+	0x9f, 0x07, 0x61, 0x14, 0xcb, 0xab, 0x48, 0xc3, 0x6a, 0xcc, 0xfb, 0x2a, 0x8a, 0x65, 0xb8, 0xd6,
+	0x30, 0x7f, 0x2e, 0xf3, 0x92, 0xb4, 0xcb, 0xef, 0xe8, 0x1a, 0xef, 0x76, 0x65, 0x08, 0xb6, 0x1e,
+	0x03, 0xf6, 0x42, 0x9f, 0x3f, 0x4c, 0x95, 0x89, 0x02, 0xf1, 0xbf, 0x39, 0xb0, 0xc2, 0x3b, 0xda,
+	0x0f, 0xbd, 0xde, 0x2b, 0x2a, 0xac, 0xd0, 0x81, 0xda, 0xd0, 0x3b, 0x7b, 0x18, 0x25, 0x22, 0x62,
+	0x94, 0x89, 0x02, 0x59, 0xea, 0xf8, 0x2a, 0x48, 0x55, 0x4f, 0x92, 0x7f, 0xb3, 0xcd, 0x1e, 0x06,
+	0x49, 0xa2, 0x35, 0x95, 0x10, 0x93, 0xe8, 0x35, 0x3d, 0x4f, 0x1e, 0xf8, 0x3e, 0x55, 0xd7, 0x7d,
+	0x86, 0x60, 0xfb, 0xc3, 0x80, 0xbd, 0x93, 0xa0, 0xc7, 0xe2, 0xb4, 0x50, 0xd5, 0x44, 0xf1, 0x10,
+	0x1b, 0x25, 0xa9, 0x98, 0x2f, 0xd4, 0xcd, 0x10, 0x6c, 0x3e, 0x03, 0xd4, 0x7c, 0x51, 0xe1, 0x9b,
+	0x28, 0xfc, 0x77, 0x0e, 0xb4, 0x8d, 0xe6, 0x8c, 0x50, 0x2d, 0xd7, 0xc9, 0x71, 0x66, 0xee, 0xe4,
+	0xb8, 0x50, 0x8f, 0xbd, 0x53, 0xb1, 0xa3, 0xb2, 0x16, 0x51, 0x30, 0xda, 0x82, 0x95, 0x9e, 0x7e,
+	0xa4, 0x31, 0x37, 0x3d, 0x8f, 0xc6, 0xb7, 0xa0, 0xcd, 0xbc, 0x4f, 0x14, 0x70, 0x17, 0x1f, 0x16,
+	0xfc, 0x5d, 0x19, 0x56, 0x0d, 0xe2, 0x39, 0xcf, 0x03, 0x6b, 0xb5, 0x50, 0xcf, 0x57, 0x92, 0x09,
+	0x80, 0xad, 0xcd, 0xdb, 0xc7, 0x89, 0xca, 0xc7, 0x04, 0x94, 0x6b, 0x34, 0x57, 0x2f, 0x6d, 0x34,
+	0x2f, 0x5e, 0xd6, 0x68, 0xae, 0xe5, 0x1a, 0xcd, 0xe8, 0x7d, 0x80, 0x9e, 0x3e, 0x7c, 0xdc, 0x29,
+	0x1b, 0xe6, 0x3e, 0x18, 0x87, 0x92, 0x18, 0x84, 0x6c, 0xda, 0x91, 0xf6, 0xd6, 0xce, 0x92, 0x39,
+	0x2d, 0xe7, 0xc5, 0xc4, 0x20, 0x44, 0x3b, 0xd0, 0x16, 0x50, 0xee, 0x99, 0xb5, 0xb1, 0xbd, 0x56,
+	0xd8, 0x7b, 0x31, 0xbb, 0x40, 0x8f, 0xf7, 0x61, 0xe5, 0x60, 0x44, 0xc3, 0xf9, 0xe3, 0xdc, 0x27,
+	0xd0, 0xce, 0x18, 0xcd, 0x59, 0x18, 0x3e, 0x86, 0xf6, 0xc3, 0x41, 0x94, 0xfc, 0x0c, 0xd1, 0xf7,
+	0x29, 0xac, 0x1a, 0x9c, 0xe6, 0x14, 0xeb, 0x0c, 0x96, 0x5f, 0x7a, 0x69, 0xef, 0x95, 0x29, 0x12,
+	0xef, 0x78, 0xca, 0xfc, 0x56, 0x42, 0xfa, 0x57, 0x23, 0x5d, 0x5d, 0x2f, 0x69, 0xd8, 0x50, 0xa3,
+	0x3c, 0x5d, 0x8d, 0x7c, 0xd5, 0x86, 0xff, 0xc9, 0x01, 0xe0, 0x4b, 0xef, 0x9d, 0xd0, 0x70, 0xf6,
+	0x42, 0xb1, 0x70, 0x8b, 0xf3, 0xfa, 0x57, 0xe4, 0x1d, 0x15, 0x59, 0xff, 0x72, 0xc8, 0xce, 0x60,
+	0xab, 0xb9, 0x0c, 0x96, 0x85, 0x25, 0x3f, 0x2b, 0x64, 0xf8, 0xb9, 0xa8, 0x13, 0x13, 0xa5, 0x4a,
+	0xa6, 0x9a, 0x2e, 0x99, 0xf0, 0x1f, 0x42, 0x53, 0x1a, 0x4b, 0x77, 0xbc, 0x17, 0x29, 0x93, 0x3e,
+	0x97, 0xed, 0x66, 0x6a, 0x11, 0x39, 0x8e, 0xf7, 0xa0, 0xb9, 0x77, 0x36, 0x8a, 0xe6, 0xdd, 0xfb,
+	0x6f, 0x1d, 0x68, 0x08, 0x3e, 0x85, 0x5f, 0x94, 0x5c, 0x68, 0xb5, 0xe9, 0xf5, 0x94, 0x51, 0x01,
+	0x54, 0x2e, 0xa8, 0x00, 0xf2, 0xf6, 0xc3, 0x07, 0xd0, 0x52, 0x0a, 0x49, 0x63, 0xdc, 0x86, 0x1a,
+	0x0d, 0xd3, 0x38, 0xa0, 0xb9, 0xe7, 0x5f, 0x43, 0x5e, 0xa2, 0x28, 0x26, 0x94, 0xde, 0xdf, 0x3b,
+	0xd0, 0x7c, 0x12, 0xf6, 0x69, 0x32, 0x9f, 0x89, 0xd0, 0xdb, 0xbc, 0xd5, 0xdc, 0x7b, 0xad, 0x9a,
+	0x15, 0x4b, 0x77, 0x55, 0xc8, 0x21, 0x72, 0x00, 0xbd, 0x03, 0xd5, 0x80, 0xbd, 0x58, 0xcb, 0x7e,
+	0x6c, 0xdb, 0xe8, 0xc7, 0xf2, 0x97, 0x6c, 0x22, 0x86, 0x59, 0xd1, 0xae, 0x24, 0x9a, 0x56, 0xe7,
+	0x32, 0x21, 0x53, 0x33, 0xc7, 0x90, 0x10, 0x5a, 0x33, 0xc4, 0x50, 0x1d, 0xef, 0xde, 0xeb, 0x04,
+	0xff, 0xb3, 0x03, 0x4b, 0x52, 0xc1, 0x83, 0x11, 0x7a, 0x0f, 0x1a, 0xb1, 0x00, 0xbe, 0xbc, 0x20,
+	0xed, 0x7f, 0xbc, 0x40, 0x40, 0x92, 0x1d, 0x8e, 0x53, 0xf4, 0x11, 0xb4, 0xd4, 0x24, 0xe9, 0xf8,
+	0xa5, 0xa9, 0x09, 0xf7, 0xe3, 0x05, 0xd2, 0x94, 0xc4, 0x02, 0x6f, 0x2e, 0xd9, 0x97, 0x2f, 0xfa,
+	0x7a, 0xc9, 0x7d, 0x3a, 0x61, 0xc9, 0x7d, 0x9a, 0xee, 0x2c, 0x41, 0x4d, 0x42, 0xf8, 0x5f, 0xf9,
+	0xaf, 0x3d, 0x84, 0x3d, 0x0e, 0x46, 0xe8, 0x0f, 0x60, 0x39, 0x96, 0x90, 0xa1, 0xc2, 0xaa, 0xa1,
+	0x82, 0x18, 0x7c, 0xbc, 0x40, 0x1a, 0x8a, 0x90, 0x29, 0xf1, 0xc7, 0xb0, 0xa2, 0xe7, 0x59, 0x5a,
+	0x5c, 0xb5, 0xb5, 0xd0, 0xb3, 0x5b, 0x8a, 0x5c, 0xea, 0x61, 0x2e, 0x9c, 0x29, 0xb2, 0x6a, 0x28,
+	0x52, 0x5c, 0x98, 0xa9, 0x02, 0x50, 0x57, 0x20, 0x7e, 0x17, 0x96, 0x77, 0xcc, 0xe8, 0xf7, 0x36,
+	0x94, 0x63, 0xbe, 0xbd, 0xe5, 0xac, 0x28, 0xd1, 0x9b, 0x45, 0xd8, 0x18, 0x7e, 0x0f, 0x9a, 0x3b,
+	0x56, 0x0c, 0xc0, 0x6c, 0x4e, 0x2e, 0x00, 0x64, 0xf6, 0x61, 0x93, 0x12, 0xfc, 0x37, 0xfc, 0x77,
+	0x29, 0x46, 0x93, 0x66, 0x5a, 0x98, 0xd5, 0xcd, 0x9b, 0x92, 0xd9, 0xbc, 0xd1, 0x9d, 0x82, 0x72,
+	0xae, 0x53, 0x30, 0xa9, 0x45, 0x73, 0xf1, 0x4f, 0x64, 0x54, 0x54, 0x5b, 0xcc, 0x1a, 0x41, 0x2c,
+	0xc5, 0xa5, 0x6c, 0x58, 0x5c, 0xfe, 0x75, 0xa2, 0x40, 0xa3, 0x17, 0x51, 0xb7, 0x7a, 0x11, 0x18,
+	0x96, 0x7b, 0x51, 0x98, 0x06, 0xe1, 0x98, 0x37, 0x8d, 0xf9, 0xed, 0xbe, 0x4c, 0x2c, 0x9c, 0x19,
+	0x71, 0xc0, 0x8a, 0x38, 0xf8, 0x2f, 0xa0, 0x69, 0x37, 0x8f, 0xac, 0x3e, 0x80, 0xcc, 0xe5, 0x35,
+	0x82, 0x65, 0xb2, 0x2c, 0xd9, 0xe4, 0x8f, 0xa2, 0xcb, 0x84, 0x7f, 0x1b, 0x82, 0x95, 0x39, 0x76,
+	0x9a, 0x60, 0x95, 0xa2, 0x60, 0xb7, 0x70, 0xf6, 0x13, 0x21, 0x96, 0x3c, 0xa2, 0x3a, 0x54, 0x7c,
+	0x2f, 0xf5, 0xda, 0x0b, 0xec, 0x6b, 0x48, 0x53, 0xaf, 0xed, 0xdc, 0x7a, 0x17, 0x56, 0x8c, 0x84,
+	0x42, 0x91, 0x85, 0x51, 0x48, 0xdb, 0x0b, 0x08, 0x60, 0x31, 0x09, 0xbd, 0xd1, 0xe8, 0xbc, 0xed,
+	0x30, 0xec, 0xd7, 0x49, 0xea, 0xb7, 0x4b, 0xb7, 0x3e, 0x85, 0xba, 0x6a, 0x06, 0x30, 0x0a, 0x6f,
+	0x70, 0xea, 0x9d, 0x27, 0xed, 0x05, 0xd4, 0xd6, 0x3f, 0xfd, 0xd8, 0x7b, 0x33, 0xf6, 0x06, 0x6d,
+	0x07, 0xb5, 0x00, 0xb8, 0xb8, 0x02, 0x2e, 0x71, 0xea, 0xa3, 0x84, 0x86, 0x69, 0xbb, 0x8c, 0x1a,
+	0x50, 0x63, 0xab, 0x32, 0xa0, 0xb2, 0xfd, 0xdf, 0x75, 0x58, 0xcf, 0x7a, 0xac, 0x5e, 0xe8, 0xf5,
+	0x69, 0xdc, 0xa5, 0xf1, 0x49, 0xd0, 0xa3, 0xe8, 0x73, 0x40, 0xc5, 0x27, 0x6b, 0xf4, 0x4b, 0xe1,
+	0x7e, 0x53, 0x5f, 0xcd, 0xdd, 0xcd, 0xe9, 0x04, 0xf2, 0x48, 0x2c, 0xa0, 0x07, 0xe2, 0xd7, 0x5c,
+	0xe2, 0xcd, 0x18, 0xad, 0x67, 0xaf, 0xd0, 0xd6, 0x73, 0xb3, 0xdb, 0x29, 0x0e, 0x98, 0x2c, 0xb2,
+	0xf7, 0x6f, 0xc5, 0xa2, 0xf0, 0x4c, 0xee, 0x76, 0x8a, 0x03, 0x9a, 0x45, 0x57, 0xbc, 0x3a, 0x5b,
+	0x3f, 0x49, 0xfc, 0x85, 0xa6, 0x9f, 0xf4, 0x7b, 0x13, 0x77, 0x63, 0xda, 0xb0, 0x66, 0xfa, 0x31,
+	0x2c, 0xe9, 0x67, 0x6b, 0xb4, 0x96, 0x91, 0x9b, 0x6f, 0xdb, 0xee, 0x7a, 0x01, 0x6f, 0xce, 0xd7,
+	0xef, 0xb5, 0x6a, 0x7e, 0xfe, 0x1d, 0xdb, 0x5d, 0x2f, 0xe0, 0xf5, 0xfc, 0xe7, 0xd0, 0xb2, 0x9f,
+	0x32, 0xd1, 0x5b, 0x72, 0x43, 0x26, 0xbd, 0xdf, 0xba, 0x37, 0x26, 0x0f, 0x9a, 0xec, 0xec, 0xc7,
+	0x42, 0xc5, 0x6e, 0xe2, 0xfb, 0xa5, 0x7b, 0x63, 0xf2, 0xa0, 0x66, 0xf7, 0x02, 0x56, 0x0b, 0x0f,
+	0x22, 0x68, 0x43, 0x6d, 0xf3, 0xe4, 0x17, 0x17, 0xf7, 0x97, 0x53, 0xc7, 0x6d, 0x87, 0x52, 0xbf,
+	0x2a, 0xc9, 0x1c, 0x2a, 0xf7, 0xe3, 0x15, 0xb7, 0x53, 0x1c, 0xd0, 0x2c, 0xee, 0x41, 0x4d, 0xbe,
+	0x65, 0x20, 0x79, 0x3f, 0xd8, 0x8f, 0x20, 0xee, 0xb5, 0x1c, 0x56, 0xcf, 0xfc, 0x04, 0x9a, 0xd6,
+	0xf3, 0x13, 0x72, 0x05, 0xe5, 0xa4, 0xf7, 0x2d, 0xf7, 0xad, 0x89, 0x63, 0xa6, 0x22, 0xd9, 0xdb,
+	0xa1, 0x52, 0xa4, 0xf0, 0x50, 0xe9, 0x76, 0x8a, 0x03, 0xa6, 0x5b, 0xe7, 0x5f, 0x4b, 0x95, 0x5b,
+	0x4f, 0x79, 0xa5, 0x75, 0x37, 0xa6, 0x0d, 0x6b, 0xa6, 0x87, 0xb0, 0x92, 0x7b, 0x25, 0x44, 0x37,
+	0xb4, 0x13, 0x4f, 0x78, 0x6e, 0x74, 0x7f, 0x31, 0x65, 0x54, 0x71, 0xdc, 0xfe, 0xaf, 0x1a, 0x34,
+	0xf4, 0x56, 0x3e, 0x7d, 0x81, 0xb6, 0xa1, 0xca, 0x6f, 0x3d, 0x84, 0x94, 0x9d, 0xb3, 0x5b, 0xd3,
+	0xbd, 0x62, 0xe1, 0xb4, 0x54, 0x77, 0xa0, 0xcc, 0x2e, 0xfa, 0x42, 0x36, 0xe3, 0x16, 0x93, 0x03,
+	0x41, 0xbd, 0x4f, 0x35, 0xf5, 0x3e, 0xcd, 0x53, 0x1b, 0x37, 0x3a, 0x5e, 0x40, 0xbb, 0xbc, 0xdb,
+	0xaf, 0x7f, 0x73, 0x91, 0x05, 0x92, 0x5c, 0xb3, 0xde, 0xbd, 0x3e, 0x61, 0x44, 0x73, 0x79, 0x1f,
+	0x16, 0x65, 0x32, 0x31, 0x29, 0x75, 0x72, 0x27, 0x66, 0x22, 0x62, 0x71, 0xe3, 0x0d, 0x44, 0x2d,
+	0x5e, 0x7c, 0x8a, 0x71, 0xaf, 0x4f, 0x18, 0xd1, 0x5c, 0xb6, 0xd5, 0x0f, 0xf2, 0x91, 0xf9, 0x1b,
+	0x59, 0xdb, 0xa4, 0xf9, 0x39, 0xf7, 0xa0, 0x26, 0x9b, 0xba, 0xea, 0x18, 0xd8, 0xed, 0x63, 0xf7,
+	0x5a, 0x0e, 0x6b, 0xba, 0x6e, 0xd6, 0x4a, 0x55, 0xae, 0x5b, 0xe8, 0xe1, 0xba, 0x9d, 0xe2, 0x80,
+	0x19, 0xfc, 0x74, 0x24, 0x52, 0xc1, 0x2f, 0xdf, 0x3e, 0x75, 0xd7, 0x0b, 0x78, 0x73, 0xbe, 0x0e,
+	0x3d, 0x6a, 0x7e, 0xbe, 0x21, 0xe9, 0xae, 0x17, 0xf0, 0x7a, 0xfe, 0x7d, 0xa8, 0xab, 0x6a, 0x1c,
+	0x49, 0x3d, 0x73, 0x65, 0xbe, 0xbb, 0x96, 0x47, 0x9b, 0x8b, 0xeb, 0xa2, 0x59, 0x2d, 0x9e, 0xaf,
+	0xc7, 0xdd, 0xf5, 0x02, 0x5e, 0xcf, 0xff, 0x2d, 0x54, 0x5f, 0x9a, 0x07, 0xe0, 0xe5, 0x84, 0x03,
+	0xf0, 0xd2, 0x3e, 0x00, 0xbf, 0x71, 0xd0, 0x07, 0xb0, 0x28, 0xaa, 0x1f, 0xe5, 0x60, 0x56, 0x0d,
+	0xe8, 0x5e, 0xb5, 0x91, 0xf6, 0x44, 0x51, 0x79, 0xa8, 0x89, 0x56, 0x65, 0xe4, 0x5e, 0xb5, 0x91,
+	0x6a, 0xe2, 0x96, 0xc3, 0xf4, 0xd4, 0xad, 0x28, 0xa5, 0x67, 0xbe, 0x91, 0xe5, 0xae, 0x17, 0xf0,
+	0x8a, 0xc3, 0x4e, 0xe7, 0x5f, 0x7e, 0xdc, 0x70, 0x7e, 0xf8, 0x71, 0xc3, 0xf9, 0xcf, 0x1f, 0x37,
+	0x9c, 0xef, 0x7f, 0xda, 0x58, 0xf8, 0xe1, 0xa7, 0x8d, 0x85, 0x7f, 0xff, 0x69, 0x63, 0xe1, 0x68,
+	0x91, 0xff, 0xb7, 0xc9, 0x7b, 0xff, 0x37, 0x00, 0x3e, 0x0e, 0x66, 0xa5, 0x8b, 0x32, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PartitionManagerServiceClient is the client API for PartitionManagerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PartitionManagerServiceClient interface {
+	SetRowStreamTables(ctx context.Context, in *SetRowStreamTablesRequest, opts ...grpc.CallOption) (*SetRowStreamTablesResponse, error)
+	RegisterPS(ctx context.Context, in *RegisterPSRequest, opts ...grpc.CallOption) (*RegisterPSResponse, error)
+	GetRegions(ctx context.Context, in *GetRegionsRequest, opts ...grpc.CallOption) (*GetRegionsResponse, error)
+	GetPartitionMeta(ctx context.Context, in *GetPartitionMetaRequest, opts ...grpc.CallOption) (*GetPartitionMetaResponse, error)
+	GetPSInfo(ctx context.Context, in *GetPSInfoRequest, opts ...grpc.CallOption) (*GetPSInfoResponse, error)
+	Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error)
+	SplitPartition(ctx context.Context, in *SplitPartitionRequest, opts ...grpc.CallOption) (*SplitPartitionResponse, error)
+	MergePartition(ctx context.Context, in *MergePartitionRequest, opts ...grpc.CallOption) (*MergePartitionResponse, error)
+	ReassignPartition(ctx context.Context, in *ReassignPartitionRequest, opts ...grpc.CallOption) (*ReassignPartitionResponse, error)
+	RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*RenewLeaseResponse, error)
+	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
+	AddBlobStream(ctx context.Context, in *AddBlobStreamRequest, opts ...grpc.CallOption) (*AddBlobStreamResponse, error)
+	SetDiscard(ctx context.Context, in *SetDiscardRequest, opts ...grpc.CallOption) (*SetDiscardResponse, error)
+	SetMergedStreams(ctx context.Context, in *SetMergedStreamsRequest, opts ...grpc.CallOption) (*SetMergedStreamsResponse, error)
+	GetSharedTables(ctx context.Context, in *GetSharedTablesRequest, opts ...grpc.CallOption) (*GetSharedTablesResponse, error)
+}
+
+type partitionManagerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPartitionManagerServiceClient(cc *grpc.ClientConn) PartitionManagerServiceClient {
+	return &partitionManagerServiceClient{cc}
+}
+
+func (c *partitionManagerServiceClient) SetRowStreamTables(ctx context.Context, in *SetRowStreamTablesRequest, opts ...grpc.CallOption) (*SetRowStreamTablesResponse, error) {
+	out := new(SetRowStreamTablesResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/SetRowStreamTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) RegisterPS(ctx context.Context, in *RegisterPSRequest, opts ...grpc.CallOption) (*RegisterPSResponse, error) {
+	out := new(RegisterPSResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/RegisterPS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) GetRegions(ctx context.Context, in *GetRegionsRequest, opts ...grpc.CallOption) (*GetRegionsResponse, error) {
+	out := new(GetRegionsResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/GetRegions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) GetPartitionMeta(ctx context.Context, in *GetPartitionMetaRequest, opts ...grpc.CallOption) (*GetPartitionMetaResponse, error) {
+	out := new(GetPartitionMetaResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/GetPartitionMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) GetPSInfo(ctx context.Context, in *GetPSInfoRequest, opts ...grpc.CallOption) (*GetPSInfoResponse, error) {
+	out := new(GetPSInfoResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/GetPSInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error) {
+	out := new(BootstrapResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/Bootstrap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) SplitPartition(ctx context.Context, in *SplitPartitionRequest, opts ...grpc.CallOption) (*SplitPartitionResponse, error) {
+	out := new(SplitPartitionResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/SplitPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) MergePartition(ctx context.Context, in *MergePartitionRequest, opts ...grpc.CallOption) (*MergePartitionResponse, error) {
+	out := new(MergePartitionResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/MergePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) ReassignPartition(ctx context.Context, in *ReassignPartitionRequest, opts ...grpc.CallOption) (*ReassignPartitionResponse, error) {
+	out := new(ReassignPartitionResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/ReassignPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*RenewLeaseResponse, error) {
+	out := new(RenewLeaseResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/RenewLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
+	out := new(BalanceResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) AddBlobStream(ctx context.Context, in *AddBlobStreamRequest, opts ...grpc.CallOption) (*AddBlobStreamResponse, error) {
+	out := new(AddBlobStreamResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/AddBlobStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) SetDiscard(ctx context.Context, in *SetDiscardRequest, opts ...grpc.CallOption) (*SetDiscardResponse, error) {
+	out := new(SetDiscardResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/SetDiscard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) SetMergedStreams(ctx context.Context, in *SetMergedStreamsRequest, opts ...grpc.CallOption) (*SetMergedStreamsResponse, error) {
+	out := new(SetMergedStreamsResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/SetMergedStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionManagerServiceClient) GetSharedTables(ctx context.Context, in *GetSharedTablesRequest, opts ...grpc.CallOption) (*GetSharedTablesResponse, error) {
+	out := new(GetSharedTablesResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionManagerService/GetSharedTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PartitionManagerServiceServer is the server API for PartitionManagerService service.
+type PartitionManagerServiceServer interface {
+	SetRowStreamTables(context.Context, *SetRowStreamTablesRequest) (*SetRowStreamTablesResponse, error)
+	RegisterPS(context.Context, *RegisterPSRequest) (*RegisterPSResponse, error)
+	GetRegions(context.Context, *GetRegionsRequest) (*GetRegionsResponse, error)
+	GetPartitionMeta(context.Context, *GetPartitionMetaRequest) (*GetPartitionMetaResponse, error)
+	GetPSInfo(context.Context, *GetPSInfoRequest) (*GetPSInfoResponse, error)
+	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
+	SplitPartition(context.Context, *SplitPartitionRequest) (*SplitPartitionResponse, error)
+	MergePartition(context.Context, *MergePartitionRequest) (*MergePartitionResponse, error)
+	ReassignPartition(context.Context, *ReassignPartitionRequest) (*ReassignPartitionResponse, error)
+	RenewLease(context.Context, *RenewLeaseRequest) (*RenewLeaseResponse, error)
+	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
+	AddBlobStream(context.Context, *AddBlobStreamRequest) (*AddBlobStreamResponse, error)
+	SetDiscard(context.Context, *SetDiscardRequest) (*SetDiscardResponse, error)
+	SetMergedStreams(context.Context, *SetMergedStreamsRequest) (*SetMergedStreamsResponse, error)
+	GetSharedTables(context.Context, *GetSharedTablesRequest) (*GetSharedTablesResponse, error)
+}
+
+// UnimplementedPartitionManagerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPartitionManagerServiceServer struct {
+}
+
+func (*UnimplementedPartitionManagerServiceServer) SetRowStreamTables(ctx context.Context, req *SetRowStreamTablesRequest) (*SetRowStreamTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRowStreamTables not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) RegisterPS(ctx context.Context, req *RegisterPSRequest) (*RegisterPSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPS not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) GetRegions(ctx context.Context, req *GetRegionsRequest) (*GetRegionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegions not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) GetPartitionMeta(ctx context.Context, req *GetPartitionMetaRequest) (*GetPartitionMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionMeta not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) GetPSInfo(ctx context.Context, req *GetPSInfoRequest) (*GetPSInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPSInfo not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) Bootstrap(ctx context.Context, req *BootstrapRequest) (*BootstrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bootstrap not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) SplitPartition(ctx context.Context, req *SplitPartitionRequest) (*SplitPartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitPartition not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) MergePartition(ctx context.Context, req *MergePartitionRequest) (*MergePartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePartition not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) ReassignPartition(ctx context.Context, req *ReassignPartitionRequest) (*ReassignPartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignPartition not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) RenewLease(ctx context.Context, req *RenewLeaseRequest) (*RenewLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLease not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) Balance(ctx context.Context, req *BalanceRequest) (*BalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) AddBlobStream(ctx context.Context, req *AddBlobStreamRequest) (*AddBlobStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlobStream not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) SetDiscard(ctx context.Context, req *SetDiscardRequest) (*SetDiscardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiscard not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) SetMergedStreams(ctx context.Context, req *SetMergedStreamsRequest) (*SetMergedStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMergedStreams not implemented")
+}
+func (*UnimplementedPartitionManagerServiceServer) GetSharedTables(ctx context.Context, req *GetSharedTablesRequest) (*GetSharedTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedTables not implemented")
+}
+
+func RegisterPartitionManagerServiceServer(s *grpc.Server, srv PartitionManagerServiceServer) {
+	s.RegisterService(&_PartitionManagerService_serviceDesc, srv)
+}
+
+func _PartitionManagerService_SetRowStreamTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRowStreamTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).SetRowStreamTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/SetRowStreamTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).SetRowStreamTables(ctx, req.(*SetRowStreamTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_RegisterPS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).RegisterPS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/RegisterPS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).RegisterPS(ctx, req.(*RegisterPSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_GetRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).GetRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/GetRegions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).GetRegions(ctx, req.(*GetRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_GetPartitionMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).GetPartitionMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/GetPartitionMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).GetPartitionMeta(ctx, req.(*GetPartitionMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_GetPSInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPSInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).GetPSInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/GetPSInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).GetPSInfo(ctx, req.(*GetPSInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).Bootstrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/Bootstrap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).Bootstrap(ctx, req.(*BootstrapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_SplitPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).SplitPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/SplitPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).SplitPartition(ctx, req.(*SplitPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_MergePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).MergePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/MergePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).MergePartition(ctx, req.(*MergePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_ReassignPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).ReassignPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/ReassignPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).ReassignPartition(ctx, req.(*ReassignPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_RenewLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).RenewLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/RenewLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).RenewLease(ctx, req.(*RenewLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).Balance(ctx, req.(*BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_AddBlobStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBlobStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).AddBlobStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/AddBlobStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).AddBlobStream(ctx, req.(*AddBlobStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_SetDiscard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDiscardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).SetDiscard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/SetDiscard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).SetDiscard(ctx, req.(*SetDiscardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_SetMergedStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMergedStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).SetMergedStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/SetMergedStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).SetMergedStreams(ctx, req.(*SetMergedStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionManagerService_GetSharedTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionManagerServiceServer).GetSharedTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionManagerService/GetSharedTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionManagerServiceServer).GetSharedTables(ctx, req.(*GetSharedTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PartitionManagerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pspb.PartitionManagerService",
+	HandlerType: (*PartitionManagerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetRowStreamTables",
+			Handler:    _PartitionManagerService_SetRowStreamTables_Handler,
+		},
+		{
+			MethodName: "RegisterPS",
+			Handler:    _PartitionManagerService_RegisterPS_Handler,
+		},
+		{
+			MethodName: "GetRegions",
+			Handler:    _PartitionManagerService_GetRegions_Handler,
+		},
+		{
+			MethodName: "GetPartitionMeta",
+			Handler:    _PartitionManagerService_GetPartitionMeta_Handler,
+		},
+		{
+			MethodName: "GetPSInfo",
+			Handler:    _PartitionManagerService_GetPSInfo_Handler,
+		},
+		{
+			MethodName: "Bootstrap",
+			Handler:    _PartitionManagerService_Bootstrap_Handler,
+		},
+		{
+			MethodName: "SplitPartition",
+			Handler:    _PartitionManagerService_SplitPartition_Handler,
+		},
+		{
+			MethodName: "MergePartition",
+			Handler:    _PartitionManagerService_MergePartition_Handler,
+		},
+		{
+			MethodName: "ReassignPartition",
+			Handler:    _PartitionManagerService_ReassignPartition_Handler,
+		},
+		{
+			MethodName: "RenewLease",
+			Handler:    _PartitionManagerService_RenewLease_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _PartitionManagerService_Balance_Handler,
+		},
+		{
+			MethodName: "AddBlobStream",
+			Handler:    _PartitionManagerService_AddBlobStream_Handler,
+		},
+		{
+			MethodName: "SetDiscard",
+			Handler:    _PartitionManagerService_SetDiscard_Handler,
+		},
+		{
+			MethodName: "SetMergedStreams",
+			Handler:    _PartitionManagerService_SetMergedStreams_Handler,
+		},
+		{
+			MethodName: "GetSharedTables",
+			Handler:    _PartitionManagerService_GetSharedTables_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pspb.proto",
+}
+
+// PartitionKVClient is the client API for PartitionKV service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PartitionKVClient interface {
+	//
+	//option (google.api.http) = {
+	//post: "/v3/kv/txn"
+	//body: "*"
+	//};
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	GetVersions(ctx context.Context, in *GetVersionsRequest, opts ...grpc.CallOption) (*GetVersionsResponse, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	DeleteRange(ctx context.Context, in *DeleteRangeRequest, opts ...grpc.CallOption) (*DeleteRangeResponse, error)
+	Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error)
+	CondPut(ctx context.Context, in *CondPutRequest, opts ...grpc.CallOption) (*CondPutResponse, error)
+	CondDelete(ctx context.Context, in *CondDeleteRequest, opts ...grpc.CallOption) (*CondDeleteResponse, error)
+	SplitPart(ctx context.Context, in *SplitPartRequest, opts ...grpc.CallOption) (*SplitPartResponse, error)
+	MergePart(ctx context.Context, in *MergePartRequest, opts ...grpc.CallOption) (*MergePartResponse, error)
+	OpenPart(ctx context.Context, in *OpenPartRequest, opts ...grpc.CallOption) (*OpenPartResponse, error)
+	ClosePart(ctx context.Context, in *ClosePartRequest, opts ...grpc.CallOption) (*ClosePartResponse, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PartitionKV_WatchClient, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (PartitionKV_ExportClient, error)
+	Ingest(ctx context.Context, opts ...grpc.CallOption) (PartitionKV_IngestClient, error)
+	PartStats(ctx context.Context, in *PartStatsRequest, opts ...grpc.CallOption) (*PartStatsResponse, error)
+}
+
+type partitionKVClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPartitionKVClient(cc *grpc.ClientConn) PartitionKVClient {
+	return &partitionKVClient{cc}
+}
+
+func (c *partitionKVClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
+	out := new(PutResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Put", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) GetVersions(ctx context.Context, in *GetVersionsRequest, opts ...grpc.CallOption) (*GetVersionsResponse, error) {
+	out := new(GetVersionsResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/GetVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) DeleteRange(ctx context.Context, in *DeleteRangeRequest, opts ...grpc.CallOption) (*DeleteRangeResponse, error) {
+	out := new(DeleteRangeResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/DeleteRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error) {
+	out := new(RangeResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/Range", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) CondPut(ctx context.Context, in *CondPutRequest, opts ...grpc.CallOption) (*CondPutResponse, error) {
+	out := new(CondPutResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/CondPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) CondDelete(ctx context.Context, in *CondDeleteRequest, opts ...grpc.CallOption) (*CondDeleteResponse, error) {
+	out := new(CondDeleteResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/CondDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) SplitPart(ctx context.Context, in *SplitPartRequest, opts ...grpc.CallOption) (*SplitPartResponse, error) {
+	out := new(SplitPartResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/SplitPart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) MergePart(ctx context.Context, in *MergePartRequest, opts ...grpc.CallOption) (*MergePartResponse, error) {
+	out := new(MergePartResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/MergePart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) OpenPart(ctx context.Context, in *OpenPartRequest, opts ...grpc.CallOption) (*OpenPartResponse, error) {
+	out := new(OpenPartResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/OpenPart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) ClosePart(ctx context.Context, in *ClosePartRequest, opts ...grpc.CallOption) (*ClosePartResponse, error) {
+	out := new(ClosePartResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/ClosePart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partitionKVClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (PartitionKV_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PartitionKV_serviceDesc.Streams[0], "/pspb.PartitionKV/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &partitionKVWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PartitionKV_WatchClient interface {
+	Recv() (*WatchResponse, error)
+	grpc.ClientStream
+}
+
+type partitionKVWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *partitionKVWatchClient) Recv() (*WatchResponse, error) {
+	m := new(WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *partitionKVClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (PartitionKV_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PartitionKV_serviceDesc.Streams[1], "/pspb.PartitionKV/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &partitionKVExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PartitionKV_ExportClient interface {
+	Recv() (*ExportResponse, error)
+	grpc.ClientStream
+}
+
+type partitionKVExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *partitionKVExportClient) Recv() (*ExportResponse, error) {
+	m := new(ExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *partitionKVClient) Ingest(ctx context.Context, opts ...grpc.CallOption) (PartitionKV_IngestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PartitionKV_serviceDesc.Streams[2], "/pspb.PartitionKV/Ingest", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &partitionKVIngestClient{stream}
+	return x, nil
+}
+
+type PartitionKV_IngestClient interface {
+	Send(*IngestRequest) error
+	CloseAndRecv() (*IngestResponse, error)
+	grpc.ClientStream
+}
+
+type partitionKVIngestClient struct {
+	grpc.ClientStream
+}
+
+func (x *partitionKVIngestClient) Send(m *IngestRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *partitionKVIngestClient) CloseAndRecv() (*IngestResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(IngestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *partitionKVClient) PartStats(ctx context.Context, in *PartStatsRequest, opts ...grpc.CallOption) (*PartStatsResponse, error) {
+	out := new(PartStatsResponse)
+	err := c.cc.Invoke(ctx, "/pspb.PartitionKV/PartStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PartitionKVServer is the server API for PartitionKV service.
+type PartitionKVServer interface {
+	//
+	//option (google.api.http) = {
+	//post: "/v3/kv/txn"
+	//body: "*"
+	//};
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+	Put(context.Context, *PutRequest) (*PutResponse, error)
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	GetVersions(context.Context, *GetVersionsRequest) (*GetVersionsResponse, error)
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	DeleteRange(context.Context, *DeleteRangeRequest) (*DeleteRangeResponse, error)
+	Range(context.Context, *RangeRequest) (*RangeResponse, error)
+	CondPut(context.Context, *CondPutRequest) (*CondPutResponse, error)
+	CondDelete(context.Context, *CondDeleteRequest) (*CondDeleteResponse, error)
+	SplitPart(context.Context, *SplitPartRequest) (*SplitPartResponse, error)
+	MergePart(context.Context, *MergePartRequest) (*MergePartResponse, error)
+	OpenPart(context.Context, *OpenPartRequest) (*OpenPartResponse, error)
+	ClosePart(context.Context, *ClosePartRequest) (*ClosePartResponse, error)
+	Watch(*WatchRequest, PartitionKV_WatchServer) error
+	Export(*ExportRequest, PartitionKV_ExportServer) error
+	Ingest(PartitionKV_IngestServer) error
+	PartStats(context.Context, *PartStatsRequest) (*PartStatsResponse, error)
+}
+
+// UnimplementedPartitionKVServer can be embedded to have forward compatible implementations.
+type UnimplementedPartitionKVServer struct {
+}
+
+func (*UnimplementedPartitionKVServer) Batch(ctx context.Context, req *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (*UnimplementedPartitionKVServer) Put(ctx context.Context, req *PutRequest) (*PutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+}
+func (*UnimplementedPartitionKVServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedPartitionKVServer) GetVersions(ctx context.Context, req *GetVersionsRequest) (*GetVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersions not implemented")
+}
+func (*UnimplementedPartitionKVServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedPartitionKVServer) DeleteRange(ctx context.Context, req *DeleteRangeRequest) (*DeleteRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRange not implemented")
+}
+func (*UnimplementedPartitionKVServer) Range(ctx context.Context, req *RangeRequest) (*RangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Range not implemented")
+}
+func (*UnimplementedPartitionKVServer) CondPut(ctx context.Context, req *CondPutRequest) (*CondPutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CondPut not implemented")
+}
+func (*UnimplementedPartitionKVServer) CondDelete(ctx context.Context, req *CondDeleteRequest) (*CondDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CondDelete not implemented")
+}
+func (*UnimplementedPartitionKVServer) SplitPart(ctx context.Context, req *SplitPartRequest) (*SplitPartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitPart not implemented")
+}
+func (*UnimplementedPartitionKVServer) MergePart(ctx context.Context, req *MergePartRequest) (*MergePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePart not implemented")
+}
+func (*UnimplementedPartitionKVServer) OpenPart(ctx context.Context, req *OpenPartRequest) (*OpenPartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenPart not implemented")
+}
+func (*UnimplementedPartitionKVServer) ClosePart(ctx context.Context, req *ClosePartRequest) (*ClosePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePart not implemented")
+}
+func (*UnimplementedPartitionKVServer) Watch(req *WatchRequest, srv PartitionKV_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedPartitionKVServer) Export(req *ExportRequest, srv PartitionKV_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedPartitionKVServer) Ingest(srv PartitionKV_IngestServer) error {
+	return status.Errorf(codes.Unimplemented, "method Ingest not implemented")
+}
+func (*UnimplementedPartitionKVServer) PartStats(ctx context.Context, req *PartStatsRequest) (*PartStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartStats not implemented")
+}
+
+func RegisterPartitionKVServer(s *grpc.Server, srv PartitionKVServer) {
+	s.RegisterService(&_PartitionKV_serviceDesc, srv)
+}
+
+func _PartitionKV_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Put",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Put(ctx, req.(*PutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_GetVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).GetVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/GetVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).GetVersions(ctx, req.(*GetVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_DeleteRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).DeleteRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/DeleteRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).DeleteRange(ctx, req.(*DeleteRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_Range_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).Range(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/Range",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).Range(ctx, req.(*RangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_CondPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CondPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).CondPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/CondPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).CondPut(ctx, req.(*CondPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_CondDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CondDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).CondDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/CondDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).CondDelete(ctx, req.(*CondDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_SplitPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitPartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).SplitPart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/SplitPart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).SplitPart(ctx, req.(*SplitPartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_MergePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).MergePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/MergePart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).MergePart(ctx, req.(*MergePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_OpenPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenPartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).OpenPart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/OpenPart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).OpenPart(ctx, req.(*OpenPartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_ClosePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).ClosePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/ClosePart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).ClosePart(ctx, req.(*ClosePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartitionKV_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PartitionKVServer).Watch(m, &partitionKVWatchServer{stream})
+}
+
+type PartitionKV_WatchServer interface {
+	Send(*WatchResponse) error
+	grpc.ServerStream
+}
+
+type partitionKVWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *partitionKVWatchServer) Send(m *WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PartitionKV_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PartitionKVServer).Export(m, &partitionKVExportServer{stream})
+}
+
+type PartitionKV_ExportServer interface {
+	Send(*ExportResponse) error
+	grpc.ServerStream
+}
+
+type partitionKVExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *partitionKVExportServer) Send(m *ExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PartitionKV_Ingest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PartitionKVServer).Ingest(&partitionKVIngestServer{stream})
+}
+
+type PartitionKV_IngestServer interface {
+	SendAndClose(*IngestResponse) error
+	Recv() (*IngestRequest, error)
+	grpc.ServerStream
+}
+
+type partitionKVIngestServer struct {
+	grpc.ServerStream
+}
+
+func (x *partitionKVIngestServer) SendAndClose(m *IngestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *partitionKVIngestServer) Recv() (*IngestRequest, error) {
+	m := new(IngestRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PartitionKV_PartStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartitionKVServer).PartStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pspb.PartitionKV/PartStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartitionKVServer).PartStats(ctx, req.(*PartStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PartitionKV_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pspb.PartitionKV",
+	HandlerType: (*PartitionKVServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Batch",
+			Handler:    _PartitionKV_Batch_Handler,
+		},
+		{
+			MethodName: "Put",
+			Handler:    _PartitionKV_Put_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _PartitionKV_Get_Handler,
+		},
+		{
+			MethodName: "GetVersions",
+			Handler:    _PartitionKV_GetVersions_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PartitionKV_Delete_Handler,
+		},
+		{
+			MethodName: "DeleteRange",
+			Handler:    _PartitionKV_DeleteRange_Handler,
+		},
+		{
+			MethodName: "Range",
+			Handler:    _PartitionKV_Range_Handler,
+		},
+		{
+			MethodName: "CondPut",
+			Handler:    _PartitionKV_CondPut_Handler,
+		},
+		{
+			MethodName: "CondDelete",
+			Handler:    _PartitionKV_CondDelete_Handler,
+		},
+		{
+			MethodName: "SplitPart",
+			Handler:    _PartitionKV_SplitPart_Handler,
+		},
+		{
+			MethodName: "MergePart",
+			Handler:    _PartitionKV_MergePart_Handler,
+		},
+		{
+			MethodName: "OpenPart",
+			Handler:    _PartitionKV_OpenPart_Handler,
+		},
+		{
+			MethodName: "ClosePart",
+			Handler:    _PartitionKV_ClosePart_Handler,
+		},
+		{
+			MethodName: "PartStats",
+			Handler:    _PartitionKV_PartStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _PartitionKV_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _PartitionKV_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Ingest",
+			Handler:       _PartitionKV_Ingest_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pspb.proto",
+}
+
+func (m *MixedLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MixedLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MixedLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Offsets) > 0 {
+		dAtA2 := make([]byte, len(m.Offsets)*10)
+		var j1 int
+		for _, num := range m.Offsets {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPspb(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Range) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Range) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Range) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Location) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Location) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobStreams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobStreams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobStreams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blob) > 0 {
+		dAtA4 := make([]byte, len(m.Blob)*10)
+		var j3 int
+		for _, num := range m.Blob {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintPspb(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiscardStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiscardStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiscardStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SharedFrom) > 0 {
+		dAtA6 := make([]byte, len(m.SharedFrom)*10)
+		var j5 int
+		for _, num := range m.SharedFrom {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintPspb(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SharedBy) > 0 {
+		dAtA8 := make([]byte, len(m.SharedBy)*10)
+		var j7 int
+		for _, num := range m.SharedBy {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintPspb(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TruncatedSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.TruncatedSeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SharedExtent != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.SharedExtent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Discards) > 0 {
+		for k := range m.Discards {
+			v := m.Discards[k]
+			baseI := i
+			i = encodeVarintPspb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPspb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPspb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergedStreams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergedStreams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergedStreams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		dAtA10 := make([]byte, len(m.Rows)*10)
+		var j9 int
+		for _, num := range m.Rows {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintPspb(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Logs) > 0 {
+		dAtA12 := make([]byte, len(m.Logs)*10)
+		var j11 int
+		for _, num := range m.Logs {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintPspb(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TableLocations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableLocations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableLocations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locs) > 0 {
+		for iNdEx := len(m.Locs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Merged != nil {
+		{
+			size, err := m.Merged.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.VersionRetention != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.VersionRetention))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxVersions != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.MaxVersions))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PrefixBloomLen != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PrefixBloomLen))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Compression != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Rg != nil {
+		{
+			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Discard) > 0 {
+		i -= len(m.Discard)
+		copy(dAtA[i:], m.Discard)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Discard)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Parent != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Locs != nil {
+		{
+			size, err := m.Locs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RowStream != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.RowStream))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LogStream != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.LogStream))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Blobs != nil {
+		{
+			size, err := m.Blobs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PSDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PSDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PSDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PSID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PSID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Rg != nil {
+		{
+			size, err := m.Rg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawBlockMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawBlockMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawBlockMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compression != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SeqNum != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.SeqNum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VpOffset != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.VpOffset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VpExtentID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.VpExtentID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UnCompressedSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.UnCompressedSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CompressedSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.CompressedSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockOffset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockOffset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExtentID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExtentID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TableIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.GlobalSeq))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.VersionTimes) > 0 {
+		for iNdEx := len(m.VersionTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RangeDeletes) > 0 {
+		for iNdEx := len(m.RangeDeletes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangeDeletes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PrefixLen != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PrefixLen))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PrefixBloomFilter) > 0 {
+		i -= len(m.PrefixBloomFilter)
+		copy(dAtA[i:], m.PrefixBloomFilter)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.PrefixBloomFilter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NumOfBlocks != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.NumOfBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EstimatedSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.EstimatedSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BloomFilter) > 0 {
+		i -= len(m.BloomFilter)
+		copy(dAtA[i:], m.BloomFilter)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.BloomFilter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offsets) > 0 {
+		for iNdEx := len(m.Offsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnixTime != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.UnixTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPartitionMetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPartitionMetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPartitionMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PSID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPartitionMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPartitionMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPartitionMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Meta) > 0 {
+		for iNdEx := len(m.Meta) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Meta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TableSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.TableSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MemtableSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.MemtableSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WriteBytes != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.WriteBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Qps != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Qps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenewLeaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenewLeaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenewLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Loads) > 0 {
+		for iNdEx := len(m.Loads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PSID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenewLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenewLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenewLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ttl != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetRowStreamTablesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRowStreamTablesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRowStreamTablesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Locs != nil {
+		{
+			size, err := m.Locs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartitionID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartitionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetRowStreamTablesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRowStreamTablesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRowStreamTablesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRegionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRegionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRegionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRegionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Regions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterPSRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterPSRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterPSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterPSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterPSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterPSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPSInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPSInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPSInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPSInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPSInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPSInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Servers) > 0 {
+		for iNdEx := len(m.Servers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Servers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BootstrapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BootstrapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersionRetention != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.VersionRetention))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxVersions != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.MaxVersions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PrefixBloomLen != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PrefixBloomLen))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Compression != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Parent != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RowID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.RowID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LogID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.LogID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BootstrapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BootstrapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Parent != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitPartitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitPartitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitPartitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SharedExtent != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.SharedExtent))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlobID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.BlobID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Locs != nil {
+		{
+			size, err := m.Locs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RowID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.RowID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LogID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.LogID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SplitKey) > 0 {
+		i -= len(m.SplitKey)
+		copy(dAtA[i:], m.SplitKey)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.SplitKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitPartitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitPartitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitPartitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.NewPartID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergePartitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergePartitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergePartitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Locs != nil {
+		{
+			size, err := m.Locs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RightPartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.RightPartID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergePartitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergePartitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergePartitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetMergedStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMergedStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMergedStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Merged != nil {
+		{
+			size, err := m.Merged.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetMergedStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMergedStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMergedStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetDiscardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDiscardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDiscardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Discard != nil {
+		{
+			size, err := m.Discard.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetDiscardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDiscardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDiscardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Discard != nil {
+		{
+			size, err := m.Discard.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSharedTablesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSharedTablesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSharedTablesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSharedTablesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSharedTablesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSharedTablesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locs) > 0 {
+		for iNdEx := len(m.Locs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddBlobStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddBlobStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddBlobStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.StreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddBlobStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddBlobStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddBlobStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReassignPartitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReassignPartitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReassignPartitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PSID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PSID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReassignPartitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReassignPartitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReassignPartitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Load != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Load))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.To != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PartID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Moves) > 0 {
+		for iNdEx := len(m.Moves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Moves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Version != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NotFound {
+		i--
+		if m.NotFound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Values {
+		i--
+		if m.Values {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Before != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Before))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObjectManifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectManifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectManifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumChunks != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.NumChunks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChunkSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Length != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Condition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CondPutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CondPutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CondPutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cond != nil {
+		{
+			size, err := m.Cond.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Put != nil {
+		{
+			size, err := m.Put.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CondPutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CondPutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CondPutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CondDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CondDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CondDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cond != nil {
+		{
+			size, err := m.Cond.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Delete != nil {
+		{
+			size, err := m.Delete.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CondDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CondDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CondDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitPartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitPartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitPartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SplitKey) > 0 {
+		i -= len(m.SplitKey)
+		copy(dAtA[i:], m.SplitKey)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.SplitKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitPartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitPartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitPartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SplitKey) > 0 {
+		i -= len(m.SplitKey)
+		copy(dAtA[i:], m.SplitKey)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.SplitKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewPartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.NewPartID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergePartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergePartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergePartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergePartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergePartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergePartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RightPartID != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.RightPartID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompactionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastEnd != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.LastEnd))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LastStart != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.LastStart))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumMajor != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.NumMajor))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumMinor != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.NumMinor))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Running {
+		i--
+		if m.Running {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PendingBytes != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PendingBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PendingTables != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.PendingTables))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumTables != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.NumTables))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockCacheStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockCacheStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockCacheStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CostEvicted != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.CostEvicted))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CostAdded != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.CostAdded))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.KeysEvicted != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.KeysEvicted))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.KeysAdded != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.KeysAdded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Misses != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Hits != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Hits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxCost != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.MaxCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompressionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompressionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompressionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompressedBytes != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.CompressedBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RawBytes != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.RawBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Compression != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockCompression != nil {
+		{
+			size, err := m.BlockCompression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BlockCache != nil {
+		{
+			size, err := m.BlockCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Compaction != nil {
+		{
+			size, err := m.Compaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TableSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.TableSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MemtableSize != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.MemtableSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteBytes != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.WriteBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Writes != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Writes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reads != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Reads))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenPartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenPartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenPartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenPartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenPartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenPartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClosePartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClosePartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClosePartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClosePartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClosePartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClosePartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeDes) > 0 {
+		i -= len(m.CodeDes)
+		copy(dAtA[i:], m.CodeDes)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.CodeDes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartSeq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.StartSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DeleteRange {
+		i--
+		if m.DeleteRange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IngestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IngestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != nil {
+		{
+			size, err := m.Index.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IngestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IngestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Tables != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Tables))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Seq != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size := m.Request.Size()
+			i -= size
+			if _, err := m.Request.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestOp_RequestPut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestOp_RequestPut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestPut != nil {
+		{
+			size, err := m.RequestPut.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *RequestOp_RequestDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestOp_RequestDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestDelete != nil {
+		{
+			size, err := m.RequestDelete.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *RequestOp_RequestGet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestOp_RequestGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestGet != nil {
+		{
+			size, err := m.RequestGet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ResponseOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		{
+			size := m.Response.Size()
+			i -= size
+			if _, err := m.Response.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseOp_ResponsePut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseOp_ResponsePut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResponsePut != nil {
+		{
+			size, err := m.ResponsePut.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ResponseOp_ResponseDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseOp_ResponseDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResponseDelete != nil {
+		{
+			size, err := m.ResponseDelete.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ResponseOp_ResponseGet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseOp_ResponseGet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResponseGet != nil {
+		{
+			size, err := m.ResponseGet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPspb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Req) > 0 {
+		for iNdEx := len(m.Req) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Req[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Res) > 0 {
+		for iNdEx := len(m.Res) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Res[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPspb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Continuation) > 0 {
+		i -= len(m.Continuation)
+		copy(dAtA[i:], m.Continuation)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Continuation)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Values {
+		i--
+		if m.Values {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Psversion != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Psversion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Partid != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Partid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Continuation) > 0 {
+		i -= len(m.Continuation)
+		copy(dAtA[i:], m.Continuation)
+		i = encodeVarintPspb(dAtA, i, uint64(len(m.Continuation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintPspb(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintPspb(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Truncated != 0 {
+		i = encodeVarintPspb(dAtA, i, uint64(m.Truncated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPspb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPspb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MixedLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offsets) > 0 {
+		l = 0
+		for _, e := range m.Offsets {
+			l += sovPspb(uint64(e))
+		}
+		n += 1 + sovPspb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Range) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *Location) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtentID != 0 {
+		n += 1 + sovPspb(uint64(m.ExtentID))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovPspb(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *BlobStreams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blob) > 0 {
+		l = 0
+		for _, e := range m.Blob {
+			l += sovPspb(uint64(e))
+		}
+		n += 1 + sovPspb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DiscardStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Discards) > 0 {
+		for k, v := range m.Discards {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPspb(uint64(k)) + 1 + sovPspb(uint64(v))
+			n += mapEntrySize + 1 + sovPspb(uint64(mapEntrySize))
+		}
+	}
+	if m.SharedExtent != 0 {
+		n += 1 + sovPspb(uint64(m.SharedExtent))
+	}
+	if m.TruncatedSeq != 0 {
+		n += 1 + sovPspb(uint64(m.TruncatedSeq))
+	}
+	if len(m.SharedBy) > 0 {
+		l = 0
+		for _, e := range m.SharedBy {
+			l += sovPspb(uint64(e))
+		}
+		n += 1 + sovPspb(uint64(l)) + l
+	}
+	if len(m.SharedFrom) > 0 {
+		l = 0
+		for _, e := range m.SharedFrom {
+			l += sovPspb(uint64(e))
+		}
+		n += 1 + sovPspb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MergedStreams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		l = 0
+		for _, e := range m.Logs {
+			l += sovPspb(uint64(e))
+		}
+		n += 1 + sovPspb(uint64(l)) + l
+	}
+	if len(m.Rows) > 0 {
+		l = 0
+		for _, e := range m.Rows {
+			l += sovPspb(uint64(e))
+		}
+		n += 1 + sovPspb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *TableLocations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locs) > 0 {
+		for _, e := range m.Locs {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PartitionMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blobs != nil {
+		l = m.Blobs.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.LogStream != 0 {
+		n += 1 + sovPspb(uint64(m.LogStream))
+	}
+	if m.RowStream != 0 {
+		n += 1 + sovPspb(uint64(m.RowStream))
+	}
+	if m.Locs != nil {
+		l = m.Locs.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovPspb(uint64(m.Parent))
+	}
+	l = len(m.Discard)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Rg != nil {
+		l = m.Rg.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	if m.Compression != 0 {
+		n += 1 + sovPspb(uint64(m.Compression))
+	}
+	if m.PrefixBloomLen != 0 {
+		n += 1 + sovPspb(uint64(m.PrefixBloomLen))
+	}
+	if m.MaxVersions != 0 {
+		n += 1 + sovPspb(uint64(m.MaxVersions))
+	}
+	if m.VersionRetention != 0 {
+		n += 1 + sovPspb(uint64(m.VersionRetention))
+	}
+	if m.Merged != nil {
+		l = m.Merged.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *PSDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PSID != 0 {
+		n += 1 + sovPspb(uint64(m.PSID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *RegionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rg != nil {
+		l = m.Rg.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.PSID != 0 {
+		n += 1 + sovPspb(uint64(m.PSID))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	return n
+}
+
+func (m *RawBlockMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPspb(uint64(m.Type))
+	}
+	if m.CompressedSize != 0 {
+		n += 1 + sovPspb(uint64(m.CompressedSize))
+	}
+	if m.UnCompressedSize != 0 {
+		n += 1 + sovPspb(uint64(m.UnCompressedSize))
+	}
+	if m.VpExtentID != 0 {
+		n += 1 + sovPspb(uint64(m.VpExtentID))
+	}
+	if m.VpOffset != 0 {
+		n += 1 + sovPspb(uint64(m.VpOffset))
+	}
+	if m.SeqNum != 0 {
+		n += 1 + sovPspb(uint64(m.SeqNum))
+	}
+	if m.Compression != 0 {
+		n += 1 + sovPspb(uint64(m.Compression))
+	}
+	return n
+}
+
+func (m *BlockOffset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.ExtentID != 0 {
+		n += 1 + sovPspb(uint64(m.ExtentID))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovPspb(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *TableIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offsets) > 0 {
+		for _, e := range m.Offsets {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	l = len(m.BloomFilter)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.EstimatedSize != 0 {
+		n += 1 + sovPspb(uint64(m.EstimatedSize))
+	}
+	if m.NumOfBlocks != 0 {
+		n += 1 + sovPspb(uint64(m.NumOfBlocks))
+	}
+	l = len(m.PrefixBloomFilter)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.PrefixLen != 0 {
+		n += 1 + sovPspb(uint64(m.PrefixLen))
+	}
+	if len(m.RangeDeletes) > 0 {
+		for _, e := range m.RangeDeletes {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if len(m.VersionTimes) > 0 {
+		for _, e := range m.VersionTimes {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.GlobalSeq != 0 {
+		n += 1 + sovPspb(uint64(m.GlobalSeq))
+	}
+	return n
+}
+
+func (m *VersionTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	if m.UnixTime != 0 {
+		n += 1 + sovPspb(uint64(m.UnixTime))
+	}
+	return n
+}
+
+func (m *RangeDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *GetPartitionMetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PSID != 0 {
+		n += 1 + sovPspb(uint64(m.PSID))
+	}
+	return n
+}
+
+func (m *GetPartitionMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	if len(m.Meta) > 0 {
+		for _, e := range m.Meta {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PartitionLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	return n
+}
+
+func (m *PartitionLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.Qps != 0 {
+		n += 1 + sovPspb(uint64(m.Qps))
+	}
+	if m.WriteBytes != 0 {
+		n += 1 + sovPspb(uint64(m.WriteBytes))
+	}
+	if m.MemtableSize != 0 {
+		n += 1 + sovPspb(uint64(m.MemtableSize))
+	}
+	if m.TableSize != 0 {
+		n += 1 + sovPspb(uint64(m.TableSize))
+	}
+	return n
+}
+
+func (m *RenewLeaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PSID != 0 {
+		n += 1 + sovPspb(uint64(m.PSID))
+	}
+	if len(m.Loads) > 0 {
+		for _, e := range m.Loads {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RenewLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovPspb(uint64(m.Ttl))
+	}
+	return n
+}
+
+func (m *SetRowStreamTablesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionID != 0 {
+		n += 1 + sovPspb(uint64(m.PartitionID))
+	}
+	if m.Locs != nil {
+		l = m.Locs.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *SetRowStreamTablesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	return n
+}
+
+func (m *GetRegionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetRegionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterPSRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterPSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPspb(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *GetPSInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetPSInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Servers) > 0 {
+		for _, e := range m.Servers {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BootstrapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogID != 0 {
+		n += 1 + sovPspb(uint64(m.LogID))
+	}
+	if m.RowID != 0 {
+		n += 1 + sovPspb(uint64(m.RowID))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovPspb(uint64(m.Parent))
+	}
+	if m.Compression != 0 {
+		n += 1 + sovPspb(uint64(m.Compression))
+	}
+	if m.PrefixBloomLen != 0 {
+		n += 1 + sovPspb(uint64(m.PrefixBloomLen))
+	}
+	if m.MaxVersions != 0 {
+		n += 1 + sovPspb(uint64(m.MaxVersions))
+	}
+	if m.VersionRetention != 0 {
+		n += 1 + sovPspb(uint64(m.VersionRetention))
+	}
+	return n
+}
+
+func (m *BootstrapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovPspb(uint64(m.Parent))
+	}
+	return n
+}
+
+func (m *SplitPartitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	l = len(m.SplitKey)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.LogID != 0 {
+		n += 1 + sovPspb(uint64(m.LogID))
+	}
+	if m.RowID != 0 {
+		n += 1 + sovPspb(uint64(m.RowID))
+	}
+	if m.Locs != nil {
+		l = m.Locs.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.BlobID != 0 {
+		n += 1 + sovPspb(uint64(m.BlobID))
+	}
+	if m.SharedExtent != 0 {
+		n += 1 + sovPspb(uint64(m.SharedExtent))
+	}
+	return n
+}
+
+func (m *SplitPartitionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.NewPartID != 0 {
+		n += 1 + sovPspb(uint64(m.NewPartID))
+	}
+	return n
+}
+
+func (m *MergePartitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.RightPartID != 0 {
+		n += 1 + sovPspb(uint64(m.RightPartID))
+	}
+	if m.Locs != nil {
+		l = m.Locs.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *MergePartitionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *SetMergedStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.Merged != nil {
+		l = m.Merged.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *SetMergedStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *SetDiscardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.Discard != nil {
+		l = m.Discard.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *SetDiscardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Discard != nil {
+		l = m.Discard.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSharedTablesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	return n
+}
+
+func (m *GetSharedTablesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if len(m.Locs) > 0 {
+		for _, e := range m.Locs {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddBlobStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.StreamID != 0 {
+		n += 1 + sovPspb(uint64(m.StreamID))
+	}
+	return n
+}
+
+func (m *AddBlobStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *ReassignPartitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.PSID != 0 {
+		n += 1 + sovPspb(uint64(m.PSID))
+	}
+	return n
+}
+
+func (m *ReassignPartitionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *PartitionMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartID != 0 {
+		n += 1 + sovPspb(uint64(m.PartID))
+	}
+	if m.From != 0 {
+		n += 1 + sovPspb(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovPspb(uint64(m.To))
+	}
+	if m.Load != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *BalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *BalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *PutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *DeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *DeleteRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *DeleteRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPspb(uint64(m.Version))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.NotFound {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	if m.Before != 0 {
+		n += 1 + sovPspb(uint64(m.Before))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPspb(uint64(m.Limit))
+	}
+	if m.Values {
+		n += 2
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *KeyVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPspb(uint64(m.Version))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *GetVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *ObjectManifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovPspb(uint64(m.Length))
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovPspb(uint64(m.ChunkSize))
+	}
+	if m.NumChunks != 0 {
+		n += 1 + sovPspb(uint64(m.NumChunks))
+	}
+	return n
+}
+
+func (m *Condition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPspb(uint64(m.Type))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPspb(uint64(m.Version))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *CondPutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Put != nil {
+		l = m.Put.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Cond != nil {
+		l = m.Cond.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *CondPutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *CondDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Cond != nil {
+		l = m.Cond.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *CondDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *SplitPartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	l = len(m.SplitKey)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	return n
+}
+
+func (m *SplitPartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.NewPartID != 0 {
+		n += 1 + sovPspb(uint64(m.NewPartID))
+	}
+	l = len(m.SplitKey)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *MergePartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	return n
+}
+
+func (m *MergePartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.RightPartID != 0 {
+		n += 1 + sovPspb(uint64(m.RightPartID))
+	}
+	return n
+}
+
+func (m *CompactionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumTables != 0 {
+		n += 1 + sovPspb(uint64(m.NumTables))
+	}
+	if m.PendingTables != 0 {
+		n += 1 + sovPspb(uint64(m.PendingTables))
+	}
+	if m.PendingBytes != 0 {
+		n += 1 + sovPspb(uint64(m.PendingBytes))
+	}
+	if m.Running {
+		n += 2
+	}
+	if m.NumMinor != 0 {
+		n += 1 + sovPspb(uint64(m.NumMinor))
+	}
+	if m.NumMajor != 0 {
+		n += 1 + sovPspb(uint64(m.NumMajor))
+	}
+	if m.LastStart != 0 {
+		n += 1 + sovPspb(uint64(m.LastStart))
+	}
+	if m.LastEnd != 0 {
+		n += 1 + sovPspb(uint64(m.LastEnd))
+	}
+	return n
+}
+
+func (m *BlockCacheStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxCost != 0 {
+		n += 1 + sovPspb(uint64(m.MaxCost))
+	}
+	if m.Hits != 0 {
+		n += 1 + sovPspb(uint64(m.Hits))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovPspb(uint64(m.Misses))
+	}
+	if m.KeysAdded != 0 {
+		n += 1 + sovPspb(uint64(m.KeysAdded))
+	}
+	if m.KeysEvicted != 0 {
+		n += 1 + sovPspb(uint64(m.KeysEvicted))
+	}
+	if m.CostAdded != 0 {
+		n += 1 + sovPspb(uint64(m.CostAdded))
+	}
+	if m.CostEvicted != 0 {
+		n += 1 + sovPspb(uint64(m.CostEvicted))
+	}
+	return n
+}
+
+func (m *CompressionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compression != 0 {
+		n += 1 + sovPspb(uint64(m.Compression))
+	}
+	if m.RawBytes != 0 {
+		n += 1 + sovPspb(uint64(m.RawBytes))
+	}
+	if m.CompressedBytes != 0 {
+		n += 1 + sovPspb(uint64(m.CompressedBytes))
+	}
+	return n
+}
+
+func (m *PartStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	return n
+}
+
+func (m *PartStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Reads != 0 {
+		n += 1 + sovPspb(uint64(m.Reads))
+	}
+	if m.Writes != 0 {
+		n += 1 + sovPspb(uint64(m.Writes))
+	}
+	if m.WriteBytes != 0 {
+		n += 1 + sovPspb(uint64(m.WriteBytes))
+	}
+	if m.MemtableSize != 0 {
+		n += 1 + sovPspb(uint64(m.MemtableSize))
+	}
+	if m.TableSize != 0 {
+		n += 1 + sovPspb(uint64(m.TableSize))
+	}
+	if m.Compaction != nil {
+		l = m.Compaction.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.BlockCache != nil {
+		l = m.BlockCache.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.BlockCompression != nil {
+		l = m.BlockCompression.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *OpenPartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	return n
+}
+
+func (m *OpenPartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *ClosePartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	return n
+}
+
+func (m *ClosePartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPspb(uint64(m.Code))
+	}
+	l = len(m.CodeDes)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.StartSeq != 0 {
+		n += 1 + sovPspb(uint64(m.StartSeq))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	return n
+}
+
+func (m *WatchEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	if m.Delete {
+		n += 2
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	}
+	if m.DeleteRange {
+		n += 2
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	return n
+}
+
+func (m *ExportEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPspb(uint64(m.Version))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPspb(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *ExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *IngestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if m.Index != nil {
+		l = m.Index.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func (m *IngestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovPspb(uint64(m.Seq))
+	}
+	if m.Tables != 0 {
+		n += 1 + sovPspb(uint64(m.Tables))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovPspb(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *RequestOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		n += m.Request.Size()
+	}
+	return n
+}
+
+func (m *RequestOp_RequestPut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestPut != nil {
+		l = m.RequestPut.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *RequestOp_RequestDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestDelete != nil {
+		l = m.RequestDelete.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *RequestOp_RequestGet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestGet != nil {
+		l = m.RequestGet.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *ResponseOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		n += m.Response.Size()
+	}
+	return n
+}
+
+func (m *ResponseOp_ResponsePut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResponsePut != nil {
+		l = m.ResponsePut.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *ResponseOp_ResponseDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResponseDelete != nil {
+		l = m.ResponseDelete.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *ResponseOp_ResponseGet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResponseGet != nil {
+		l = m.ResponseGet.Size()
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+func (m *BatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Req) > 0 {
+		for _, e := range m.Req {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *BatchResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if len(m.Res) > 0 {
-		for _, e := range m.Res {
-			l = e.Size()
-			n += 1 + l + sovPspb(uint64(l))
+	var l int
+	_ = l
+	if len(m.Res) > 0 {
+		for _, e := range m.Res {
+			l = e.Size()
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPspb(uint64(m.Limit))
+	}
+	if m.Partid != 0 {
+		n += 1 + sovPspb(uint64(m.Partid))
+	}
+	if m.Psversion != 0 {
+		n += 1 + sovPspb(uint64(m.Psversion))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.Values {
+		n += 2
+	}
+	l = len(m.Continuation)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPspb(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *RangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Truncated != 0 {
+		n += 1 + sovPspb(uint64(m.Truncated))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	if len(m.Values) > 0 {
+		for _, b := range m.Values {
+			l = len(b)
+			n += 1 + l + sovPspb(uint64(l))
+		}
+	}
+	l = len(m.Continuation)
+	if l > 0 {
+		n += 1 + l + sovPspb(uint64(l))
+	}
+	return n
+}
+
+func sovPspb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPspb(x uint64) (n int) {
+	return sovPspb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MixedLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MixedLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MixedLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Offsets = append(m.Offsets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPspb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Offsets) == 0 {
+					m.Offsets = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Offsets = append(m.Offsets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Range) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Range: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Location) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Location: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Location: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobStreams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobStreams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobStreams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Blob = append(m.Blob, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPspb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Blob) == 0 {
+					m.Blob = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Blob = append(m.Blob, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscardStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscardStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscardStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Discards == nil {
+				m.Discards = make(map[uint64]int64)
+			}
+			var mapkey uint64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPspb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPspb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Discards[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedExtent", wireType)
+			}
+			m.SharedExtent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharedExtent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatedSeq", wireType)
+			}
+			m.TruncatedSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TruncatedSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SharedBy = append(m.SharedBy, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPspb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SharedBy) == 0 {
+					m.SharedBy = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SharedBy = append(m.SharedBy, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedBy", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SharedFrom = append(m.SharedFrom, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPspb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SharedFrom) == 0 {
+					m.SharedFrom = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SharedFrom = append(m.SharedFrom, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedFrom", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergedStreams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergedStreams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergedStreams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Logs = append(m.Logs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPspb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Logs) == 0 {
+					m.Logs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Logs = append(m.Logs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Rows = append(m.Rows, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPspb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPspb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPspb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Rows) == 0 {
+					m.Rows = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPspb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Rows = append(m.Rows, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableLocations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableLocations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableLocations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locs = append(m.Locs, &Location{})
+			if err := m.Locs[len(m.Locs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Blobs == nil {
+				m.Blobs = &BlobStreams{}
+			}
+			if err := m.Blobs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			}
+			m.LogStream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStream |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowStream", wireType)
+			}
+			m.RowStream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowStream |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Locs == nil {
+				m.Locs = &TableLocations{}
+			}
+			if err := m.Locs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discard", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discard = append(m.Discard[:0], dAtA[iNdEx:postIndex]...)
+			if m.Discard == nil {
+				m.Discard = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rg == nil {
+				m.Rg = &Range{}
+			}
+			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+			}
+			m.Psversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Psversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= CompressionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixBloomLen", wireType)
+			}
+			m.PrefixBloomLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrefixBloomLen |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVersions", wireType)
+			}
+			m.MaxVersions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVersions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionRetention", wireType)
+			}
+			m.VersionRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VersionRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Merged == nil {
+				m.Merged = &MergedStreams{}
+			}
+			if err := m.Merged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PSDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PSDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PSDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+			}
+			m.PSID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PSID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rg == nil {
+				m.Rg = &Range{}
+			}
+			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+			}
+			m.PSID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PSID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+			}
+			m.Psversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Psversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawBlockMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawBlockMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawBlockMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RawBlockType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedSize", wireType)
+			}
+			m.CompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressedSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnCompressedSize", wireType)
+			}
+			m.UnCompressedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnCompressedSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpExtentID", wireType)
+			}
+			m.VpExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpOffset", wireType)
+			}
+			m.VpOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpOffset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNum", wireType)
+			}
+			m.SeqNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= CompressionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockOffset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockOffset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockOffset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+			}
+			m.ExtentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtentID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offsets = append(m.Offsets, &BlockOffset{})
+			if err := m.Offsets[len(m.Offsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BloomFilter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BloomFilter = append(m.BloomFilter[:0], dAtA[iNdEx:postIndex]...)
+			if m.BloomFilter == nil {
+				m.BloomFilter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedSize", wireType)
+			}
+			m.EstimatedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfBlocks", wireType)
+			}
+			m.NumOfBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixBloomFilter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrefixBloomFilter = append(m.PrefixBloomFilter[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrefixBloomFilter == nil {
+				m.PrefixBloomFilter = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixLen", wireType)
+			}
+			m.PrefixLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrefixLen |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeDeletes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeDeletes = append(m.RangeDeletes, &RangeDelete{})
+			if err := m.RangeDeletes[len(m.RangeDeletes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionTimes = append(m.VersionTimes, &VersionTime{})
+			if err := m.VersionTimes[len(m.VersionTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSeq", wireType)
+			}
+			m.GlobalSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixTime", wireType)
+			}
+			m.UnixTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnixTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
+			if m.Start == nil {
+				m.Start = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPartitionMetaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPartitionMetaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPartitionMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+			}
+			m.PSID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PSID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPartitionMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPartitionMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPartitionMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = append(m.Meta, &PartitionMeta{})
+			if err := m.Meta[len(m.Meta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+			}
+			m.Psversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Psversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionLoad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionLoad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionLoad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qps", wireType)
+			}
+			m.Qps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Qps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBytes", wireType)
+			}
+			m.WriteBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemtableSize", wireType)
+			}
+			m.MemtableSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemtableSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableSize", wireType)
+			}
+			m.TableSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenewLeaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenewLeaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenewLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+			}
+			m.PSID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PSID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Loads = append(m.Loads, &PartitionLoad{})
+			if err := m.Loads[len(m.Loads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenewLeaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenewLeaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenewLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leases = append(m.Leases, &PartitionLease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRowStreamTablesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRowStreamTablesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRowStreamTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+			}
+			m.PartitionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Locs == nil {
+				m.Locs = &TableLocations{}
+			}
+			if err := m.Locs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRowStreamTablesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRowStreamTablesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRowStreamTablesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRegionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRegionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRegionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRegionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRegionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRegionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, &RegionInfo{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterPSRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterPSRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterPSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterPSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterPSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterPSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPSInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPSInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPSInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPSInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPSInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPSInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Servers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Servers = append(m.Servers, &PSDetail{})
+			if err := m.Servers[len(m.Servers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BootstrapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BootstrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogID", wireType)
+			}
+			m.LogID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowID", wireType)
+			}
+			m.RowID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= CompressionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixBloomLen", wireType)
+			}
+			m.PrefixBloomLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrefixBloomLen |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVersions", wireType)
+			}
+			m.MaxVersions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVersions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionRetention", wireType)
+			}
+			m.VersionRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VersionRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BootstrapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitPartitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitPartitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitPartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogID", wireType)
+			}
+			m.LogID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowID", wireType)
+			}
+			m.RowID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Locs == nil {
+				m.Locs = &TableLocations{}
+			}
+			if err := m.Locs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobID", wireType)
+			}
+			m.BlobID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedExtent", wireType)
+			}
+			m.SharedExtent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharedExtent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitPartitionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitPartitionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitPartitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPartID", wireType)
+			}
+			m.NewPartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewPartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergePartitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergePartitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergePartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightPartID", wireType)
+			}
+			m.RightPartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RightPartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Locs == nil {
+				m.Locs = &TableLocations{}
+			}
+			if err := m.Locs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergePartitionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergePartitionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergePartitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetMergedStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetMergedStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMergedStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Merged == nil {
+				m.Merged = &MergedStreams{}
+			}
+			if err := m.Merged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetMergedStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetMergedStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMergedStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDiscardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDiscardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDiscardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Discard == nil {
+				m.Discard = &DiscardStats{}
+			}
+			if err := m.Discard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDiscardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDiscardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDiscardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Discard == nil {
+				m.Discard = &DiscardStats{}
+			}
+			if err := m.Discard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSharedTablesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSharedTablesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSharedTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSharedTablesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSharedTablesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSharedTablesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locs = append(m.Locs, &Location{})
+			if err := m.Locs[len(m.Locs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddBlobStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddBlobStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddBlobStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
+			}
+			m.StreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddBlobStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddBlobStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddBlobStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReassignPartitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReassignPartitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReassignPartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+			}
+			m.PSID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PSID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReassignPartitionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReassignPartitionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReassignPartitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+			}
+			m.PartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Load = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, &PartitionMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+			}
+			m.Psversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Psversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+			}
+			m.Psversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Psversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+			}
+			m.Psversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Psversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
+			if m.Start == nil {
+				m.Start = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+			}
+			m.Psversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Psversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+			}
+			m.Psversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Psversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotFound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotFound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+			}
+			m.Psversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Psversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			m.Before = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Before |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Values = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *RangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Prefix)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	l = len(m.Start)
-	if l > 0 {
-		n += 1 + l + sovPspb(uint64(l))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovPspb(uint64(m.Limit))
-	}
-	if m.Partid != 0 {
-		n += 1 + sovPspb(uint64(m.Partid))
-	}
-	if m.Psversion != 0 {
-		n += 1 + sovPspb(uint64(m.Psversion))
-	}
-	return n
-}
 
-func (m *RangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Truncated != 0 {
-		n += 1 + sovPspb(uint64(m.Truncated))
-	}
-	if len(m.Keys) > 0 {
-		for _, b := range m.Keys {
-			l = len(b)
-			n += 1 + l + sovPspb(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPspb(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPspb(x uint64) (n int) {
-	return sovPspb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MixedLog) Unmarshal(dAtA []byte) error {
+func (m *KeyVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4860,88 +21148,211 @@ func (m *MixedLog) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MixedLog: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MixedLog: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
 				}
-				m.Offsets = append(m.Offsets, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPspb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPspb
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Offsets) == 0 {
-					m.Offsets = make([]uint32, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &KeyVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPspb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Offsets = append(m.Offsets, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
 			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -4966,7 +21377,7 @@ func (m *MixedLog) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Range) Unmarshal(dAtA []byte) error {
+func (m *ObjectManifest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4989,17 +21400,17 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Range: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectManifest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectManifest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5009,31 +21420,29 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StartKey == nil {
-				m.StartKey = []byte{}
-			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var byteLen int
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5043,26 +21452,49 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Length |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumChunks", wireType)
 			}
-			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EndKey == nil {
-				m.EndKey = []byte{}
+			m.NumChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumChunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -5087,7 +21519,7 @@ func (m *Range) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Location) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5110,17 +21542,17 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Location: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Location: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.ExtentID = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5130,16 +21562,16 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				m.Type |= CondType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Offset = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5149,11 +21581,45 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint32(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -5178,7 +21644,7 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlobStreams) Unmarshal(dAtA []byte) error {
+func (m *CondPutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5201,88 +21667,84 @@ func (m *BlobStreams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlobStreams: wiretype end group for non-group")
+			return fmt.Errorf("proto: CondPutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlobStreams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CondPutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Blob = append(m.Blob, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPspb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPspb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPspb
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Put == nil {
+				m.Put = &PutRequest{}
+			}
+			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Blob) == 0 {
-					m.Blob = make([]uint64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPspb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Blob = append(m.Blob, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cond == nil {
+				m.Cond = &Condition{}
+			}
+			if err := m.Cond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -5307,7 +21769,7 @@ func (m *BlobStreams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TableLocations) Unmarshal(dAtA []byte) error {
+func (m *CondPutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5330,17 +21792,36 @@ func (m *TableLocations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TableLocations: wiretype end group for non-group")
+			return fmt.Errorf("proto: CondPutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableLocations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CondPutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5350,26 +21831,43 @@ func (m *TableLocations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locs = append(m.Locs, &Location{})
-			if err := m.Locs[len(m.Locs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -5394,7 +21892,7 @@ func (m *TableLocations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
+func (m *CondDeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5417,15 +21915,15 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: CondDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CondDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5452,18 +21950,18 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Blobs == nil {
-				m.Blobs = &BlobStreams{}
+			if m.Delete == nil {
+				m.Delete = &DeleteRequest{}
 			}
-			if err := m.Blobs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cond", wireType)
 			}
-			m.LogStream = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5473,16 +21971,86 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStream |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cond == nil {
+				m.Cond = &Condition{}
+			}
+			if err := m.Cond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CondDeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CondDeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CondDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.RowStream = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5492,16 +22060,16 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RowStream |= uint64(b&0x7F) << shift
+				m.Code |= pb.Code(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5511,33 +22079,29 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Locs == nil {
-				m.Locs = &TableLocations{}
-			}
-			if err := m.Locs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			m.Parent = 0
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5547,16 +22111,69 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Parent |= uint64(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Discard", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitPartRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitPartRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitPartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			var byteLen int
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5566,31 +22183,16 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Discard = append(m.Discard[:0], dAtA[iNdEx:postIndex]...)
-			if m.Discard == nil {
-				m.Discard = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5600,33 +22202,31 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rg == nil {
-				m.Rg = &Range{}
-			}
-			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
 			}
-			m.PartID = 0
+			m.Psversion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5636,7 +22236,7 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartID |= uint64(b&0x7F) << shift
+				m.Psversion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5665,7 +22265,7 @@ func (m *PartitionMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PSDetail) Unmarshal(dAtA []byte) error {
+func (m *SplitPartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5688,17 +22288,17 @@ func (m *PSDetail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PSDetail: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitPartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PSDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitPartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.PSID = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5708,14 +22308,14 @@ func (m *PSDetail) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PSID |= uint64(b&0x7F) << shift
+				m.Code |= pb.Code(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5743,7 +22343,60 @@ func (m *PSDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPartID", wireType)
+			}
+			m.NewPartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewPartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5769,7 +22422,7 @@ func (m *PSDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegionInfo) Unmarshal(dAtA []byte) error {
+func (m *MergePartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5792,17 +22445,17 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergePartRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergePartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			var msglen int
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5812,33 +22465,16 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Rg == nil {
-				m.Rg = &Range{}
-			}
-			if err := m.Rg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
 			}
-			m.PartID = 0
+			m.Psversion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5848,16 +22484,69 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartID |= uint64(b&0x7F) << shift
+				m.Psversion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergePartResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergePartResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergePartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.PSID = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5867,14 +22556,14 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PSID |= uint64(b&0x7F) << shift
+				m.Code |= pb.Code(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5902,8 +22591,27 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightPartID", wireType)
+			}
+			m.RightPartID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RightPartID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -5928,7 +22636,7 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawBlockMeta) Unmarshal(dAtA []byte) error {
+func (m *CompactionStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5951,17 +22659,55 @@ func (m *RawBlockMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawBlockMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactionStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawBlockMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactionStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTables", wireType)
+			}
+			m.NumTables = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTables |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTables", wireType)
+			}
+			m.PendingTables = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingTables |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBytes", wireType)
 			}
-			m.Type = 0
+			m.PendingBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5971,16 +22717,16 @@ func (m *RawBlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= RawBlockType(b&0x7F) << shift
+				m.PendingBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressedSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
 			}
-			m.CompressedSize = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -5990,16 +22736,17 @@ func (m *RawBlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompressedSize |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Running = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnCompressedSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMinor", wireType)
 			}
-			m.UnCompressedSize = 0
+			m.NumMinor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6009,16 +22756,16 @@ func (m *RawBlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnCompressedSize |= uint32(b&0x7F) << shift
+				m.NumMinor |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpExtentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMajor", wireType)
 			}
-			m.VpExtentID = 0
+			m.NumMajor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6028,16 +22775,16 @@ func (m *RawBlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VpExtentID |= uint64(b&0x7F) << shift
+				m.NumMajor |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStart", wireType)
 			}
-			m.VpOffset = 0
+			m.LastStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6047,16 +22794,16 @@ func (m *RawBlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VpOffset |= uint32(b&0x7F) << shift
+				m.LastStart |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeqNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEnd", wireType)
 			}
-			m.SeqNum = 0
+			m.LastEnd = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6066,7 +22813,7 @@ func (m *RawBlockMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SeqNum |= uint64(b&0x7F) << shift
+				m.LastEnd |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6095,7 +22842,7 @@ func (m *RawBlockMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockOffset) Unmarshal(dAtA []byte) error {
+func (m *BlockCacheStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6118,17 +22865,17 @@ func (m *BlockOffset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockOffset: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockCacheStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockOffset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockCacheStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCost", wireType)
 			}
-			var byteLen int
+			m.MaxCost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6138,31 +22885,54 @@ func (m *BlockOffset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxCost |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
+			m.Hits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysAdded", wireType)
 			}
-			m.ExtentID = 0
+			m.KeysAdded = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6172,16 +22942,16 @@ func (m *BlockOffset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExtentID |= uint64(b&0x7F) << shift
+				m.KeysAdded |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysEvicted", wireType)
 			}
-			m.Offset = 0
+			m.KeysEvicted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6191,7 +22961,45 @@ func (m *BlockOffset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint32(b&0x7F) << shift
+				m.KeysEvicted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostAdded", wireType)
+			}
+			m.CostAdded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CostAdded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostEvicted", wireType)
+			}
+			m.CostEvicted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CostEvicted |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6220,7 +23028,7 @@ func (m *BlockOffset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TableIndex) Unmarshal(dAtA []byte) error {
+func (m *CompressionStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6243,17 +23051,17 @@ func (m *TableIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TableIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompressionStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompressionStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
-			var msglen int
+			m.Compression = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6263,65 +23071,16 @@ func (m *TableIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Compression |= CompressionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Offsets = append(m.Offsets, &BlockOffset{})
-			if err := m.Offsets[len(m.Offsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BloomFilter", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPspb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BloomFilter = append(m.BloomFilter[:0], dAtA[iNdEx:postIndex]...)
-			if m.BloomFilter == nil {
-				m.BloomFilter = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
 			}
-			m.EstimatedSize = 0
+			m.RawBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6331,16 +23090,16 @@ func (m *TableIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EstimatedSize |= uint64(b&0x7F) << shift
+				m.RawBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedBytes", wireType)
 			}
-			m.NumOfBlocks = 0
+			m.CompressedBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6350,7 +23109,7 @@ func (m *TableIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumOfBlocks |= uint32(b&0x7F) << shift
+				m.CompressedBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6379,7 +23138,7 @@ func (m *TableIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPartitionMetaRequest) Unmarshal(dAtA []byte) error {
+func (m *PartStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6402,17 +23161,17 @@ func (m *GetPartitionMetaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPartitionMetaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPartitionMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PSID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			m.PSID = 0
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6422,7 +23181,7 @@ func (m *GetPartitionMetaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PSID |= uint64(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6451,7 +23210,7 @@ func (m *GetPartitionMetaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPartitionMetaResponse) Unmarshal(dAtA []byte) error {
+func (m *PartStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6474,10 +23233,10 @@ func (m *GetPartitionMetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPartitionMetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPartitionMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6494,16 +23253,86 @@ func (m *GetPartitionMetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= pb.Code(b&0x7F) << shift
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			m.Reads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reads |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+			}
+			m.Writes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Writes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBytes", wireType)
 			}
-			var msglen int
+			m.WriteBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6513,84 +23342,35 @@ func (m *GetPartitionMetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WriteBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Meta = append(m.Meta, &PartitionMeta{})
-			if err := m.Meta[len(m.Meta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetRowStreamTablesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemtableSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MemtableSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemtableSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetRowStreamTablesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetRowStreamTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableSize", wireType)
 			}
-			m.PartitionID = 0
+			m.TableSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6600,14 +23380,14 @@ func (m *SetRowStreamTablesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartitionID |= uint64(b&0x7F) << shift
+				m.TableSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6634,71 +23414,54 @@ func (m *SetRowStreamTablesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Locs == nil {
-				m.Locs = &TableLocations{}
+			if m.Compaction == nil {
+				m.Compaction = &CompactionStats{}
 			}
-			if err := m.Locs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Compaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCache", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetRowStreamTablesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.BlockCache == nil {
+				m.BlockCache = &BlockCacheStats{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BlockCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetRowStreamTablesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetRowStreamTablesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCompression", wireType)
 			}
-			m.Code = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6708,64 +23471,28 @@ func (m *SetRowStreamTablesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= pb.Code(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetRegionsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.BlockCompression == nil {
+				m.BlockCompression = &CompressionStats{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BlockCompression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -6790,7 +23517,7 @@ func (m *GetRegionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRegionsResponse) Unmarshal(dAtA []byte) error {
+func (m *OpenPartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6813,17 +23540,17 @@ func (m *GetRegionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenPartRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenPartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			m.Code = 0
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6833,16 +23560,16 @@ func (m *GetRegionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= pb.Code(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
 			}
-			var msglen int
+			m.Psversion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -6852,26 +23579,11 @@ func (m *GetRegionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Psversion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Regions = append(m.Regions, &RegionInfo{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -6896,7 +23608,7 @@ func (m *GetRegionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterPSRequest) Unmarshal(dAtA []byte) error {
+func (m *OpenPartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6919,15 +23631,34 @@ func (m *RegisterPSRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterPSRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenPartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterPSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenPartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6955,7 +23686,7 @@ func (m *RegisterPSRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6981,7 +23712,7 @@ func (m *RegisterPSRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterPSResponse) Unmarshal(dAtA []byte) error {
+func (m *ClosePartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7004,17 +23735,17 @@ func (m *RegisterPSResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterPSResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClosePartRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterPSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClosePartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			m.Code = 0
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7024,16 +23755,16 @@ func (m *RegisterPSResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= pb.Code(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
 			}
-			m.Id = 0
+			m.Psversion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7043,7 +23774,7 @@ func (m *RegisterPSResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Psversion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7072,7 +23803,7 @@ func (m *RegisterPSResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPSInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *ClosePartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7095,70 +23826,36 @@ func (m *GetPSInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPSInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClosePartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPSInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClosePartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetPSInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= pb.Code(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetPSInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPSInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Servers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeDes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7168,25 +23865,23 @@ func (m *GetPSInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Servers = append(m.Servers, &PSDetail{})
-			if err := m.Servers[len(m.Servers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CodeDes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7212,7 +23907,7 @@ func (m *GetPSInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7235,17 +23930,17 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BootstrapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BootstrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			m.LogID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7255,16 +23950,31 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogID |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSeq", wireType)
 			}
-			m.RowID = 0
+			m.StartSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7274,16 +23984,16 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RowID |= uint64(b&0x7F) << shift
+				m.StartSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			m.Parent = 0
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7293,69 +24003,16 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Parent |= uint64(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPspb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPspb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BootstrapResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
 			}
-			m.PartID = 0
+			m.Psversion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7365,7 +24022,7 @@ func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartID |= uint64(b&0x7F) << shift
+				m.Psversion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7394,7 +24051,7 @@ func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7417,10 +24074,10 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7492,6 +24149,45 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
@@ -7510,11 +24206,11 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
 			}
-			m.Psversion = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7524,16 +24220,17 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Psversion |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			m.DeleteRange = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			m.Partid = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7543,11 +24240,26 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partid |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -7572,7 +24284,7 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7595,17 +24307,17 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7615,24 +24327,24 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.Events = append(m.Events, &WatchEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -7659,7 +24371,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7682,10 +24394,101 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+			}
+			m.Partid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+			}
+			m.Psversion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Psversion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPspb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPspb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7723,10 +24526,44 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Psversion = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7736,16 +24573,36 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Psversion |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Deleted = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.Partid = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7755,7 +24612,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partid |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7784,7 +24641,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7807,17 +24664,17 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7827,31 +24684,31 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPspb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPspb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.Entries = append(m.Entries, &ExportEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			m.Psversion = 0
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7861,7 +24718,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Psversion |= uint64(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7890,7 +24747,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *IngestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7913,17 +24770,17 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IngestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IngestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
 			}
-			var byteLen int
+			m.Partid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7933,26 +24790,11 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Partid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Psversion", wireType)
@@ -7972,11 +24814,45 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partid", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
 			}
-			m.Partid = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &pb.Block{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -7986,11 +24862,28 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partid |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Index == nil {
+				m.Index = &TableIndex{}
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -8015,7 +24908,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *IngestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8038,17 +24931,17 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IngestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IngestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			var byteLen int
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -8058,31 +24951,16 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
 			}
-			var byteLen int
+			m.Tables = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPspb
@@ -8092,26 +24970,30 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Tables |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPspb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPspb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -8597,7 +25479,7 @@ func (m *BatchResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Res = append(m.Res, &RequestOp{})
+			m.Res = append(m.Res, &ResponseOp{})
 			if err := m.Res[len(m.Res)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -8780,6 +25662,133 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Values = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continuation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Continuation = append(m.Continuation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Continuation == nil {
+				m.Continuation = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
@@ -8884,6 +25893,72 @@ func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
 			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, make([]byte, postIndex-iNdEx))
+			copy(m.Values[len(m.Values)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continuation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPspb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPspb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPspb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Continuation = append(m.Continuation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Continuation == nil {
+				m.Continuation = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPspb(dAtA[iNdEx:])
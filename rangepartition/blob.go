@@ -0,0 +1,76 @@
+package rangepartition
+
+import (
+	"context"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/rangepartition/y"
+	"github.com/journeymidnight/autumn/streamclient"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/pkg/errors"
+)
+
+//SetBlobStream makes values bigger than threshold written to stream, stream
+//should be the last one of blobStreams of the partition, the caller closes it
+//after the partition is closed. threshold 0 means all values are written to log stream.
+//Values in blob streams are read by blockReader, so old blob streams do not need clients.
+func (rp *RangePartition) SetBlobStream(stream streamclient.StreamClient, threshold uint32) {
+	rp.blobLock.Lock()
+	defer rp.blobLock.Unlock()
+	rp.blobStream = stream
+	rp.blobThreshold = threshold
+}
+
+//BlobStreams returns IDs of blob streams of the partition, the last one is
+//written by the partition, the others come from its parent before split
+func (rp *RangePartition) BlobStreams() []uint64 {
+	return rp.blobStreams
+}
+
+//writeBlobs appends big values to the blob stream, and replaces them with value
+//pointers. Log stream only has the pointers, so replaying log does not read
+//big values. Blocks in blob stream are pb.Entry as those in log stream, so
+//getValue reads both of them in the same way.
+func (rp *RangePartition) writeBlobs(entries []*pb.EntryInfo) error {
+	rp.blobLock.Lock()
+	defer rp.blobLock.Unlock()
+	if rp.blobStream == nil || rp.blobThreshold == 0 {
+		return nil
+	}
+
+	var blocks []*pb.Block
+	var bigs []*pb.EntryInfo
+	for _, e := range entries {
+		if e.Log.Meta&uint32(y.BitDelete) > 0 || uint32(len(e.Log.Value)) <= rp.blobThreshold {
+			continue
+		}
+		blocks = append(blocks, &pb.Block{Data: utils.MustMarshal(&pb.Entry{
+			Key:       e.Log.Key,
+			Value:     e.Log.Value,
+			ExpiresAt: e.Log.ExpiresAt,
+		})})
+		bigs = append(bigs, e)
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	extentID, offsets, _, err := rp.blobStream.Append(context.Background(), blocks)
+	if err != nil {
+		return errors.Wrap(err, "write blob stream")
+	}
+	if len(offsets) != len(blocks) {
+		return errors.Errorf("blob stream returns %d offsets for %d blocks", len(offsets), len(blocks))
+	}
+	for i, e := range bigs {
+		vp := valuePointer{extentID: extentID, offset: offsets[i], len: uint32(len(e.Log.Value))}
+		e.Log.Value = vp.Encode()
+		e.Log.Meta |= uint32(y.BitBlobPointer)
+	}
+	return nil
+}
+
+//blobValue resolves the value of a log entry which points into a blob stream
+func (rp *RangePartition) blobValue(e *pb.Entry) ([]byte, error) {
+	return rp.getValue(y.ValueStruct{Meta: y.BitValuePointer, Value: e.Value})
+}
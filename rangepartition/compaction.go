@@ -1,106 +1,465 @@
 package rangepartition
 
 import (
-	"context"
+	"bytes"
+	"math"
+	"sort"
+	"sync/atomic"
 	"time"
 
+	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/rangepartition/skiplist"
 	"github.com/journeymidnight/autumn/rangepartition/table"
 	"github.com/journeymidnight/autumn/rangepartition/y"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/xlog"
+	"github.com/pkg/errors"
 )
 
+const (
+	//tables smaller than it are always in the same tier
+	minTierSize = 16 << 20
+	//a tier is compacted if it has at least minCompactTables overlapped tables
+	minCompactTables = 4
+	maxCompactTables = 32
+	//all tables are compacted if there are more than maxTables
+	maxTables = 24
+)
+
+var errCompactStopped = errors.New("partition is closed while compacting")
+
+//compactStats is updated by the compaction goroutine
+type compactStats struct {
+	running   int32 //atomic
+	numMinor  uint64
+	numMajor  uint64
+	lastStart int64 //unix time in seconds
+	lastEnd   int64
+}
+
+//CompactionStats shows pending and finished compactions of a partition
+type CompactionStats struct {
+	NumTables     int
+	PendingTables int //tables picked by the next minor compaction
+	PendingBytes  uint64
+	Running       bool
+	NumMinor      uint64
+	NumMajor      uint64
+	LastStart     int64 //unix time in seconds, 0 if never
+	LastEnd       int64
+}
+
 func (rp *RangePartition) startCompact() {
 	rp.compactStopper = utils.NewStopper()
 	rp.compactStopper.RunWorker(rp.compact)
 }
 
+//triggerCompact wakes up the compaction goroutine, it never blocks
+func (rp *RangePartition) triggerCompact() {
+	select {
+	case rp.compactCh <- struct{}{}:
+	default:
+	}
+}
+
+//compact runs minor compactions after memtables are flushed, and a major
+//compaction every 10-20 minutes which also drops deleted and expired keys
 func (rp *RangePartition) compact() {
 	randTicker := utils.NewRandomTicker(10*time.Minute, 20*time.Minute)
+	defer randTicker.Stop()
+
+	//tables left by the last run, and extents which were not reclaimed. Tables of
+	//merged partitions are rewritten to rowStream
+	if rp.hasMergedRows() {
+		rp.runCompact(true)
+	}
+	rp.triggerCompact()
+	rp.triggerReclaim()
 	for {
 		select {
-		// Can add a done channel or other stuff.
+		case <-rp.reclaimCh:
+			rp.reclaimRowStream()
+		case <-rp.compactCh:
+			for rp.runCompact(false) {
+				select {
+				case <-rp.compactStopper.ShouldStop():
+					return
+				default:
+				}
+			}
 		case <-randTicker.C:
-			//pick tables
-			//doCompact
+			rp.runCompact(true)
 		case <-rp.compactStopper.ShouldStop():
 			return
 		}
 	}
 }
 
-//tbls已经inc
-func (rp *RangePartition) doCompact(tbls []*table.Table, major bool) {
+//runCompact compacts tables picked by the policy, it returns false if nothing is picked
+func (rp *RangePartition) runCompact(major bool) bool {
+	rp.tableLock.RLock()
+	var tbls []*table.Table
+	if major {
+		if len(rp.tables) > 1 || (len(rp.tables) == 1 && (rp.hasMergedRows() || len(rp.discard.SharedFrom()) > 0)) {
+			tbls = append(tbls, rp.tables...)
+		}
+	} else {
+		tbls, major = pickTables(rp.tables)
+	}
+	for _, t := range tbls {
+		t.IncrRef()
+	}
+	rp.tableLock.RUnlock()
 	if len(tbls) == 0 {
-		return
+		return false
+	}
+
+	atomic.StoreInt32(&rp.compactStats.running, 1)
+	atomic.StoreInt64(&rp.compactStats.lastStart, time.Now().Unix())
+	defer func() {
+		atomic.StoreInt64(&rp.compactStats.lastEnd, time.Now().Unix())
+		atomic.StoreInt32(&rp.compactStats.running, 0)
+	}()
+	xlog.Logger.Infof("partition %d: compact %d tables, major: %v", rp.PartID, len(tbls), major)
+
+	//doCompact releases refs of tbls, tables keeps their own refs
+	if err := rp.doCompact(tbls, major); err != nil {
+		xlog.Logger.Warnf("partition %d: compaction is given up: %v", rp.PartID, err)
+		return false
+	}
+	//extents of tbls are reclaimed when they are not read any more
+	rp.removeTables(tbls)
+	//keys deleted by range tombstones of tbls have been dropped
+	if major {
+		for _, t := range tbls {
+			rp.removeRangeDeletes(t.RangeDeletes())
+		}
+	}
+
+	if major {
+		atomic.AddUint64(&rp.compactStats.numMajor, 1)
+	} else {
+		atomic.AddUint64(&rp.compactStats.numMinor, 1)
+	}
+	return true
+}
+
+//removeTables removes compacted tables from rp.tables and saves table locations in PM,
+//a removed table is obsolete until its last reference is dropped
+func (rp *RangePartition) removeTables(tbls []*table.Table) {
+	removed := make(map[*table.Table]bool, len(tbls))
+	for _, t := range tbls {
+		removed[t] = true
+	}
+
+	rp.tableLock.Lock()
+	var newTables, dropped []*table.Table
+	var tableLocs []*pspb.Location
+	for _, t := range rp.tables {
+		if removed[t] {
+			dropped = append(dropped, t)
+			continue
+		}
+		newTables = append(newTables, t)
+		tableLocs = append(tableLocs, &t.Loc)
+	}
+	rp.obsoleteLock.Lock()
+	for _, t := range dropped {
+		rp.obsolete[t] = struct{}{}
+		t.OnRelease(rp.releaseTable)
+	}
+	rp.obsoleteLock.Unlock()
+	rp.tables = newTables
+	rp.updateTableLocs(tableLocs)
+	rp.tableLock.Unlock()
+
+	//releaseTable locks obsoleteLock
+	for _, t := range dropped {
+		t.DecrRef()
+	}
+}
+
+//pickTables is size-tiered, tables of similar sizes are in the same tier, the
+//tier which has the most tables overlapped with each other is picked. If there
+//are too many tables, or range tombstones are flushed, all of them are picked
+//for a major compaction
+func pickTables(tables []*table.Table) ([]*table.Table, bool) {
+	if len(tables) > maxTables || hasRangeDeletes(tables) {
+		return append([]*table.Table{}, tables...), true
+	}
+	if len(tables) <= 1 {
+		return nil, false
+	}
+
+	sorted := append([]*table.Table{}, tables...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].EstimatedSize() < sorted[j].EstimatedSize()
+	})
+
+	var best []*table.Table
+	var tier []*table.Table
+	var tierSize uint64
+	pick := func() {
+		candidates := overlapped(tier)
+		if len(candidates) > maxCompactTables {
+			candidates = candidates[:maxCompactTables]
+		}
+		if len(candidates) >= minCompactTables && len(candidates) > len(best) {
+			best = candidates
+		}
+	}
+	for _, t := range sorted {
+		size := t.EstimatedSize()
+		if len(tier) > 0 {
+			avg := tierSize / uint64(len(tier))
+			if size > minTierSize && (size > avg*3/2 || size < avg/2) {
+				pick()
+				tier, tierSize = nil, 0
+			}
+		}
+		tier = append(tier, t)
+		tierSize += size
+	}
+	pick()
+
+	if len(best) == 0 {
+		return nil, false
+	}
+	//tables between the oldest and the newest picked ones are also picked, so
+	//a table which is not compacted is either older or newer than the result.
+	//value log gc makes keys of the same version, the newer one is right
+	minSeq, maxSeq := best[0].LastSeq, best[0].LastSeq
+	for _, t := range best {
+		if t.LastSeq < minSeq {
+			minSeq = t.LastSeq
+		}
+		if t.LastSeq > maxSeq {
+			maxSeq = t.LastSeq
+		}
+	}
+	//doCompact takes the order in rp.tables
+	var ret []*table.Table
+	for _, t := range tables {
+		if t.LastSeq >= minSeq && t.LastSeq <= maxSeq {
+			ret = append(ret, t)
+		}
+	}
+	return ret, false
+}
+
+func hasRangeDeletes(tables []*table.Table) bool {
+	for _, t := range tables {
+		if len(t.RangeDeletes()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+//overlapped returns tables whose key ranges overlap with others in tbls,
+//compacting the others does not reduce reads
+func overlapped(tbls []*table.Table) []*table.Table {
+	var ret []*table.Table
+	for i, a := range tbls {
+		for j, b := range tbls {
+			if i != j &&
+				bytes.Compare(y.ParseKey(a.Smallest()), y.ParseKey(b.Biggest())) <= 0 &&
+				bytes.Compare(y.ParseKey(b.Smallest()), y.ParseKey(a.Biggest())) <= 0 {
+				ret = append(ret, a)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+//CompactionStats returns pending work of compaction
+func (rp *RangePartition) CompactionStats() CompactionStats {
+	s := CompactionStats{
+		Running:   atomic.LoadInt32(&rp.compactStats.running) == 1,
+		NumMinor:  atomic.LoadUint64(&rp.compactStats.numMinor),
+		NumMajor:  atomic.LoadUint64(&rp.compactStats.numMajor),
+		LastStart: atomic.LoadInt64(&rp.compactStats.lastStart),
+		LastEnd:   atomic.LoadInt64(&rp.compactStats.lastEnd),
+	}
+	rp.tableLock.RLock()
+	defer rp.tableLock.RUnlock()
+	s.NumTables = len(rp.tables)
+	pending, _ := pickTables(rp.tables)
+	s.PendingTables = len(pending)
+	for _, t := range pending {
+		s.PendingBytes += t.EstimatedSize()
+	}
+	return s
+}
+
+//tbls已经inc. If the partition is closed while compacting, or a value in the log of
+//another partition can not be read, the tables built so far are removed and the
+//error is returned, tbls are still in rp.tables
+func (rp *RangePartition) doCompact(tbls []*table.Table, major bool) error {
+	if len(tbls) == 0 {
+		return nil
 	}
 	defer func() {
 		for _, table := range tbls {
 			table.DecrRef()
 		}
 	}()
-	//tbls的顺序是在stream里面的顺序
+	//tbls的顺序是在stream里面的顺序, merge iterator在key相同时取前面的, 所以新的table在前
+	sorted := append([]*table.Table{}, tbls...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].LastSeq > sorted[j].LastSeq
+	})
 
 	var iters []y.Iterator
 	var maxSeq uint64
-	var head valuePointer
-	for _, table := range tbls {
+	for _, table := range sorted {
 		if table.LastSeq > maxSeq {
 			maxSeq = table.LastSeq
-			head = valuePointer{extentID: table.VpExtentID, offset: table.VpOffset}
 		}
 		iters = append(iters, table.NewIterator(false))
 	}
+	//tables of merged partitions point to their own log streams
+	var head valuePointer
+	if t := headTable(sorted, rp.logStream.ExtentIDs()); t != nil {
+		head = valuePointer{extentID: t.VpExtentID, offset: t.VpOffset}
+	}
 
 	it := table.NewMergeIterator(iters, false)
 	defer it.Close()
 
 	it.Rewind()
+	dels := rp.rangeDeletes(math.MaxUint64, nil, nil)
 
 	//FIXME
-	discardStats := make(map[uint32]int64)
+	discardStats := make(map[uint64]int64)
 	updateStats := func(vs y.ValueStruct) {
 		if vs.Meta&y.BitValuePointer > 0 { //big Value
 			var vp valuePointer
 			vp.Decode(vs.Value)
-			discardStats[uint32(vp.extentID)] += int64(vp.len)
+			discardStats[vp.extentID] += int64(vp.len)
 		}
 	}
 
 	var numBuilds int
-	resultCh := make(chan struct{})
-	//ignore keep multiple versions and snapshot support
+	resultCh := make(chan *table.Table)
+	keep := rp.retention()
+	//versions of curKey come from the newest to the oldest, keptVersions of them
+	//are kept. A deleted or expired version is dropped by major compaction if no
+	//older version is kept, so it is held in pending until the next one is kept
+	var curKey []byte
+	var inRange, dropRest bool
+	var keptVersions int
+	var pendingKey []byte
+	var pending *y.ValueStruct
+	dropPending := func() {
+		if pending != nil {
+			updateStats(*pending)
+			pending = nil
+		}
+	}
+	//values in logs of the partitions this one is split from are copied into
+	//tables, the logs are not read after a major compaction
+	var ownLog map[uint64]bool
+	if major && len(rp.discard.SharedFrom()) > 0 {
+		ownLog = make(map[uint64]bool)
+		for _, extentID := range rp.logStream.ExtentIDs() {
+			ownLog[extentID] = true
+		}
+		mergedLogs, _ := rp.mergedStreams()
+		for _, stream := range mergedLogs {
+			for _, extentID := range stream.ExtentIDs() {
+				ownLog[extentID] = true
+			}
+		}
+	}
+
 	capacity := int64(2 * maxSkipList)
+	var cerr error
 	for it.Valid() {
-		var skipKey []byte
+		//close waits for compaction, do not build the rest of the tables
+		select {
+		case <-rp.compactStopper.ShouldStop():
+			cerr = errCompactStopped
+		default:
+		}
+		if cerr != nil {
+			break
+		}
 		timeStart := time.Now()
 		var numKeys, numSkips uint64
 		memStore := skiplist.NewSkiplist(capacity)
 		for ; it.Valid(); it.Next() {
-			if len(skipKey) > 0 {
-				if y.SameKey(it.Key(), skipKey) {
-					updateStats(it.Value())
+			//range tombstones are kept until major compaction, even if there
+			//are newer versions of their start keys
+			if it.Value().Meta&y.BitRangeDelete > 0 {
+				if major {
 					numSkips++
 					continue
-				} else {
-					skipKey = skipKey[:0]
 				}
+				if memStore.MemSize()+int64(estimatedVS(it.Key(), it.Value())) > capacity {
+					break
+				}
+				numKeys++
+				memStore.Put(it.Key(), it.Value())
+				continue
 			}
 
-			vs := it.Value()
-
-			skipKey = y.SafeCopy(skipKey, it.Key())
+			userKey := y.ParseKey(it.Key())
+			if !bytes.Equal(userKey, curKey) {
+				dropPending()
+				curKey = y.SafeCopy(curKey, userKey)
+				keptVersions = 0
+				dropRest = false
+				//tables shared with the other half after split
+				inRange = rp.InRange(userKey)
+			}
+			if !inRange {
+				numSkips++
+				continue
+			}
 
-			if major && isDeletedOrExpired(vs.Meta, vs.ExpiresAt) {
-				updateStats(it.Value())
+			vs := it.Value()
+			version := y.ParseTs(it.Key())
+			//versions older than a dropped one or a range tombstone are dropped too
+			if dropRest || !keep.keeps(keptVersions, version) || deletedBy(dels, userKey, version) > 0 {
+				dropRest = true
+				updateStats(vs)
 				numSkips++
 				continue
 			}
+			if ownLog != nil && vs.Meta&y.BitValuePointer > 0 {
+				var vp valuePointer
+				vp.Decode(vs.Value)
+				if !ownLog[vp.extentID] {
+					value, err := rp.getValue(vs)
+					if err != nil {
+						cerr = err
+						break
+					}
+					vs.Meta &^= y.BitValuePointer
+					vs.Value = value
+				}
+			}
 
-			if memStore.MemSize()+int64(estimatedVS(it.Key(), it.Value())) > capacity {
+			size := int64(estimatedVS(it.Key(), vs))
+			if pending != nil {
+				size += int64(estimatedVS(pendingKey, *pending))
+			}
+			if memStore.MemSize()+size > capacity {
 				break
 			}
+			keptVersions++
+			if pending != nil {
+				numKeys++
+				memStore.Put(pendingKey, *pending)
+				pending = nil
+			}
+			if major && isDeletedOrExpired(vs.Meta, vs.ExpiresAt) {
+				pendingKey = y.SafeCopy(pendingKey, it.Key())
+				pending = &y.ValueStruct{Meta: vs.Meta, UserMeta: vs.UserMeta, ExpiresAt: vs.ExpiresAt, Value: y.Copy(vs.Value)}
+				continue
+			}
 			numKeys++
 			memStore.Put(it.Key(), vs)
 		}
@@ -113,20 +472,32 @@ func (rp *RangePartition) doCompact(tbls []*table.Table, major bool) {
 		rp.flushChan <- flushTask{mt: memStore, vptr: head, seqNum: maxSeq, isCompact: true, resultCh: resultCh}
 		numBuilds++
 	}
+	dropPending()
 
 	//wait all numBuilds finished(saved in rowstream and saved in pm)
+	var built []*table.Table
 	for i := 0; i < numBuilds; i++ {
-		<-resultCh
+		if t := <-resultCh; t != nil {
+			built = append(built, t)
+		}
+	}
+	if cerr != nil {
+		//keys in built are still in tbls
+		rp.removeTables(built)
+		return cerr
 	}
 
-	eID := tbls[len(tbls)-1].Loc.ExtentID
-
-	//last table's meta extentd
-	_, _, err := rp.rowStream.Truncate(context.Background(), eID)
-	if err == nil {
-		_ = err
-		//FIXME: send frontStream/endStream to sm
+	//stale values found by compaction are collected by gc
+	if len(discardStats) > 0 {
+		rp.discard.UpdateDiscardStats(discardStats)
+		rp.saveDiscard()
+	}
+	//all tables are compacted, values in logs of others have been copied
+	if ownLog != nil {
+		rp.discard.ReleaseSharedFrom()
+		rp.saveDiscard()
 	}
+	return nil
 }
 
 func isDeletedOrExpired(meta byte, expiresAt uint64) bool {
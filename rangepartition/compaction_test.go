@@ -1,13 +1,18 @@
 package rangepartition
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/journeymidnight/autumn/manager/pmclient"
+	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/rangepartition/table"
 	"github.com/journeymidnight/autumn/streamclient"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCompaction(t *testing.T) {
@@ -19,7 +24,7 @@ func TestCompaction(t *testing.T) {
 	defer rowStream.Close()
 
 	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
-		[]byte(""), []byte(""), nil, nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock)
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
 	defer rp.Close()
 
 	var wg sync.WaitGroup
@@ -27,7 +32,7 @@ func TestCompaction(t *testing.T) {
 		wg.Add(1)
 		k := fmt.Sprintf("%04d", i)
 		v := fmt.Sprintf("%d", i)
-		rp.WriteAsync([]byte(k), []byte(v), func(e error) {
+		rp.WriteAsync([]byte(k), []byte(v), 0, func(e error) {
 			wg.Done()
 		})
 	}
@@ -42,6 +47,207 @@ func TestCompaction(t *testing.T) {
 	}
 	rp.tableLock.RUnlock()
 
-	rp.doCompact(tbls, true)
+	require.NoError(t, rp.doCompact(tbls, true))
 	fmt.Printf("%d\n", len(rp.tables))
 }
+
+func TestBackgroundCompaction(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	pmclient := new(pmclient.MockPMClient)
+
+	defer logStream.Close()
+	defer rowStream.Close()
+
+	//each run flushes one table which overlaps with others, runs see less than
+	//minCompactTables tables, so only the last open compacts them
+	for run := 0; run < minCompactTables; run++ {
+		rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+			[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+		for i := 0; i < 100; i++ {
+			_, err := rp.Write([]byte(fmt.Sprintf("%04d", i)), []byte(fmt.Sprintf("%d-%d", run, i)), 0)
+			require.NoError(t, err)
+		}
+		require.NoError(t, rp.Close())
+	}
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer rp.Close()
+
+	require.Eventually(t, func() bool {
+		return rp.CompactionStats().NumMinor > 0
+	}, 10*time.Second, 50*time.Millisecond)
+
+	stats := rp.CompactionStats()
+	require.Equal(t, 1, stats.NumTables)
+	require.Equal(t, 0, stats.PendingTables)
+	for i := 0; i < 100; i++ {
+		v, err := rp.Get([]byte(fmt.Sprintf("%04d", i)), 0)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("%d-%d", minCompactTables-1, i)), v)
+	}
+}
+
+func TestReclaimRowStream(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	pmclient := new(pmclient.MockPMClient)
+
+	defer logStream.Close()
+	defer rowStream.Close()
+
+	//tables of different runs do not overlap, so no minor compaction is picked.
+	//random values are not compressed, a table of each run fills a mock extent
+	value := make([]byte, 800)
+	rand.Read(value)
+	for run := 0; run < 3; run++ {
+		rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+			[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+		for i := run * 2000; i < (run+1)*2000; i++ {
+			_, err := rp.Write([]byte(fmt.Sprintf("%04d", i)), value, 0)
+			require.NoError(t, err)
+		}
+		require.NoError(t, rp.Close())
+	}
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer rp.Close()
+	before := rowStream.ExtentIDs()
+	require.True(t, len(before) > 2)
+
+	//hold compacted tables like an iterator does
+	var old []*table.Table
+	rp.tableLock.RLock()
+	for _, t := range rp.tables {
+		t.IncrRef()
+		old = append(old, t)
+	}
+	rp.tableLock.RUnlock()
+
+	//output of major compaction is split into several tables
+	require.True(t, rp.runCompact(true))
+	rp.tableLock.RLock()
+	for _, tbl := range rp.tables {
+		require.NotContains(t, old, tbl)
+	}
+	rp.tableLock.RUnlock()
+	rp.reclaimRowStream()
+	require.Equal(t, before[0], rowStream.ExtentIDs()[0])
+
+	//the first old table is read by another partition after split
+	pmclient.Shared = []*pspb.Location{&old[0].Loc}
+	for _, t := range old {
+		t.DecrRef()
+	}
+	require.Eventually(t, func() bool {
+		rp.obsoleteLock.Lock()
+		defer rp.obsoleteLock.Unlock()
+		return len(rp.obsolete) == 0
+	}, 10*time.Second, 50*time.Millisecond)
+	rp.reclaimRowStream()
+	require.Equal(t, old[0].Extents()[0], rowStream.ExtentIDs()[0])
+
+	pmclient.Shared = nil
+	rp.reclaimRowStream()
+	require.Equal(t, rp.tables[0].Extents()[0], rowStream.ExtentIDs()[0])
+	//the last old table may end in the extent where compaction starts
+	for _, extentID := range old[0].Extents() {
+		_, _, err := rowStream.Read(context.Background(), extentID, 0, 1)
+		require.Error(t, err)
+	}
+
+	for _, i := range []int{0, 1999, 2000, 5999} {
+		v, err := rp.Get([]byte(fmt.Sprintf("%04d", i)), 0)
+		require.NoError(t, err)
+		require.Equal(t, value, v)
+	}
+}
+
+func TestVersionRetention(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	pmclient := new(pmclient.MockPMClient)
+
+	defer logStream.Close()
+	defer rowStream.Close()
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	for i := 0; i < 5; i++ {
+		_, err := rp.Write([]byte("k"), []byte(fmt.Sprintf("v%d", i)), 0)
+		require.NoError(t, err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err := rp.Write([]byte("d"), []byte(fmt.Sprintf("v%d", i)), 0)
+		require.NoError(t, err)
+	}
+	_, err := rp.Delete([]byte("d"))
+	require.NoError(t, err)
+	require.NoError(t, rp.Close())
+
+	//background compaction after open must not drop versions
+	rp = OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock,
+		OpenOption{MaxVersions: 3})
+	defer rp.Close()
+	require.NotEmpty(t, rp.getVersionTimes())
+
+	compact := func() {
+		var tbls []*table.Table
+		rp.tableLock.RLock()
+		for _, t := range rp.tables {
+			t.IncrRef()
+			tbls = append(tbls, t)
+		}
+		rp.tableLock.RUnlock()
+		require.NoError(t, rp.doCompact(tbls, true))
+		rp.removeTables(tbls)
+	}
+
+	compact()
+	vers, truncated, err := rp.GetVersions([]byte("k"), 0, 0, true)
+	require.NoError(t, err)
+	require.False(t, truncated)
+	require.Equal(t, 3, len(vers))
+	for i, v := range vers {
+		require.Equal(t, []byte(fmt.Sprintf("v%d", 4-i)), v.Value)
+	}
+	//read an old version
+	v, err := rp.Get([]byte("k"), vers[2].Version)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v2"), v)
+
+	//page through versions
+	page, truncated, err := rp.GetVersions([]byte("k"), vers[0].Version, 1, false)
+	require.NoError(t, err)
+	require.True(t, truncated)
+	require.Equal(t, vers[1].Version, page[0].Version)
+	require.Nil(t, page[0].Value)
+
+	//the delete is kept with the older versions
+	_, err = rp.Get([]byte("d"), 0)
+	require.Equal(t, ErrNotFound, err)
+	vers, _, err = rp.GetVersions([]byte("d"), 0, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(vers))
+	require.True(t, vers[0].Deleted)
+
+	//versions newer than retention are kept
+	rp.SetRetention(1, time.Hour)
+	compact()
+	vers, _, err = rp.GetVersions([]byte("k"), 0, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(vers))
+
+	rp.SetRetention(1, 0)
+	compact()
+	vers, _, err = rp.GetVersions([]byte("k"), 0, 0, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(vers))
+	require.Equal(t, []byte("v4"), vers[0].Value)
+	vers, _, err = rp.GetVersions([]byte("d"), 0, 0, false)
+	require.NoError(t, err)
+	require.Empty(t, vers)
+}
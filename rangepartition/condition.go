@@ -0,0 +1,149 @@
+package rangepartition
+
+import (
+	"bytes"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/rangepartition/y"
+	"github.com/journeymidnight/autumn/wire_errors"
+)
+
+var ErrPreconditionFailed = wire_errors.PreconditionFailed
+
+type CondType int
+
+const (
+	CondAlways       CondType = iota
+	CondVersionEqual          //the seqNumber of the latest version equals Version
+	CondValueEqual            //the latest value equals Value
+	CondAbsent                //the key does not exist, is deleted or expired
+	CondPresent
+)
+
+//Condition is checked against the latest version of the key in doWrites,
+//all writes are serialised there, so the check and the write are atomic
+type Condition struct {
+	Type    CondType
+	Version uint64
+	Value   []byte
+}
+
+//CondWrite writes the value only if cond is met, otherwise ErrPreconditionFailed is returned
+func (rp *RangePartition) CondWrite(key, value []byte, expiresAt uint64, cond Condition) (uint64, error) {
+	e := &pb.EntryInfo{
+		Log: &pb.Entry{
+			Key:       key,
+			Value:     value,
+			ExpiresAt: expiresAt,
+		},
+	}
+	return rp.writeEntriesWithCond([]*pb.EntryInfo{e}, &cond)
+}
+
+//CondDelete deletes the key only if cond is met, otherwise ErrPreconditionFailed is returned
+func (rp *RangePartition) CondDelete(key []byte, cond Condition) (uint64, error) {
+	e := &pb.EntryInfo{
+		Log: &pb.Entry{
+			Key:  key,
+			Meta: uint32(y.BitDelete),
+		},
+	}
+	return rp.writeEntriesWithCond([]*pb.EntryInfo{e}, &cond)
+}
+
+//checkConditions is called in writeRequests before writing value log.
+//requests whose conditions are not met are finished with ErrPreconditionFailed,
+//the rest are returned. Previous requests are all in memtable, pending
+//holds the entries of the accepted requests in the same batch, and pendingDels
+//holds their range tombstones which are newer than the keys not in pending.
+func (rp *RangePartition) checkConditions(reqs []*request) []*request {
+	var pending map[string]*pb.Entry
+	var pendingDels []rangeDelete
+	accepted := reqs[:0]
+	for _, req := range reqs {
+		if req.cond != nil && !rp.conditionMet(req.entries[0].Log.Key, req.cond, pending, pendingDels) {
+			req.Err = ErrPreconditionFailed
+			req.wg.Done()
+			continue
+		}
+		accepted = append(accepted, req)
+		if req.seq == 0 {
+			//rewritten by valuelog gc, the visible versions do not change
+			continue
+		}
+		if pending == nil {
+			pending = make(map[string]*pb.Entry)
+		}
+		for _, e := range req.entries {
+			userKey := y.ParseKey(e.Log.Key)
+			if e.Log.Meta&uint32(y.BitRangeDelete) > 0 {
+				d := rangeDelete{start: userKey, end: e.Log.Value}
+				for k := range pending {
+					if d.contains([]byte(k)) {
+						delete(pending, k)
+					}
+				}
+				pendingDels = append(pendingDels, d)
+			}
+			pending[string(userKey)] = e.Log
+		}
+	}
+	return accepted
+}
+
+func (rp *RangePartition) conditionMet(internalKey []byte, cond *Condition, pending map[string]*pb.Entry, pendingDels []rangeDelete) bool {
+	if cond.Type == CondAlways {
+		return true
+	}
+	userKey := y.ParseKey(internalKey)
+
+	var exist bool
+	var version uint64
+	var value []byte
+	if e, ok := pending[string(userKey)]; ok {
+		exist = !isDeletedOrExpired(getLowerByte(e.Meta), e.ExpiresAt)
+		version = y.ParseTs(e.Key)
+		value = e.Value
+		if exist && cond.Type == CondValueEqual && e.Meta&uint32(y.BitBlobPointer) > 0 {
+			v, err := rp.getValue(y.ValueStruct{Meta: y.BitValuePointer, Value: e.Value})
+			if err != nil {
+				return false
+			}
+			value = v
+		}
+	} else if deletedInBatch(pendingDels, userKey) {
+		exist = false
+	} else {
+		vs := rp.getValueStruct(userKey, 0)
+		exist = vs.Version != 0 && !isDeletedOrExpired(vs.Meta, vs.ExpiresAt)
+		version = vs.Version
+		if exist && cond.Type == CondValueEqual {
+			v, err := rp.getValue(vs)
+			if err != nil {
+				return false
+			}
+			value = v
+		}
+	}
+
+	switch cond.Type {
+	case CondVersionEqual:
+		return exist && version == cond.Version
+	case CondValueEqual:
+		return exist && bytes.Equal(value, cond.Value)
+	case CondAbsent:
+		return !exist
+	case CondPresent:
+		return exist
+	}
+	return false
+}
+
+func deletedInBatch(dels []rangeDelete, userKey []byte) bool {
+	for _, d := range dels {
+		if d.contains(userKey) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,70 +1,128 @@
 package rangepartition
 
 import (
-	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/xlog"
 )
 
+//discardManager counts size of stale values in each extent, stale values are
+//found by compaction. It is saved in PM as PART/{PartID}/discard
 type discardManager struct {
-	streams         map[uint64]pb.StreamInfo
-	reverseIndex    map[uint64][]uint64 //extentID=>[stream1, stream2]
-	discardOfStream map[uint64]int64
 	utils.SafeMutex
+	discards     map[uint64]int64 //extentID => size of stale values
+	sharedExtent uint64           //log stream up to it is read by partitions split from this one
+	sharedFrom   []uint64         //partitions whose logs may be read by value pointers of this one
+	truncatedSeq uint64           //entries up to it may be in truncated log extents
 }
 
-func NewDiscardManager(si map[uint64]pb.StreamInfo) *discardManager {
+func newDiscardManager(stats *pspb.DiscardStats) *discardManager {
 	dsm := &discardManager{
-		reverseIndex:    make(map[uint64][]uint64),
-		discardOfStream: make(map[uint64]int64),
+		discards: make(map[uint64]int64),
 	}
-
-	dsm.streams = si
-	for streamID, extentIDs := range si {
-		for _, extentID := range extentIDs.ExtentIDs {
-			dsm.reverseIndex[extentID] = append(dsm.reverseIndex[extentID], streamID)
+	if stats != nil {
+		for extentID, discard := range stats.Discards {
+			dsm.discards[extentID] = discard
 		}
+		dsm.sharedExtent = stats.SharedExtent
+		dsm.sharedFrom = stats.SharedFrom
+		dsm.truncatedSeq = stats.TruncatedSeq
 	}
 	return dsm
 }
 
 //input : map[exteintID]=>len(free data)
 func (dsm *discardManager) UpdateDiscardStats(stats map[uint64]int64) {
-	dsm.RLock()
-	defer dsm.RUnlock()
+	dsm.Lock()
+	defer dsm.Unlock()
 	for extentID, discard := range stats {
-		for _, streamID := range dsm.reverseIndex[extentID] {
-			dsm.discardOfStream[streamID] += discard
-		}
+		dsm.discards[extentID] += discard
 	}
+}
+
+func (dsm *discardManager) Discard(extentID uint64) int64 {
+	dsm.RLock()
+	defer dsm.RUnlock()
+	return dsm.discards[extentID]
+}
 
+func (dsm *discardManager) SharedExtent() uint64 {
+	dsm.RLock()
+	defer dsm.RUnlock()
+	return dsm.sharedExtent
 }
 
-func (dsm *discardManager) MaxDiscard() *pb.StreamInfo {
+func (dsm *discardManager) SharedFrom() []uint64 {
 	dsm.RLock()
 	defer dsm.RUnlock()
-	maxDiscard := int64(0)
-	maxStreamID := uint64(0)
-	if len(dsm.discardOfStream) == 0 {
-		return nil
+	return dsm.sharedFrom
+}
+
+//ReleaseSharedFrom is called when no value pointer reads logs of other partitions,
+//PM knows it after the stats are saved
+func (dsm *discardManager) ReleaseSharedFrom() {
+	dsm.Lock()
+	defer dsm.Unlock()
+	dsm.sharedFrom = nil
+}
+
+//SetShared takes sharing of logs from stats saved in PM, partitions split from
+//this one may have stopped reading its log
+func (dsm *discardManager) SetShared(stats *pspb.DiscardStats) {
+	if stats == nil {
+		return
 	}
-	for streamID, discard := range dsm.discardOfStream {
-		if maxDiscard < discard {
-			maxStreamID = streamID
-			maxDiscard = discard
-		}
+	dsm.Lock()
+	defer dsm.Unlock()
+	dsm.sharedExtent = stats.SharedExtent
+	dsm.sharedFrom = stats.SharedFrom
+}
+
+func (dsm *discardManager) TruncatedSeq() uint64 {
+	dsm.RLock()
+	defer dsm.RUnlock()
+	return dsm.truncatedSeq
+}
 
+func (dsm *discardManager) SetTruncatedSeq(seq uint64) {
+	dsm.Lock()
+	defer dsm.Unlock()
+	if seq > dsm.truncatedSeq {
+		dsm.truncatedSeq = seq
 	}
-	ret := dsm.streams[maxStreamID]
-	return &ret
 }
 
-func (dsm *discardManager) AddBlobStream(si pb.StreamInfo) {
+//Remove forgets extents which have been truncated
+func (dsm *discardManager) Remove(extentIDs []uint64) {
 	dsm.Lock()
 	defer dsm.Unlock()
+	for _, extentID := range extentIDs {
+		delete(dsm.discards, extentID)
+	}
+}
 
-	dsm.streams[si.StreamID] = si
+func (dsm *discardManager) Stats() *pspb.DiscardStats {
+	dsm.RLock()
+	defer dsm.RUnlock()
+	stats := &pspb.DiscardStats{
+		Discards:     make(map[uint64]int64, len(dsm.discards)),
+		SharedExtent: dsm.sharedExtent,
+		SharedFrom:   dsm.sharedFrom,
+		TruncatedSeq: dsm.truncatedSeq,
+	}
+	for extentID, discard := range dsm.discards {
+		stats.Discards[extentID] = discard
+	}
+	return stats
+}
 
-	for _, extentID := range si.ExtentIDs {
-		dsm.reverseIndex[extentID] = append(dsm.reverseIndex[extentID], extentID)
+//saveDiscard saves discard stats in PM, GC works without them, so errors are
+//only logged
+func (rp *RangePartition) saveDiscard() {
+	stats, err := rp.pmClient.SetDiscard(rp.PartID, rp.discard.Stats())
+	if err != nil {
+		xlog.Logger.Warnf("failed to save discard stats of %d: %v", rp.PartID, err)
+		return
 	}
+	rp.discard.SetShared(stats)
 }
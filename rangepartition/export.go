@@ -0,0 +1,74 @@
+package rangepartition
+
+import (
+	"bytes"
+	"math"
+	"sync/atomic"
+
+	"github.com/journeymidnight/autumn/rangepartition/y"
+)
+
+//ExportEntry is the newest version of a key, it is returned by Export and
+//written by Ingest
+type ExportEntry struct {
+	Key       []byte
+	Value     []byte
+	Version   uint64
+	Deleted   bool //the key is deleted at Version, Value is nil
+	ExpiresAt uint64
+}
+
+//Export calls fn with the newest version of each key of the partition at commitSeq
+//in the order of keys, value pointers are resolved. Deleted keys whose tombstones
+//are not compacted yet are exported as deletes, expired keys are exported with
+//their ExpiresAt. It returns the seqNumber which the partition is read at
+func (rp *RangePartition) Export(fn func(ExportEntry) error) (uint64, error) {
+	atomic.AddUint64(&rp.counters.reads, 1)
+	readTs := rp.readTs(0)
+
+	iter := rp.newIterator(false, rp.StartKey, rp.EndKey, nil)
+	defer iter.Close()
+	dels := rp.rangeDeletes(readTs, rp.StartKey, rp.EndKey)
+
+	var skipKey []byte
+	for iter.Seek(y.KeyWithTs(rp.StartKey, math.MaxUint64)); iter.Valid(); iter.Next() {
+		userKey := y.ParseKey(iter.Key())
+		if len(rp.EndKey) > 0 && bytes.Compare(userKey, rp.EndKey) >= 0 {
+			break
+		}
+		version := y.ParseTs(iter.Key())
+		if version > readTs {
+			continue
+		}
+		if len(skipKey) > 0 && y.SameKey(iter.Key(), skipKey) {
+			continue
+		}
+		//range tombstones are applied to the keys they cover by deletedBy
+		vs := iter.Value()
+		if vs.Meta&y.BitRangeDelete > 0 {
+			continue
+		}
+		skipKey = y.SafeCopy(skipKey, iter.Key())
+
+		e := ExportEntry{
+			Key:       y.Copy(userKey),
+			Version:   version,
+			Deleted:   vs.Meta&y.BitDelete > 0,
+			ExpiresAt: vs.ExpiresAt,
+		}
+		if seq := deletedBy(dels, userKey, version); seq > 0 {
+			e = ExportEntry{Key: e.Key, Version: seq, Deleted: true}
+		}
+		if !e.Deleted {
+			v, err := rp.getValue(vs)
+			if err != nil {
+				return 0, err
+			}
+			e.Value = y.Copy(v)
+		}
+		if err := fn(e); err != nil {
+			return 0, err
+		}
+	}
+	return readTs, nil
+}
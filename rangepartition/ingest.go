@@ -0,0 +1,247 @@
+package rangepartition
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/rangepartition/table"
+	"github.com/journeymidnight/autumn/rangepartition/y"
+	"github.com/pkg/errors"
+)
+
+//Ingest copies tables built by table.Builder, e.g. those written by export, into
+//rowStream without writing log stream and memtable, Finish attaches all of them to
+//the partition at once. Blocks are appended as they are, only the index of a table
+//is rewritten to their new locations. Keys of the tables get the seqNumber allocated
+//by NewIngest, so they are newer than the versions written before it and older than
+//those written after it. A table must have one version of each key and no value pointers
+type Ingest struct {
+	rp      *RangePartition
+	seq     uint64
+	head    valuePointer
+	lock    sync.Mutex          //protect pending and tables, reclaimRowStream reads them
+	pending []*pspb.BlockOffset //locations of blocks of the current table in rowStream
+	tables  []*table.Table
+	last    []byte //the biggest user key of tables
+	blocks  uint64
+}
+
+//NewIngest starts an ingest, the caller calls Finish or Abort
+func (rp *RangePartition) NewIngest() (*Ingest, error) {
+	rp.seqLock.Lock()
+	if atomic.LoadInt32(&rp.blockWrites) == 1 {
+		rp.seqLock.Unlock()
+		return nil, ErrBlockedWrites
+	}
+	seq := atomic.AddUint64(&rp.seqNumber, 1)
+	rp.seqLock.Unlock()
+	rp.addVersionTimes([]*pspb.VersionTime{{Seq: seq, UnixTime: time.Now().Unix()}})
+
+	//log stream before the head of the newest table is in tables, so replaying
+	//from it never misses writes if the ingested tables become the newest ones
+	ing := &Ingest{rp: rp, seq: seq}
+	logExtents := rp.logStream.ExtentIDs()
+	rp.tableLock.RLock()
+	if t := headTable(rp.tables, logExtents); t != nil {
+		ing.head = valuePointer{extentID: t.VpExtentID, offset: t.VpOffset}
+	}
+	rp.tableLock.RUnlock()
+
+	rp.obsoleteLock.Lock()
+	rp.ingests[ing] = struct{}{}
+	rp.obsoleteLock.Unlock()
+	return ing, nil
+}
+
+//AddBlocks appends data blocks of the current table to rowStream, they are in the
+//order of the index of the table
+func (ing *Ingest) AddBlocks(blocks []*pb.Block) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+	rp := ing.rp
+	//reclaimRowStream sees the blocks in pending after they are appended
+	rp.rowLock.RLock()
+	defer rp.rowLock.RUnlock()
+	extentID, offsets, _, err := rp.rowStream.Append(context.Background(), blocks)
+	if err != nil {
+		return err
+	}
+	ing.lock.Lock()
+	for _, offset := range offsets {
+		ing.pending = append(ing.pending, &pspb.BlockOffset{ExtentID: extentID, Offset: offset})
+	}
+	ing.lock.Unlock()
+	ing.blocks += uint64(len(blocks))
+	return nil
+}
+
+//AddTable writes index of the blocks added after the last table to rowStream, with
+//their new locations and keys at the seqNumber of the ingest. Tables are sorted by
+//key and in the range of the partition
+func (ing *Ingest) AddTable(index *pspb.TableIndex) error {
+	rp := ing.rp
+	if len(index.Offsets) == 0 || len(index.Offsets) != len(ing.pending) {
+		return errors.Errorf("table has %d blocks, %d blocks are added", len(index.Offsets), len(ing.pending))
+	}
+	if len(index.RangeDeletes) > 0 {
+		return errors.New("range tombstones can not be ingested")
+	}
+	for i, offset := range index.Offsets {
+		offset.Key = y.KeyWithTs(y.ParseKey(offset.Key), ing.seq)
+		offset.ExtentID = ing.pending[i].ExtentID
+		offset.Offset = ing.pending[i].Offset
+	}
+	index.GlobalSeq = ing.seq
+	index.VersionTimes = rp.getVersionTimes()
+
+	rp.rowLock.RLock()
+	extentID, offset, err := table.WriteTableIndex(rp.rowStream, index, ing.head.extentID, ing.head.offset, ing.seq)
+	var tbl *table.Table
+	if err == nil {
+		tbl, err = rp.openTable(extentID, offset)
+	}
+	if err == nil {
+		ing.lock.Lock()
+		ing.tables = append(ing.tables, tbl)
+		ing.pending = nil
+		ing.lock.Unlock()
+	}
+	rp.rowLock.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	//the table is dropped by Abort if it is not good
+	if err = checkIngestTable(tbl); err != nil {
+		return err
+	}
+	smallest, biggest := y.ParseKey(tbl.Smallest()), y.ParseKey(tbl.Biggest())
+	if !rp.InRange(smallest) || !rp.InRange(biggest) {
+		return errors.Errorf("keys [%q, %q] are not in partition %d", smallest, biggest, rp.PartID)
+	}
+	if ing.last != nil && bytes.Compare(smallest, ing.last) <= 0 {
+		return errors.Errorf("table from %q is not sorted", smallest)
+	}
+	ing.last = y.SafeCopy(ing.last, biggest)
+	return nil
+}
+
+//checkIngestTable scans all blocks of tbl, value pointers point to log streams of
+//other partitions, and versions of a key become the same after ingest
+func checkIngestTable(tbl *table.Table) error {
+	it := tbl.NewIterator(false)
+	defer it.Close()
+	var last []byte
+	for it.Rewind(); it.Valid(); it.Next() {
+		key := y.ParseKey(it.Key())
+		if last != nil && bytes.Compare(key, last) <= 0 {
+			return errors.Errorf("key %q has more than one version", key)
+		}
+		if it.Value().Meta&(y.BitValuePointer|y.BitBlobPointer) > 0 {
+			return errors.Errorf("value of %q is a value pointer", key)
+		}
+		last = y.SafeCopy(last, key)
+	}
+	return nil
+}
+
+//liveExtents adds extents of tables and blocks of the ingest to live
+func (ing *Ingest) liveExtents(live map[uint64]bool) {
+	ing.lock.Lock()
+	defer ing.lock.Unlock()
+	for _, t := range ing.tables {
+		for _, extentID := range t.Extents() {
+			live[extentID] = true
+		}
+	}
+	for _, offset := range ing.pending {
+		live[offset.ExtentID] = true
+	}
+}
+
+//Finish attaches the tables to the partition, it returns the seqNumber which
+//reads see all keys at, and the number of tables and blocks. Reads at older
+//versions may see them after Finish returns. Watchers do not get ingested keys
+func (ing *Ingest) Finish() (uint64, int, uint64, error) {
+	rp := ing.rp
+	if len(ing.pending) > 0 {
+		ing.Abort()
+		return 0, 0, 0, errors.Errorf("%d blocks are added without index", len(ing.pending))
+	}
+	if len(ing.tables) == 0 {
+		ing.Abort()
+		return atomic.LoadUint64(&rp.commitSeq), 0, 0, nil
+	}
+
+	//the request comes out of writeCh after all writes before it
+	rp.seqLock.Lock()
+	if atomic.LoadInt32(&rp.blockWrites) == 1 {
+		rp.seqLock.Unlock()
+		ing.Abort()
+		return 0, 0, 0, ErrBlockedWrites
+	}
+	req := requestPool.Get().(*request)
+	req.reset()
+	req.seq = atomic.AddUint64(&rp.seqNumber, 1)
+	req.tables = ing.tables
+	req.wg.Add(1)
+	req.IncrRef()
+	rp.writeCh <- req
+	rp.seqLock.Unlock()
+
+	seq := req.seq
+	err := req.Wait()
+	//the tables are in rp.tables now
+	rp.obsoleteLock.Lock()
+	delete(rp.ingests, ing)
+	rp.obsoleteLock.Unlock()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return seq, len(ing.tables), ing.blocks, nil
+}
+
+//Abort drops the tables and blocks added by the ingest, their extents are reclaimed later
+func (ing *Ingest) Abort() {
+	rp := ing.rp
+	rp.obsoleteLock.Lock()
+	delete(rp.ingests, ing)
+	rp.obsoleteLock.Unlock()
+	for _, t := range ing.tables {
+		t.DecrRef()
+	}
+	ing.tables = nil
+	ing.pending = nil
+	rp.triggerReclaim()
+}
+
+//attachTables is called by the write loop, so all writes before req.seq are in
+//memtable when the tables become visible at req.seq
+func (rp *RangePartition) attachTables(req *request) {
+	//versions in memtable before the ingest are older than the tables, set it before
+	//the tables are visible
+	if ing := req.tables[0].LastSeq; ing > atomic.LoadUint64(&rp.maxTableSeq) {
+		atomic.StoreUint64(&rp.maxTableSeq, ing)
+	}
+
+	rp.tableLock.Lock()
+	rp.tables = append(rp.tables, req.tables...)
+	var tableLocs []*pspb.Location
+	for _, t := range rp.tables {
+		tableLocs = append(tableLocs, &t.Loc)
+	}
+	rp.updateTableLocs(tableLocs)
+	rp.tableLock.Unlock()
+
+	if req.seq > atomic.LoadUint64(&rp.commitSeq) {
+		atomic.StoreUint64(&rp.commitSeq, req.seq)
+	}
+	rp.triggerCompact()
+	req.wg.Done()
+}
@@ -0,0 +1,129 @@
+package rangepartition
+
+import (
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/rangepartition/table"
+	"github.com/journeymidnight/autumn/streamclient"
+	"github.com/journeymidnight/autumn/xlog"
+)
+
+//headTable returns the newest table whose value pointer is in log stream, log
+//stream before it is in tables. Tables of a merged partition, or tables of the
+//parent after split, point to other log streams. nil means no table points to
+//log stream, it is replayed from the start
+func headTable(tables []*table.Table, logExtents []uint64) *table.Table {
+	inLog := make(map[uint64]bool, len(logExtents))
+	for _, extentID := range logExtents {
+		inLog[extentID] = true
+	}
+	var head *table.Table
+	for _, t := range tables {
+		if inLog[t.VpExtentID] && (head == nil || t.LastSeq > head.LastSeq) {
+			head = t
+		}
+	}
+	return head
+}
+
+//mergedStreams returns copies of log and row streams of merged partitions
+func (rp *RangePartition) mergedStreams() (map[uint64]streamclient.StreamClient, map[uint64]streamclient.StreamClient) {
+	rp.mergedLock.Lock()
+	defer rp.mergedLock.Unlock()
+	logs := make(map[uint64]streamclient.StreamClient, len(rp.mergedLogs))
+	for id, s := range rp.mergedLogs {
+		logs[id] = s
+	}
+	rows := make(map[uint64]streamclient.StreamClient, len(rp.mergedRows))
+	for id, s := range rp.mergedRows {
+		rows[id] = s
+	}
+	return logs, rows
+}
+
+func (rp *RangePartition) hasMergedRows() bool {
+	rp.mergedLock.Lock()
+	defer rp.mergedLock.Unlock()
+	return len(rp.mergedRows) > 0
+}
+
+//replayMergedLogs moves live values in log streams of merged partitions to log
+//stream, like gc does, then the merged logs are dropped
+func (rp *RangePartition) replayMergedLogs() error {
+	logs, _ := rp.mergedStreams()
+	for id, stream := range logs {
+		count, moved, err := rp.moveValues(stream, func(uint64) bool { return true })
+		if err != nil {
+			return err
+		}
+		xlog.Logger.Infof("partition %d: moved %d of %d entries from merged log stream %d", rp.PartID, moved, count, id)
+
+		//compaction counts stale values of the merged log too
+		rp.discard.Remove(stream.ExtentIDs())
+		if err = rp.dropMerged([]uint64{id}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//reclaimMergedRows drops row streams of merged partitions which have no live extents
+func (rp *RangePartition) reclaimMergedRows(rows map[uint64]streamclient.StreamClient, live map[uint64]bool) {
+	var dropped []uint64
+	for id, stream := range rows {
+		used := false
+		for _, extentID := range stream.ExtentIDs() {
+			if live[extentID] {
+				used = true
+				break
+			}
+		}
+		if !used {
+			dropped = append(dropped, id)
+		}
+	}
+	if len(dropped) == 0 {
+		return
+	}
+	if err := rp.dropMerged(nil, dropped); err != nil {
+		xlog.Logger.Warnf("partition %d: failed to drop merged row streams: %v", rp.PartID, err)
+	}
+}
+
+//dropMerged removes merged streams from PM, then deletes them. A stream which
+//fails to be deleted is left in SM, PM does not know it any more
+func (rp *RangePartition) dropMerged(logs []uint64, rows []uint64) error {
+	rp.mergedLock.Lock()
+	defer rp.mergedLock.Unlock()
+
+	dropped := make(map[uint64]bool)
+	for _, id := range append(append([]uint64{}, logs...), rows...) {
+		dropped[id] = true
+	}
+	merged := &pspb.MergedStreams{}
+	for id := range rp.mergedLogs {
+		if !dropped[id] {
+			merged.Logs = append(merged.Logs, id)
+		}
+	}
+	for id := range rp.mergedRows {
+		if !dropped[id] {
+			merged.Rows = append(merged.Rows, id)
+		}
+	}
+	if err := rp.pmClient.SetMergedStreams(rp.PartID, merged); err != nil {
+		return err
+	}
+
+	for id := range dropped {
+		delete(rp.mergedLogs, id)
+		delete(rp.mergedRows, id)
+		if rp.deleteStream == nil {
+			continue
+		}
+		if err := rp.deleteStream(id); err != nil {
+			xlog.Logger.Warnf("partition %d: failed to delete merged stream %d: %v", rp.PartID, id, err)
+		}
+	}
+	xlog.Logger.Infof("partition %d: dropped merged log streams %v, row streams %v", rp.PartID, logs, rows)
+	return nil
+}
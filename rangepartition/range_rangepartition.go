@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 	"unsafe"
 
+	"github.com/dgraph-io/ristretto"
 	"github.com/dgryski/go-farm"
 	"github.com/journeymidnight/autumn/manager/pmclient"
 	"github.com/journeymidnight/autumn/proto/pb"
@@ -19,6 +21,7 @@ import (
 	"github.com/journeymidnight/autumn/rangepartition/y"
 	"github.com/journeymidnight/autumn/streamclient"
 	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
 	"github.com/journeymidnight/autumn/xlog"
 	"github.com/pkg/errors"
 )
@@ -33,12 +36,15 @@ const (
 	//64 for production
 	//16 for test
 	writeChCapacity = 64
+	//a write request takes at most half of an empty memtable
+	maxBatchSize = maxSkipList / 2
 )
 
 var (
 	errNoRoom         = errors.New("No room for write")
-	errNotFound       = errors.New("not found")
+	ErrNotFound       = wire_errors.NotFound
 	ErrBlockedWrites  = errors.New("Writes are blocked, possibly due to DropAll or Close")
+	ErrBatchTooBig    = errors.New("batch is too big for memtable")
 	maxEntriesInQueue = maxSkipList / (y.ValueThrottle + 20) / 2
 )
 
@@ -65,9 +71,41 @@ type RangePartition struct {
 	flushChan      chan flushTask
 	writeStopper   *utils.Stopper
 	compactStopper *utils.Stopper
+	gcStopper      *utils.Stopper
+	compactCh      chan struct{} //wake up compaction after memtable is flushed
+	compactStats   compactStats
 	tableLock      utils.SafeMutex //protect tables
 	tables         []*table.Table
+	blockCache     *ristretto.Cache          //shared by all partitions of a PS, nil means no cache
+	compression    int32                     //pspb.CompressionType of new tables, atomic
+	prefixBloomLen uint32                    //length of key prefixes in bloom filters of new tables, atomic
+	obsoleteLock   utils.SafeMutex           //protect obsolete, ingests
+	obsolete       map[*table.Table]struct{} //compacted tables which are still being read
+	ingests        map[*Ingest]struct{}      //ingests which are not finished, their tables are not attached yet
+	rowLock        utils.SafeMutex           //tables being built hold RLock, reclaimRowStream holds Lock
+	reclaimCh      chan struct{}             //wake up compaction to reclaim rowStream
+	rangeDelLock   utils.SafeMutex           //protect rangeDels
+	rangeDels      []rangeDelete             //range tombstones in memtables and tables
+	maxVersions    uint32                    //versions of a key kept by compaction, atomic
+	retentionSecs  int64                     //versions newer than it are kept by compaction too, atomic
+	versionLock    utils.SafeMutex           //protect versionTimes
+	versionTimes   []*pspb.VersionTime       //when seqNumbers were flushed, ordered by seq
 	seqNumber      uint64
+	commitSeq      uint64     //all writes <= commitSeq are in memtable, reads never see newer versions
+	maxTableSeq    uint64     //tables may be newer than versions <= maxTableSeq in memtable, e.g. ingested ones
+	seqLock        sync.Mutex //keep the order of requests in writeCh the same as their seqNumbers
+	counters       counters
+	watchers       watchers
+
+	mergedLock   sync.Mutex                           //protect mergedLogs, mergedRows
+	mergedLogs   map[uint64]streamclient.StreamClient //log streams of merged partitions, values in them are moved by gc
+	mergedRows   map[uint64]streamclient.StreamClient //row streams of merged partitions, tables in them are rewritten by compaction
+	deleteStream func(streamID uint64) error
+
+	blobLock      sync.Mutex //serialise appends to blobStream
+	blobStreams   []uint64   //all blob streams which may have values of this partition
+	blobStream    streamclient.StreamClient
+	blobThreshold uint32 //values bigger than it are written to blobStream, 0 means never
 
 	PartID   uint64
 	StartKey []byte
@@ -83,11 +121,30 @@ type RangePartition struct {
 //TODO
 //interface KV save some values
 
+//OpenOption has optional settings of OpenRangePartition, the zero value means defaults
+type OpenOption struct {
+	BlobStreams []uint64           //all blob streams which may have values of this partition
+	Discard     *pspb.DiscardStats //discard stats saved by the last run
+	BlockCache  *ristretto.Cache   //shared by all partitions of a PS, nil means no cache
+
+	//they are set before compaction starts, see SetCompression, SetPrefixBloom and SetRetention
+	Compression      pspb.CompressionType
+	PrefixBloomLen   uint32
+	MaxVersions      uint32
+	VersionRetention time.Duration
+
+	//streams of partitions merged into this one, they are deleted by DeleteStream
+	//when they are not read any more
+	MergedLogs   map[uint64]streamclient.StreamClient
+	MergedRows   map[uint64]streamclient.StreamClient
+	DeleteStream func(streamID uint64) error
+}
+
 func OpenRangePartition(id uint64, rowStream streamclient.StreamClient,
 	logStream streamclient.StreamClient, blockReader streamclient.BlockReader,
-	startKey []byte, endKey []byte, tableLocs []*pspb.Location, blobStreams []uint64,
+	startKey []byte, endKey []byte, tableLocs []*pspb.Location,
 	pmclient pmclient.PMClient,
-	openStream OpenStreamFunc, updateStream UpdateStreamFunc,
+	openStream OpenStreamFunc, updateStream UpdateStreamFunc, opt OpenOption,
 ) *RangePartition {
 	rp := &RangePartition{
 		rowStream:    rowStream,
@@ -103,7 +160,26 @@ func OpenRangePartition(id uint64, rowStream streamclient.StreamClient,
 		PartID:       id,
 		openStream:   openStream,
 		updateStream: updateStream,
+		blobStreams:  opt.BlobStreams,
+		blockCache:   opt.BlockCache,
+		compactCh:    make(chan struct{}, 1),
+		reclaimCh:    make(chan struct{}, 1),
+		obsolete:     make(map[*table.Table]struct{}),
+		ingests:      make(map[*Ingest]struct{}),
+		discard:      newDiscardManager(opt.Discard),
+		mergedLogs:   make(map[uint64]streamclient.StreamClient),
+		mergedRows:   make(map[uint64]streamclient.StreamClient),
+		deleteStream: opt.DeleteStream,
+	}
+	for id, s := range opt.MergedLogs {
+		rp.mergedLogs[id] = s
+	}
+	for id, s := range opt.MergedRows {
+		rp.mergedRows[id] = s
 	}
+	rp.SetCompression(opt.Compression)
+	rp.SetPrefixBloom(opt.PrefixBloomLen)
+	rp.SetRetention(opt.MaxVersions, opt.VersionRetention)
 	rp.startMemoryFlush()
 
 	//replay log
@@ -111,26 +187,31 @@ func OpenRangePartition(id uint64, rowStream streamclient.StreamClient,
 	//tableLocs的顺序就是在logStream里面的顺序
 	for _, tLoc := range tableLocs {
 	retry:
-		tbl, err := table.OpenTable(rp.rowStream, tLoc.ExtentID, tLoc.Offset)
+		tbl, err := rp.openTable(tLoc.ExtentID, tLoc.Offset)
 		if err != nil {
 			xlog.Logger.Error(err)
 			time.Sleep(1 * time.Second)
 			goto retry
 		}
 		rp.tables = append(rp.tables, tbl)
+		for _, d := range tbl.RangeDeletes() {
+			rp.addRangeDelete(d.Start, d.End, d.Seq)
+		}
+		rp.addVersionTimes(tbl.VersionTimes())
 	}
 
 	//search all tables, find the table who has the most latest seqNum
 	seq := uint64(0)
-	var lastTable *table.Table
 	for _, table := range rp.tables {
 		fmt.Printf("read table from [%s] to [%s]: seq[%d], vp[%d]\n", y.ParseKey(table.Smallest()), y.ParseKey(table.Biggest()), table.LastSeq, table.VpOffset)
 		if table.LastSeq > seq {
 			seq = table.LastSeq
-			lastTable = table
 		}
 	}
+	//tables of merged partitions may be newer, but they point to other logs
+	lastTable := headTable(rp.tables, rp.logStream.ExtentIDs())
 	rp.seqNumber = seq
+	rp.maxTableSeq = seq
 
 	//FIXME:poor performace: prefetch read will be better
 	replayedLog := 0
@@ -142,26 +223,19 @@ func OpenRangePartition(id uint64, rowStream streamclient.StreamClient,
 
 		head := valuePointer{extentID: ei.ExtentID, offset: ei.Offset}
 
-		if y.ParseTs(ei.Log.Key) > rp.seqNumber {
-			rp.seqNumber++
+		//failed conditions and ingests use seqNumbers which are not in log
+		if ts := y.ParseTs(ei.Log.Key); ts > rp.seqNumber {
+			rp.seqNumber = ts
 		}
 
-		i := 0
 		/*
 		*
 		* 有一种特殊情况, block里面有3个entries, 其中第3个entry
 		* 放不到mt里面,会强制刷memtable, 而这个table的vp, 指向block的offset
 		* 并且刷出来的table只有前2个entry, 总之结果就是有2个table有overlap的key的情况
 		 */
-		for err := rp.ensureRoomForWrite(entriesReady, head); err == errNoRoom; err = rp.ensureRoomForWrite(entriesReady, head) {
-			i++
-			if i%100 == 0 {
-				xlog.Logger.Infof("Making room for writes")
-			}
-			// We need to poll a bit because both hasRoomForWrite and the flusher need access to s.imm.
-			// When flushChan is full and you are blocked there, and the flusher is trying to update s.imm,
-			// you will get a deadlock.
-			time.Sleep(10 * time.Millisecond)
+		if err := rp.makeRoomForWrite(entriesReady, head); err != nil {
+			return false, err
 		}
 		/*
 			if len(ei.Log.Key) == 0 {
@@ -186,57 +260,14 @@ func OpenRangePartition(id uint64, rowStream streamclient.StreamClient,
 		replayLog(rp.logStream, lastTable.VpExtentID, lastTable.VpOffset, true, replay)
 	}
 	fmt.Printf("replayed log number: %d\n", replayedLog)
+	rp.commitSeq = rp.seqNumber
 
 	//start real write
 	rp.startWriteLoop()
 
-	//do compactions:FIXME, doCompactions放到另一个goroutine里面执行
-	var tbls []*table.Table
-	rp.tableLock.RLock()
-	for _, t := range rp.tables {
-		t.IncrRef()
-		tbls = append(tbls, t)
-	}
-	rp.tableLock.RUnlock()
-
-	if len(tbls) <= 1 {
-		return rp
-	}
-
-	rp.doCompact(tbls, true)
-
-	//remove tbls from rp.tables, and update etcd,
-	rp.tableLock.Lock()
-	var i, j int
-	var newTables []*table.Table
-	for i < len(tbls) && j < len(rp.tables) {
-		if tbls[i].LastSeq == rp.tables[j].LastSeq {
-			i++
-			j++ //同时存在
-		} else if tbls[i].LastSeq < rp.tables[j].LastSeq {
-			i++ //只在tbls存在
-		} else {
-			newTables = append(newTables, rp.tables[j])
-			rp.tables[j].IncrRef()
-			j++ //只在rp.tables存在
-		}
-	}
-	for ; j < len(rp.tables); j++ {
-		newTables = append(newTables, rp.tables[j])
-		rp.tables[j].IncrRef()
-	}
-
-	tableLocs = nil
-	for _, t := range newTables {
-		tableLocs = append(tableLocs, &t.Loc)
-	}
-	rp.updateTableLocs(tableLocs)
-
-	for _, t := range rp.tables {
-		t.DecrRef()
-	}
-	rp.tables = newTables
-	rp.tableLock.Unlock()
+	//tables left by the last run are compacted in background
+	rp.startCompact()
+	rp.startGC()
 
 	return rp
 }
@@ -245,8 +276,8 @@ type flushTask struct {
 	mt        *skiplist.Skiplist
 	vptr      valuePointer
 	seqNum    uint64
-	isCompact bool          //如果是compact任务, 不需要修改rp.mt
-	resultCh  chan struct{} //也可以用wg, 但是防止未来还需要发数据
+	isCompact bool              //如果是compact任务, 不需要修改rp.mt
+	resultCh  chan *table.Table //table built for a compact task, nil if mt is empty
 }
 
 func (rp *RangePartition) startWriteLoop() {
@@ -266,16 +297,41 @@ func (rp *RangePartition) startMemoryFlush() {
 }
 
 // handleFlushTask must be run serially.
-func (rp *RangePartition) handleFlushTask(ft flushTask) error {
+func (rp *RangePartition) handleFlushTask(ft flushTask) (*table.Table, error) {
 	// There can be a scenario, when empty memtable is flushed. For example, memtable is empty and
 	// after writing request to value log, rotation count exceeds db.LogRotatesToFlush.
 	if ft.mt.Empty() {
-		return nil
+		return nil, nil
 	}
 
-	iter := ft.mt.NewIterator()
+	//extents written by b must not be reclaimed before tbl is in rp.tables
+	rp.rowLock.RLock()
+	defer rp.rowLock.RUnlock()
+
+	//versions in a memtable are not newer than the time it is flushed
+	if !ft.isCompact {
+		rp.addVersionTimes([]*pspb.VersionTime{{Seq: ft.seqNum, UnixTime: time.Now().Unix()}})
+	}
+	tbl, err := rp.buildTable(ft.mt, ft.vptr, ft.seqNum)
+	if err != nil {
+		return nil, err
+	}
+
+	// We own a ref on tbl.
+
+	rp.tableLock.Lock()
+	rp.tables = append(rp.tables, tbl)
+	rp.tableLock.Unlock()
+
+	return tbl, nil
+}
+
+//buildTable writes mt to rowStream and opens it, the caller holds rowLock.RLock
+//until the table is in rp.tables. Log stream before vptr is in tables
+func (rp *RangePartition) buildTable(mt *skiplist.Skiplist, vptr valuePointer, seqNum uint64) (*table.Table, error) {
+	iter := mt.NewIterator()
 	defer iter.Close()
-	b := table.NewTableBuilder(rp.rowStream)
+	b := table.NewTableBuilder(rp.rowStream, rp.Compression(), atomic.LoadUint32(&rp.prefixBloomLen))
 	defer b.Close()
 
 	//var vp valuePointer
@@ -295,30 +351,53 @@ func (rp *RangePartition) handleFlushTask(ft flushTask) error {
 		b.Add(iter.Key(), iter.Value())
 		last = iter.Key()
 	}
+	b.SetVersionTimes(rp.getVersionTimes())
 
 	b.FinishBlock()
-	id, offset, err := b.FinishAll(ft.vptr.extentID, ft.vptr.offset, ft.seqNum)
+	id, offset, err := b.FinishAll(vptr.extentID, vptr.offset, seqNum)
 	if err != nil {
 		xlog.Logger.Errorf("ERROR while build table: %v", err)
-		return err
+		return nil, err
 	}
+	rp.counters.build(b.BlockSizes())
 
 	//todo
-	tbl, err := table.OpenTable(rp.rowStream, id, offset)
+	tbl, err := rp.openTable(id, offset)
 	if err != nil {
 		xlog.Logger.Errorf("ERROR while opening table: %v", err)
-		return err
+		return nil, err
 	}
 
-	// We own a ref on tbl.
+	xlog.Logger.Debugf("flushed table %s to %s seq[%d], head %d\n", y.ParseKey(first), y.ParseKey(last), tbl.LastSeq, tbl.VpOffset)
 
-	rp.tableLock.Lock()
-	rp.tables = append(rp.tables, tbl)
-	rp.tableLock.Unlock()
+	return tbl, nil
+}
 
-	xlog.Logger.Debugf("flushed table %s to %s seq[%d], head %d\n", y.ParseKey(first), y.ParseKey(last), tbl.LastSeq, tbl.VpOffset)
+//SetCompression sets the compression of tables built later, existing tables
+//are read as they are
+func (rp *RangePartition) SetCompression(c pspb.CompressionType) {
+	atomic.StoreInt32(&rp.compression, int32(c))
+}
 
-	return nil
+func (rp *RangePartition) Compression() pspb.CompressionType {
+	return pspb.CompressionType(atomic.LoadInt32(&rp.compression))
+}
+
+//SetPrefixBloom makes tables built later have bloom filters of the first n bytes
+//of user keys, Range with a prefix not shorter than n skips tables without it.
+//0 means no prefix bloom filter
+func (rp *RangePartition) SetPrefixBloom(n uint32) {
+	atomic.StoreUint32(&rp.prefixBloomLen, n)
+}
+
+//openTable opens the table in rowStream, blocks are cached in blockCache
+func (rp *RangePartition) openTable(extentID uint64, offset uint32) (*table.Table, error) {
+	tbl, err := table.OpenTable(rp.rowStream, extentID, offset)
+	if err != nil {
+		return nil, err
+	}
+	tbl.Cache = rp.blockCache
+	return tbl, nil
 }
 
 func (rp *RangePartition) getMemTables() ([]*skiplist.Skiplist, func()) {
@@ -353,8 +432,10 @@ func (rp *RangePartition) flushMemtable() {
 			continue
 		}
 		//save to rowStream
+		var tbl *table.Table
 		for {
-			err := rp.handleFlushTask(ft)
+			var err error
+			tbl, err = rp.handleFlushTask(ft)
 			if err == nil {
 				if !ft.isCompact {
 					// Update s.imm. Need a lock.
@@ -384,7 +465,9 @@ func (rp *RangePartition) flushMemtable() {
 		rp.tableLock.RUnlock()
 
 		if ft.isCompact {
-			ft.resultCh <- struct{}{}
+			ft.resultCh <- tbl
+		} else {
+			rp.triggerCompact()
 		}
 
 	}
@@ -421,6 +504,9 @@ type request struct {
 	// Input values, 这个以后可能有多个Entry..., 比如一个request里面
 	//A = "x", A:time = "y", A:md5 = "asdfasdf"
 	entries []*pb.EntryInfo
+	seq     uint64 //seqNumber of the last entry, 0 if entries keep their versions
+	cond    *Condition
+	tables  []*table.Table //tables of an ingest, they are attached at seq instead of writing entries
 
 	// Output values and wait group stuff below
 	wg  sync.WaitGroup
@@ -445,7 +531,8 @@ func estimatedSizeInSkl(e *pb.Entry) int {
 
 func estimatedVS(key []byte, vs y.ValueStruct) int {
 	sz := len(key)
-	if vs.Meta&y.BitValuePointer == 0 && len(vs.Value) <= y.ValueThrottle {
+	//compaction may copy big values into tables
+	if vs.Meta&y.BitValuePointer == 0 {
 		sz += len(vs.Value) + 2 // Meta, UserMeta
 	} else {
 		sz += int(vptrSize) + 2 // vptrSize for valuePointer, 2 for metas.
@@ -467,6 +554,9 @@ func (r *request) Size() int {
 
 func (req *request) reset() {
 	req.entries = nil
+	req.seq = 0
+	req.cond = nil
+	req.tables = nil
 	req.wg = sync.WaitGroup{}
 	req.Err = nil
 	req.ref = 0
@@ -508,6 +598,23 @@ func (rp *RangePartition) writeRequests(reqs []*request) error {
 		return nil
 	}
 
+	//requests before an ingest are written before its tables are attached,
+	//those after it check their conditions against the tables
+	for i, req := range reqs {
+		if req.tables != nil {
+			err := rp.writeRequests(reqs[:i])
+			rp.attachTables(req)
+			if nextErr := rp.writeRequests(reqs[i+1:]); err == nil {
+				err = nextErr
+			}
+			return err
+		}
+	}
+
+	if reqs = rp.checkConditions(reqs); len(reqs) == 0 {
+		return nil
+	}
+
 	done := func(err error) {
 		for _, r := range reqs {
 			r.Err = err
@@ -517,7 +624,7 @@ func (rp *RangePartition) writeRequests(reqs []*request) error {
 
 	xlog.Logger.Debugf("writeRequests called. Writing to log, len[%d]", len(reqs))
 
-	entriesReady, head, err := rp.writeValueLog(reqs)
+	_, head, err := rp.writeValueLog(reqs)
 	if err != nil {
 		done(err)
 		return err
@@ -528,31 +635,27 @@ func (rp *RangePartition) writeRequests(reqs []*request) error {
 	//seqNum := y.ParseTs(e.Log.Key)
 
 	xlog.Logger.Info("Writing to memtable")
-	i := 0
-	for err = rp.ensureRoomForWrite(entriesReady, rp.vhead); err == errNoRoom; err = rp.ensureRoomForWrite(entriesReady, rp.vhead) {
-		i++
-		if i%100 == 0 {
-			xlog.Logger.Infof("Making room for writes")
-		}
-		// We need to poll a bit because both hasRoomForWrite and the flusher need access to s.imm.
-		// When flushChan is full and you are blocked there, and the flusher is trying to update s.imm,
-		// you will get a deadlock.
-		time.Sleep(10 * time.Millisecond)
-	}
-
-	if err != nil {
-		done(err)
-		return errors.Wrap(err, "writeRequests")
-	}
 
-	//write to LSM
+	//write to LSM, requests may not fit in one memtable all together
 	for _, b := range reqs {
+		if err = rp.makeRoomForWrite(b.entries, rp.vhead); err != nil {
+			done(err)
+			return errors.Wrap(err, "writeRequests")
+		}
 		if err := rp.writeToLSM(b.entries); err != nil {
 			done(err)
 			return errors.Wrap(err, "writeRequests")
 		}
 	}
 
+	//reqs are in the order of seqNumber, publish them after all entries are in memtable
+	for _, b := range reqs {
+		if b.seq > atomic.LoadUint64(&rp.commitSeq) {
+			atomic.StoreUint64(&rp.commitSeq, b.seq)
+		}
+	}
+	rp.publish(reqs)
+
 	rp.vhead = head
 	done(nil)
 	return nil
@@ -564,11 +667,18 @@ func getLowerByte(a uint32) byte {
 
 func (rp *RangePartition) writeToLSM(entries []*pb.EntryInfo) error {
 	for _, entry := range entries {
+		if entry.Log.Meta&uint32(y.BitRangeDelete) > 0 {
+			rp.addRangeDelete(y.ParseKey(entry.Log.Key), entry.Log.Value, y.ParseTs(entry.Log.Key))
+		}
 		if y.ShouldWriteValueToLSM(entry.Log) { // Will include deletion / tombstone case.
+			meta := getLowerByte(entry.Log.Meta)
+			if meta&y.BitBlobPointer > 0 {
+				meta |= y.BitValuePointer
+			}
 			rp.mt.Put(entry.Log.Key,
 				y.ValueStruct{
 					Value:     entry.Log.Value,
-					Meta:      getLowerByte(entry.Log.Meta),
+					Meta:      meta,
 					UserMeta:  getLowerByte(entry.Log.UserMeta),
 					ExpiresAt: entry.Log.ExpiresAt,
 				})
@@ -686,6 +796,33 @@ func (rp *RangePartition) doWrites() {
 	}
 }
 
+//makeRoomForWrite waits till the memtable has room for entries
+func (rp *RangePartition) makeRoomForWrite(entries []*pb.EntryInfo, head valuePointer) error {
+	i := 0
+	err := rp.ensureRoomForWrite(entries, head)
+	for ; err == errNoRoom; err = rp.ensureRoomForWrite(entries, head) {
+		i++
+		if i%100 == 0 {
+			xlog.Logger.Infof("Making room for writes")
+		}
+		// We need to poll a bit because both hasRoomForWrite and the flusher need access to s.imm.
+		// When flushChan is full and you are blocked there, and the flusher is trying to update s.imm,
+		// you will get a deadlock.
+		time.Sleep(10 * time.Millisecond)
+	}
+	return err
+}
+
+//batchTooBig returns true if entries of one request may not fit in a memtable,
+//keys of entries do not have seqNumbers yet
+func batchTooBig(entries []*pb.EntryInfo) bool {
+	n := 0
+	for _, e := range entries {
+		n += estimatedSizeInSkl(e.Log) + 8
+	}
+	return n > maxBatchSize
+}
+
 func (rp *RangePartition) ensureRoomForWrite(entries []*pb.EntryInfo, head valuePointer) error {
 
 	rp.Lock()
@@ -697,7 +834,10 @@ func (rp *RangePartition) ensureRoomForWrite(entries []*pb.EntryInfo, head value
 		n += int64(estimatedSizeInSkl(entries[i].Log))
 	}
 
-	utils.AssertTrue(n <= maxSkipList)
+	//requests are checked by batchTooBig before they are written
+	if n > maxSkipList {
+		return ErrBatchTooBig
+	}
 
 	if !forceFlush && rp.mt.MemSize()+n < maxSkipList {
 		return nil
@@ -728,7 +868,9 @@ func (rp *RangePartition) ensureRoomForWrite(entries []*pb.EntryInfo, head value
 	}
 }
 
-func (rp *RangePartition) newIterator() y.Iterator {
+//newIterator merges memtables and tables which may have user keys in [lower, upper)
+//with the prefix, empty upper means no upper bound
+func (rp *RangePartition) newIterator(reversed bool, lower, upper, prefix []byte) y.Iterator {
 	//prefix不包括seqnum
 	//FIXME: 是否实现prefetch?
 
@@ -739,15 +881,19 @@ func (rp *RangePartition) newIterator() y.Iterator {
 
 	//memtable iters
 	for i := 0; i < len(mts); i++ {
-		iters = append(iters, mts[i].NewUniIterator(false))
+		iters = append(iters, mts[i].NewUniIterator(reversed))
 	}
 
 	rp.tableLock.RLock()
 	for i := len(rp.tables) - 1; i >= 0; i-- {
-		iters = append(iters, rp.tables[i].NewIterator(false))
+		t := rp.tables[i]
+		if !t.Overlaps(lower, upper) || t.DoesNotHavePrefix(prefix) {
+			continue
+		}
+		iters = append(iters, t.NewIterator(reversed))
 	}
 	rp.tableLock.RUnlock()
-	return table.NewMergeIterator(iters, false)
+	return table.NewMergeIterator(iters, reversed)
 }
 
 func (rp *RangePartition) getTablesForKey(userKey []byte) ([]*table.Table, func()) {
@@ -776,47 +922,188 @@ func (rp *RangePartition) getTablesForKey(userKey []byte) ([]*table.Table, func(
 	}
 }
 
-func (rp *RangePartition) Range(prefix []byte, start []byte, limit uint32) [][]byte {
-	iter := rp.newIterator()
-	defer iter.Close()
-	var out [][]byte
-	var skipKey []byte //note:包括seqnum
-	startTs := y.KeyWithTs(start, atomic.LoadUint64(&rp.seqNumber))
-	//readTs: 是否以后支持readTs:如果version比readTS大, 则忽略这个版本
-	for iter.Seek(startTs); iter.Valid() && uint32(len(out)) < limit; iter.Next() {
-		if !bytes.HasPrefix(iter.Key(), prefix) {
-			break
+//RangeOption describes a scan over [Start, End) whose keys have Prefix
+type RangeOption struct {
+	Prefix   []byte
+	Start    []byte //inclusive
+	End      []byte //exclusive, empty means no upper bound
+	Continue []byte //resume strictly after this key in the scan direction
+	Limit    uint32 //0 means no limit
+	Reverse  bool
+	Values   bool   //also return values, value pointers are resolved by blockReader
+	Version  uint64 //read at this seqNumber, 0 means the latest
+}
+
+type RangeResult struct {
+	Keys   [][]byte
+	Values [][]byte //only set if RangeOption.Values
+	//Truncated is true if there are more keys, pass Continue in the next RangeOption to get them
+	Truncated bool
+	Continue  []byte
+}
+
+//prefixEnd returns the smallest key which is bigger than all keys having the prefix,
+//nil means no such key
+func prefixEnd(prefix []byte) []byte {
+	end := y.Copy(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
 		}
-		if len(skipKey) > 0 {
-			if y.SameKey(iter.Key(), skipKey) {
-				continue
-			} else {
-				skipKey = skipKey[:0] //reset
-			}
+	}
+	return nil
+}
+
+//bounds returns [lower, upper) of the scan, clamped to the partition's range
+func (rp *RangePartition) bounds(opt RangeOption) ([]byte, []byte) {
+	lower := opt.Start
+	if bytes.Compare(opt.Prefix, lower) > 0 {
+		lower = opt.Prefix
+	}
+	if bytes.Compare(rp.StartKey, lower) > 0 {
+		lower = rp.StartKey
+	}
+
+	upper := opt.End
+	minUpper := func(k []byte) {
+		if len(k) > 0 && (len(upper) == 0 || bytes.Compare(k, upper) < 0) {
+			upper = k
 		}
-		skipKey = y.SafeCopy(skipKey, iter.Key())
+	}
+	minUpper(prefixEnd(opt.Prefix))
+	minUpper(rp.EndKey)
+	return lower, upper
+}
 
-		vs := iter.Value()
+//readTs returns the seqNumber which reads see, version 0 or any version bigger
+//than commitSeq reads at commitSeq
+func (rp *RangePartition) readTs(version uint64) uint64 {
+	commitSeq := atomic.LoadUint64(&rp.commitSeq)
+	if version == 0 || version > commitSeq {
+		return commitSeq
+	}
+	return version
+}
 
-		if isDeletedOrExpired(vs.Meta, vs.ExpiresAt) {
-			continue
+func (rp *RangePartition) Range(opt RangeOption) (*RangeResult, error) {
+	atomic.AddUint64(&rp.counters.reads, 1)
+	readTs := rp.readTs(opt.Version)
+	lower, upper := rp.bounds(opt)
+	if opt.Limit == 0 {
+		opt.Limit = math.MaxUint32
+	}
+
+	iter := rp.newIterator(opt.Reverse, lower, upper, opt.Prefix)
+	defer iter.Close()
+	dels := rp.rangeDeletes(readTs, lower, upper)
+
+	res := &RangeResult{}
+	//emit returns false if the scan should stop
+	emit := func(userKey []byte, version uint64, vs y.ValueStruct) (bool, error) {
+		if isDeletedOrExpired(vs.Meta, vs.ExpiresAt) || deletedBy(dels, userKey, version) > 0 {
+			return true, nil
+		}
+		if uint32(len(res.Keys)) >= opt.Limit {
+			res.Truncated = true
+			if len(res.Keys) > 0 {
+				res.Continue = res.Keys[len(res.Keys)-1]
+			}
+			return false, nil
 		}
 		//FIXME:slab allocation key
-		out = append(out, y.Copy(y.ParseKey(iter.Key())))
+		res.Keys = append(res.Keys, y.Copy(userKey))
+		if opt.Values {
+			v, err := rp.getValue(vs)
+			if err != nil {
+				return false, err
+			}
+			res.Values = append(res.Values, y.Copy(v))
+		}
+		return true, nil
 	}
-	return out
-}
 
-func (rp *RangePartition) Get(userKey []byte, version uint64) ([]byte, error) {
+	if !opt.Reverse {
+		seekKey := lower
+		if len(opt.Continue) > 0 && bytes.Compare(opt.Continue, seekKey) >= 0 {
+			//ts 0 is the last version of Continue, so Seek lands on the next key
+			iter.Seek(y.KeyWithTs(opt.Continue, 0))
+		} else {
+			iter.Seek(y.KeyWithTs(seekKey, math.MaxUint64))
+		}
+		var skipKey []byte //note:包括seqnum
+		for ; iter.Valid(); iter.Next() {
+			userKey := y.ParseKey(iter.Key())
+			if len(upper) > 0 && bytes.Compare(userKey, upper) >= 0 {
+				break
+			}
+			if (len(opt.Continue) > 0 && bytes.Equal(userKey, opt.Continue)) || y.ParseTs(iter.Key()) > readTs {
+				continue
+			}
+			if len(skipKey) > 0 && y.SameKey(iter.Key(), skipKey) {
+				continue
+			}
+			skipKey = y.SafeCopy(skipKey, iter.Key())
 
-	vs := rp.getValueStruct(userKey, version)
+			ok, err := emit(userKey, y.ParseTs(iter.Key()), iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+		}
+		return res, nil
+	}
 
-	if vs.Version == 0 {
-		return nil, errNotFound
-	} else if vs.Meta&y.BitDelete > 0 {
-		return nil, errNotFound
+	//reversed: versions of one key come from the oldest to the newest,
+	//keep the newest visible version until the key changes
+	seekKey := upper
+	if len(opt.Continue) > 0 && (len(seekKey) == 0 || bytes.Compare(opt.Continue, seekKey) < 0) {
+		seekKey = opt.Continue
+	}
+	if len(seekKey) == 0 {
+		iter.Rewind()
+	} else {
+		//MaxUint64 is the first version of seekKey, so the iterator lands on the key before seekKey
+		iter.Seek(y.KeyWithTs(seekKey, math.MaxUint64))
+	}
+	var pendingKey []byte
+	var pendingVersion uint64
+	var pending y.ValueStruct
+	for ; iter.Valid(); iter.Next() {
+		userKey := y.ParseKey(iter.Key())
+		if bytes.Compare(userKey, lower) < 0 {
+			break
+		}
+		if (len(upper) > 0 && bytes.Compare(userKey, upper) >= 0) ||
+			(len(opt.Continue) > 0 && bytes.Equal(userKey, opt.Continue)) || y.ParseTs(iter.Key()) > readTs {
+			continue
+		}
+		if pendingKey != nil && !bytes.Equal(pendingKey, userKey) {
+			ok, err := emit(pendingKey, pendingVersion, pending)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return res, nil
+			}
+		}
+		pendingKey = y.SafeCopy(pendingKey, userKey)
+		pendingVersion = y.ParseTs(iter.Key())
+		pending = iter.Value()
+		pending.Value = y.Copy(pending.Value)
 	}
+	if pendingKey != nil {
+		if _, err := emit(pendingKey, pendingVersion, pending); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
+//getValue returns the value of vs, reading it from blockReader if it is a value pointer
+func (rp *RangePartition) getValue(vs y.ValueStruct) ([]byte, error) {
 	if vs.Meta&y.BitValuePointer > 0 {
 
 		var vp valuePointer
@@ -831,22 +1118,44 @@ func (rp *RangePartition) Get(userKey []byte, version uint64) ([]byte, error) {
 		return entry.Value, nil
 	}
 	return vs.Value, nil
+}
+
+func (rp *RangePartition) Get(userKey []byte, version uint64) ([]byte, error) {
+	atomic.AddUint64(&rp.counters.reads, 1)
 
+	vs := rp.getValueStruct(userKey, version)
+
+	if vs.Version == 0 {
+		return nil, ErrNotFound
+	} else if isDeletedOrExpired(vs.Meta, vs.ExpiresAt) {
+		return nil, ErrNotFound
+	}
+
+	return rp.getValue(vs)
 }
 
 //internal APIs/block
+//getValueStruct returns the newest version of userKey at version, a version
+//deleted by a range tombstone is returned as a delete at the tombstone's seqNumber
 func (rp *RangePartition) getValueStruct(userKey []byte, version uint64) y.ValueStruct {
+	readTs := rp.readTs(version)
+	vs := rp.getNewestVersion(userKey, readTs)
+	if vs.Version == 0 {
+		return vs
+	}
+	if seq := deletedBy(rp.rangeDeletes(readTs, userKey, nil), userKey, vs.Version); seq > 0 {
+		return y.ValueStruct{Meta: y.BitDelete, Version: seq}
+	}
+	return vs
+}
+
+func (rp *RangePartition) getNewestVersion(userKey []byte, readTs uint64) y.ValueStruct {
 
 	mtables, decr := rp.getMemTables()
 	defer decr()
 
-	var internalKey []byte
-	if version == 0 {
-		internalKey = y.KeyWithTs(userKey, atomic.LoadUint64(&rp.seqNumber))
-	} else {
-		internalKey = y.KeyWithTs(userKey, version)
-
-	}
+	var maxVs y.ValueStruct
+	internalKey := y.KeyWithTs(userKey, readTs)
 	//search in rp.mt and rp.imm
 	for i := 0; i < len(mtables); i++ {
 		//samekey and the vs is the smallest bigger than req's version
@@ -856,10 +1165,13 @@ func (rp *RangePartition) getValueStruct(userKey []byte, version uint64) y.Value
 			//not found, userKey not match
 			continue
 		}
-		return vs
+		if vs.Version > atomic.LoadUint64(&rp.maxTableSeq) {
+			return vs
+		}
+		maxVs = vs
+		break
 	}
 
-	var maxVs y.ValueStruct
 	tables, decr := rp.getTablesForKey(userKey)
 	defer decr()
 	hash := farm.Fingerprint64(userKey)
@@ -893,13 +1205,32 @@ func (rp *RangePartition) Close() error {
 	return err
 }
 
+//Abandon stops the partition without flushing memtable, it is used when the
+//partition has been given to another PS, which replays the log stream
+func (rp *RangePartition) Abandon() error {
+	var err error
+	rp.closeOnce.Do(func() {
+		err = rp.close(false)
+	})
+	return err
+}
+
 func (rp *RangePartition) close(gracefull bool) error {
 	xlog.Logger.Infof("Closing database")
+	//gc waits for its requests, so it stops before writes
+	rp.gcStopper.Stop()
+
+	//wait for the request which is being sent to writeCh
+	rp.seqLock.Lock()
 	atomic.StoreInt32(&rp.blockWrites, 1)
+	rp.seqLock.Unlock()
 
 	rp.writeStopper.Stop()
 	close(rp.writeCh)
 
+	//compaction sends tasks to flushChan
+	rp.compactStopper.Stop()
+
 	//FIXME lost data in mt/imm, will have to replay log
 	//doWrite在返回前,会调用最后一次writeRequest并且等待返回, 所以这里
 	//mt和rp.vhead都是只读的
@@ -937,18 +1268,18 @@ func (rp *RangePartition) close(gracefull bool) error {
 	return nil
 }
 
-func (rp *RangePartition) WriteAsync(key, value []byte, f func(error)) {
-
-	newSeqNumber := atomic.AddUint64(&rp.seqNumber, 1)
+//expiresAt is a unix time in seconds, 0 means never expire
+func (rp *RangePartition) WriteAsync(key, value []byte, expiresAt uint64, f func(error)) {
 
 	e := &pb.EntryInfo{
 		Log: &pb.Entry{
-			Key:   y.KeyWithTs(key, newSeqNumber),
-			Value: value,
+			Key:       key,
+			Value:     value,
+			ExpiresAt: expiresAt,
 		},
 	}
 
-	req, err := rp.sendToWriteCh([]*pb.EntryInfo{e})
+	req, err := rp.sendWithSeq([]*pb.EntryInfo{e}, nil)
 	if err != nil {
 		f(err)
 		return
@@ -961,47 +1292,117 @@ func (rp *RangePartition) WriteAsync(key, value []byte, f func(error)) {
 
 }
 
-func (rp *RangePartition) Delete(key []byte) error {
+//Delete returns the seqNumber of the tombstone
+func (rp *RangePartition) Delete(key []byte) (uint64, error) {
 	//search
 	vs := rp.getValueStruct(key, 0)
-	if vs.Version == 0 || vs.Meta&y.BitDelete > 0 {
-		return errNotFound
+	if vs.Version == 0 || isDeletedOrExpired(vs.Meta, vs.ExpiresAt) {
+		return 0, ErrNotFound
 	}
 
-	newSeqNumber := atomic.AddUint64(&rp.seqNumber, 1)
 	e := &pb.EntryInfo{
 		Log: &pb.Entry{
-			Key:  y.KeyWithTs(key, newSeqNumber),
+			Key:  key,
 			Meta: uint32(y.BitDelete),
 		},
 	}
 
-	req, err := rp.sendToWriteCh([]*pb.EntryInfo{e})
-	if err != nil {
-		return err
-	}
-	return req.Wait()
+	return rp.writeEntries([]*pb.EntryInfo{e})
 }
 
-//req.Wait will free the request
-func (rp *RangePartition) Write(key, value []byte) error {
-	newSeqNumber := atomic.AddUint64(&rp.seqNumber, 1)
-
+//Write returns the seqNumber which the value is committed at,
+//expiresAt is a unix time in seconds, 0 means never expire
+func (rp *RangePartition) Write(key, value []byte, expiresAt uint64) (uint64, error) {
 	e := &pb.EntryInfo{
 		Log: &pb.Entry{
-			Key:   y.KeyWithTs(key, newSeqNumber),
-			Value: value,
+			Key:       key,
+			Value:     value,
+			ExpiresAt: expiresAt,
 		},
 	}
-	req, err := rp.sendToWriteCh([]*pb.EntryInfo{e})
+	return rp.writeEntries([]*pb.EntryInfo{e})
+}
+
+//writeEntries sends entries as one request and waits, it returns the seqNumber of the last entry.
+func (rp *RangePartition) writeEntries(entries []*pb.EntryInfo) (uint64, error) {
+	return rp.writeEntriesWithCond(entries, nil)
+}
+
+//writeEntriesWithCond writes entries if cond is nil or met.
+//req.Wait will free the request
+func (rp *RangePartition) writeEntriesWithCond(entries []*pb.EntryInfo, cond *Condition) (uint64, error) {
+	req, err := rp.sendWithSeq(entries, cond)
 	if err != nil {
-		return err
+		return 0, err
+	}
+	seq := req.seq
+	if err = req.Wait(); err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
+//Mutation is a put or a delete in Batch
+type Mutation struct {
+	Key       []byte
+	Value     []byte
+	ExpiresAt uint64
+	Delete    bool
+}
+
+//Batch writes all mutations in one request, they get a contiguous range of
+//seqNumbers and become visible in memtable all together or not at all.
+//It returns the seqNumber which the batch is committed at, reads at this
+//version see the whole batch. If mutations is empty, current commitSeq is returned.
+//ErrBatchTooBig is returned if the batch takes more than half of a memtable
+func (rp *RangePartition) Batch(mutations []Mutation) (uint64, error) {
+	if len(mutations) == 0 {
+		return atomic.LoadUint64(&rp.commitSeq), nil
+	}
+	entries := make([]*pb.EntryInfo, len(mutations))
+	for i, m := range mutations {
+		e := &pb.Entry{Key: m.Key}
+		if m.Delete {
+			e.Meta = uint32(y.BitDelete)
+		} else {
+			e.Value = m.Value
+			e.ExpiresAt = m.ExpiresAt
+		}
+		entries[i] = &pb.EntryInfo{Log: e}
+	}
+	return rp.writeEntries(entries)
+}
+
+//sendWithSeq assigns a contiguous range of seqNumbers to entries whose keys
+//are user keys, and sends them as one request. seqLock makes sure requests
+//come out of writeCh in the order of their seqNumbers
+func (rp *RangePartition) sendWithSeq(entries []*pb.EntryInfo, cond *Condition) (*request, error) {
+	if batchTooBig(entries) {
+		return nil, ErrBatchTooBig
+	}
+	//big values are written before getting seqNumbers, so they do not block others
+	if err := rp.writeBlobs(entries); err != nil {
+		return nil, err
+	}
+
+	rp.seqLock.Lock()
+	defer rp.seqLock.Unlock()
+
+	last := atomic.AddUint64(&rp.seqNumber, uint64(len(entries)))
+	first := last - uint64(len(entries)) + 1
+	var size int
+	for i := range entries {
+		size += len(entries[i].Log.Key) + len(entries[i].Log.Value)
+		entries[i].Log.Key = y.KeyWithTs(entries[i].Log.Key, first+uint64(i))
 	}
-	return req.Wait()
+	rp.counters.write(len(entries), size)
+	return rp.sendToWriteCh(entries, last, cond)
 }
 
 //block API
-func (rp *RangePartition) sendToWriteCh(entries []*pb.EntryInfo) (*request, error) {
+//seq is the seqNumber of the last entry, or 0 if entries keep their versions,
+//cond is checked against the key of the first entry if it is not nil
+func (rp *RangePartition) sendToWriteCh(entries []*pb.EntryInfo, seq uint64, cond *Condition) (*request, error) {
 	if atomic.LoadInt32(&rp.blockWrites) == 1 {
 		return nil, ErrBlockedWrites
 	}
@@ -1010,6 +1411,8 @@ func (rp *RangePartition) sendToWriteCh(entries []*pb.EntryInfo) (*request, erro
 	req.reset()
 
 	req.entries = entries
+	req.seq = seq
+	req.cond = cond
 
 	req.wg.Add(1)
 	req.IncrRef()
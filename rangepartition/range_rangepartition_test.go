@@ -1,14 +1,20 @@
 package rangepartition
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/journeymidnight/autumn/manager/pmclient"
 	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/rangepartition/skiplist"
+	"github.com/journeymidnight/autumn/rangepartition/table"
 	"github.com/journeymidnight/autumn/streamclient"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/xlog"
@@ -94,7 +100,7 @@ func runRPTest(t *testing.T, test func(t *testing.T, rp *RangePartition)) {
 	defer rowStream.Close()
 	pmclient := new(pmclient.MockPMClient)
 	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
-		[]byte(""), []byte(""), nil, nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock)
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
 	defer func() {
 		require.NoError(t, rp.Close())
 	}()
@@ -106,7 +112,7 @@ func TestWriteRead(t *testing.T) {
 		var wg sync.WaitGroup
 		for i := 0; i < 100; i++ {
 			wg.Add(1)
-			rp.WriteAsync([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)), func(e error) {
+			rp.WriteAsync([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)), 0, func(e error) {
 				wg.Done()
 			})
 			//rp.write([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)))
@@ -127,7 +133,7 @@ func TestUpdateRead(t *testing.T) {
 		var wg sync.WaitGroup
 		for i := 0; i < 100; i++ {
 			wg.Add(1)
-			rp.WriteAsync([]byte("key"), []byte(fmt.Sprintf("val%d", i)), func(e error) {
+			rp.WriteAsync([]byte("key"), []byte(fmt.Sprintf("val%d", i)), 0, func(e error) {
 				wg.Done()
 			})
 		}
@@ -144,7 +150,7 @@ func TestGetBig(t *testing.T) {
 	runRPTest(t, func(t *testing.T, rp *RangePartition) {
 		//txnSet(t, db, []byte("key1"), []byte("val1"), 0x08)
 		bigValue := []byte(fmt.Sprintf("%01048576d", 10))
-		err := rp.Write([]byte("key1"), bigValue)
+		_, err := rp.Write([]byte("key1"), bigValue, 0)
 		require.NoError(t, err)
 
 		v, err := rp.Get([]byte("key1"), 0)
@@ -165,12 +171,12 @@ func TestReopenRangePartition(t *testing.T) {
 	defer rowStream.Close()
 	pmclient := new(pmclient.MockPMClient)
 	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
-		[]byte(""), []byte(""), nil, nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock)
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
 
 	var wg sync.WaitGroup
 	for i := 10; i < 100; i++ {
 		wg.Add(1)
-		rp.WriteAsync([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)), func(e error) {
+		rp.WriteAsync([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)), 0, func(e error) {
 			wg.Done()
 		})
 	}
@@ -179,11 +185,11 @@ func TestReopenRangePartition(t *testing.T) {
 
 	//reopen with tables
 	rp = OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
-		[]byte(""), []byte(""), pmclient.Tables, nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock)
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
 
 	for i := 10; i < 100; i++ {
 		v, err := rp.Get([]byte(fmt.Sprintf("key%d", i)), 300)
-		if err == errNotFound {
+		if err == ErrNotFound {
 			fmt.Printf("key%d failed\n", i)
 			continue
 		}
@@ -202,7 +208,7 @@ func TestReopenRangePartitionWithBig(t *testing.T) {
 	defer rowStream.Close()
 	pmclient := new(pmclient.MockPMClient)
 	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
-		[]byte(""), []byte(""), nil, nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock)
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
 
 	var expectedValue [][]byte
 	var wg sync.WaitGroup
@@ -212,7 +218,7 @@ func TestReopenRangePartitionWithBig(t *testing.T) {
 		val := make([]byte, n)
 		utils.SetRandStringBytes(val)
 		expectedValue = append(expectedValue, val)
-		rp.WriteAsync([]byte(fmt.Sprintf("key%d", i)), val, func(e error) {
+		rp.WriteAsync([]byte(fmt.Sprintf("key%d", i)), val, 0, func(e error) {
 			wg.Done()
 		})
 	}
@@ -221,11 +227,11 @@ func TestReopenRangePartitionWithBig(t *testing.T) {
 
 	//reopen with tables
 	rp = OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
-		[]byte(""), []byte(""), pmclient.Tables, nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock)
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
 
 	for i := 10; i < 100; i++ {
 		v, err := rp.Get([]byte(fmt.Sprintf("key%d", i)), 300)
-		if err == errNotFound {
+		if err == ErrNotFound {
 			fmt.Printf("key%d failed\n", i)
 			continue
 		}
@@ -240,7 +246,7 @@ func TestRange(t *testing.T) {
 		var wg sync.WaitGroup
 		for i := 0; i < 100; i++ {
 			wg.Add(1)
-			rp.WriteAsync([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)), func(e error) {
+			rp.WriteAsync([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)), 0, func(e error) {
 				wg.Done()
 			})
 			//rp.write([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)))
@@ -251,14 +257,14 @@ func TestRange(t *testing.T) {
 
 		for i := 0; i < 100; i++ {
 			wg.Add(1)
-			rp.WriteAsync([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)), func(e error) {
+			rp.WriteAsync([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)), 0, func(e error) {
 				wg.Done()
 			})
 			//rp.write([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)))
 		}
 		wg.Wait()
 
-		err := rp.Delete([]byte("key99"))
+		_, err := rp.Delete([]byte("key99"))
 		require.Nil(t, err)
 
 		//key0, key1 key10,  ... ,k90, key91 ... key98
@@ -268,7 +274,9 @@ func TestRange(t *testing.T) {
 			array = append(array, []byte(fmt.Sprintf("key%d", i)))
 
 		}
-		out := rp.Range([]byte("key9"), []byte("key9"), 100)
+		res, err := rp.Range(RangeOption{Prefix: []byte("key9"), Start: []byte("key9"), Limit: 100})
+		require.NoError(t, err)
+		out := res.Keys
 
 		/* display out
 		for _, x := range out {
@@ -277,6 +285,895 @@ func TestRange(t *testing.T) {
 		}
 		*/
 		require.Equal(t, array, out)
+		require.False(t, res.Truncated)
+
+	})
+}
+
+func TestRangeReverseAndContinue(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		bigValue := []byte(fmt.Sprintf("%01048576d", 10))
+		var err error
+		for i := 0; i < 10; i++ {
+			_, err = rp.Write([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("old%d", i)), 0)
+			require.NoError(t, err)
+			_, err = rp.Write([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)), 0)
+			require.NoError(t, err)
+		}
+		_, err = rp.Write([]byte("key5"), bigValue, 0)
+		require.NoError(t, err)
+		_, err = rp.Delete([]byte("key3"))
+		require.NoError(t, err)
+
+		//reverse in [key1, key8), values resolved
+		res, err := rp.Range(RangeOption{Start: []byte("key1"), End: []byte("key8"), Reverse: true, Values: true})
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("key7"), []byte("key6"), []byte("key5"), []byte("key4"), []byte("key2"), []byte("key1")}, res.Keys)
+		require.Equal(t, []byte("val7"), res.Values[0])
+		require.Equal(t, bigValue, res.Values[2])
+		require.False(t, res.Truncated)
+
+		//paginate forward and backward
+		for _, reverse := range []bool{false, true} {
+			var keys [][]byte
+			opt := RangeOption{Prefix: []byte("key"), Limit: 4, Reverse: reverse}
+			for {
+				res, err := rp.Range(opt)
+				require.NoError(t, err)
+				keys = append(keys, res.Keys...)
+				if !res.Truncated {
+					break
+				}
+				opt.Continue = res.Continue
+			}
+			require.Equal(t, 9, len(keys))
+			if reverse {
+				require.Equal(t, []byte("key9"), keys[0])
+			} else {
+				require.Equal(t, []byte("key0"), keys[0])
+			}
+		}
+	})
+}
+
+func TestBatch(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		old, err := rp.Write([]byte("key0"), []byte("old"), 0)
+		require.NoError(t, err)
+
+		seq, err := rp.Batch([]Mutation{
+			{Key: []byte("key1"), Value: []byte("val1")},
+			{Key: []byte("key2"), Value: []byte("val2")},
+			{Key: []byte("key0"), Delete: true},
+		})
+		require.NoError(t, err)
+
+		v, err := rp.Get([]byte("key1"), seq)
+		require.NoError(t, err)
+		require.Equal(t, []byte("val1"), v)
+		v, err = rp.Get([]byte("key2"), seq)
+		require.NoError(t, err)
+		require.Equal(t, []byte("val2"), v)
+		_, err = rp.Get([]byte("key0"), seq)
+		require.Equal(t, ErrNotFound, err)
+
+		//read before the batch sees nothing of it
+		require.Equal(t, old, seq-3)
+		v, err = rp.Get([]byte("key0"), old)
+		require.NoError(t, err)
+		require.Equal(t, []byte("old"), v)
+		_, err = rp.Get([]byte("key1"), old)
+		require.Equal(t, ErrNotFound, err)
+		res, err := rp.Range(RangeOption{Version: old})
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("key0")}, res.Keys)
+
+		//empty batch returns current read point
+		last, err := rp.Batch(nil)
+		require.NoError(t, err)
+		require.Equal(t, seq, last)
+
+		//a batch must fit in a memtable, batches written together may not
+		big := make([]Mutation, 900)
+		for i := range big {
+			big[i] = Mutation{Key: []byte(fmt.Sprintf("big%03d", i)), Value: make([]byte, 1000)}
+		}
+		_, err = rp.Batch(big)
+		require.Equal(t, ErrBatchTooBig, err)
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, err := rp.Batch(big[i*300 : (i+1)*300])
+				require.NoError(t, err)
+			}(i)
+		}
+		wg.Wait()
+		for _, m := range big {
+			v, err := rp.Get(m.Key, 0)
+			require.NoError(t, err)
+			require.Equal(t, m.Value, v)
+		}
+	})
+}
+
+func TestReopenAfterFailedCond(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	pmclient := new(pmclient.MockPMClient)
+	defer logStream.Close()
+	defer rowStream.Close()
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	first, err := rp.Write([]byte("a"), []byte("1"), 0)
+	require.NoError(t, err)
+	//the failed condition uses a seqNumber which is not in log
+	_, err = rp.CondWrite([]byte("a"), []byte("2"), 0, Condition{Type: CondAbsent})
+	require.Equal(t, ErrPreconditionFailed, err)
+	last, err := rp.Write([]byte("b"), []byte("1"), 0)
+	require.NoError(t, err)
+	require.True(t, last > first+1)
+	//memtable is not flushed, so b is replayed from log
+	require.NoError(t, rp.Abandon())
+
+	rp = OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer rp.Close()
+	seq, err := rp.Write([]byte("b"), []byte("2"), 0)
+	require.NoError(t, err)
+	require.True(t, seq > last)
+	v, err := rp.Get([]byte("b"), last)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), v)
+	vers, _, err := rp.GetVersions([]byte("b"), 0, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(vers))
+}
+
+func TestTTL(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		_, err := rp.Write([]byte("expired"), []byte("val"), uint64(time.Now().Unix()-1))
+		require.NoError(t, err)
+		_, err = rp.Write([]byte("alive"), []byte("val"), uint64(time.Now().Add(time.Hour).Unix()))
+		require.NoError(t, err)
+
+		_, err = rp.Get([]byte("expired"), 0)
+		require.Equal(t, ErrNotFound, err)
+		v, err := rp.Get([]byte("alive"), 0)
+		require.NoError(t, err)
+		require.Equal(t, []byte("val"), v)
+
+		res, err := rp.Range(RangeOption{})
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("alive")}, res.Keys)
+	})
+}
 
+func TestCondWrite(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		key := []byte("lock")
+		seq, err := rp.CondWrite(key, []byte("owner1"), 0, Condition{Type: CondAbsent})
+		require.NoError(t, err)
+		_, err = rp.CondWrite(key, []byte("owner2"), 0, Condition{Type: CondAbsent})
+		require.Equal(t, ErrPreconditionFailed, err)
+
+		//compare and swap by version
+		_, err = rp.CondWrite(key, []byte("owner2"), 0, Condition{Type: CondVersionEqual, Version: seq + 100})
+		require.Equal(t, ErrPreconditionFailed, err)
+		_, err = rp.CondWrite(key, []byte("owner2"), 0, Condition{Type: CondVersionEqual, Version: seq})
+		require.NoError(t, err)
+
+		//compare and delete by value
+		_, err = rp.CondDelete(key, Condition{Type: CondValueEqual, Value: []byte("owner1")})
+		require.Equal(t, ErrPreconditionFailed, err)
+		_, err = rp.CondDelete(key, Condition{Type: CondValueEqual, Value: []byte("owner2")})
+		require.NoError(t, err)
+		_, err = rp.CondDelete(key, Condition{Type: CondPresent})
+		require.Equal(t, ErrPreconditionFailed, err)
+
+		//only one of concurrent put-if-absent wins
+		var wg sync.WaitGroup
+		var success int32
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if _, err := rp.CondWrite([]byte("race"), []byte(fmt.Sprintf("%d", i)), 0, Condition{Type: CondAbsent}); err == nil {
+					atomic.AddInt32(&success, 1)
+				}
+			}(i)
+		}
+		wg.Wait()
+		require.Equal(t, int32(1), success)
+	})
+}
+
+func TestSplitRangePartition(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	rightLogStream := streamclient.NewMockStreamClient("log")
+	rightRowStream := streamclient.NewMockStreamClient("sst")
+
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer rightLogStream.Close()
+	defer rightRowStream.Close()
+	rightPMClient := new(pmclient.MockPMClient)
+	pmclient := new(pmclient.MockPMClient)
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+
+	for i := 10; i < 100; i++ {
+		_, err := rp.Write([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("val%d", i)), 0)
+		require.NoError(t, err)
+	}
+	require.NoError(t, rp.Close())
+	_, err := rp.SplitKey()
+	require.NoError(t, err)
+	require.True(t, rp.CanSplitAt([]byte("key50")))
+	require.False(t, rp.CanSplitAt([]byte("")))
+	tables := rp.TableLocs()
+
+	//both halves open the same tables, the right one has new log and row streams
+	left := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte("key50"), tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	right := OpenRangePartition(4, sharedStream{rightRowStream, rowStream}, rightLogStream, logStream.(streamclient.BlockReader),
+		[]byte("key50"), []byte(""), tables, rightPMClient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer left.Close()
+
+	res, err := left.Range(RangeOption{})
+	require.NoError(t, err)
+	require.Equal(t, 40, len(res.Keys))
+	require.Equal(t, []byte("key49"), res.Keys[39])
+
+	res, err = right.Range(RangeOption{})
+	require.NoError(t, err)
+	require.Equal(t, 50, len(res.Keys))
+	require.Equal(t, []byte("key50"), res.Keys[0])
+
+	v, err := right.Get([]byte("key60"), 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte("val60"), v)
+
+	//new writes of the right half go to its own log
+	_, err = right.Write([]byte("key60"), []byte("new60"), 0)
+	require.NoError(t, err)
+	v, err = right.Get([]byte("key60"), 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte("new60"), v)
+
+	//and its new tables go to its own row stream
+	require.NoError(t, right.Close())
+	locs := rightPMClient.Tables
+	require.Equal(t, len(tables)+1, len(locs))
+	require.Contains(t, rightRowStream.ExtentIDs(), locs[len(locs)-1].ExtentID)
+	right = OpenRangePartition(4, sharedStream{rightRowStream, rowStream}, rightLogStream, logStream.(streamclient.BlockReader),
+		[]byte("key50"), []byte(""), locs, rightPMClient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer right.Close()
+	for key, value := range map[string]string{"key60": "new60", "key70": "val70"} {
+		v, err = right.Get([]byte(key), 0)
+		require.NoError(t, err)
+		require.Equal(t, []byte(value), v)
+	}
+}
+
+func TestMergeRangePartition(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	rightLogStream := streamclient.NewMockStreamClient("log")
+	rightRowStream := streamclient.NewMockStreamClient("sst")
+
+	//DeleteStream closes merged streams after release, reading them after that fails
+	var lock sync.Mutex
+	streams := map[uint64]streamclient.StreamClient{1: logStream, 2: rowStream, 3: rightLogStream, 4: rightRowStream}
+	release := make(chan struct{})
+	deleteStream := func(streamID uint64) error {
+		<-release
+		lock.Lock()
+		defer lock.Unlock()
+		streams[streamID].Close()
+		delete(streams, streamID)
+		return nil
+	}
+	defer func() {
+		for _, s := range streams {
+			s.Close()
+		}
+	}()
+
+	//values of both halves are bigger than ValueThrottle, so they are in log streams
+	value := func(i int, prefix string) []byte {
+		v := make([]byte, 2*KB)
+		copy(v, fmt.Sprintf("%s%d", prefix, i))
+		return v
+	}
+	leftPMClient := new(pmclient.MockPMClient)
+	rightPMClient := new(pmclient.MockPMClient)
+	left := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte("key50"), nil, leftPMClient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	for i := 10; i < 50; i++ {
+		_, err := left.Write([]byte(fmt.Sprintf("key%d", i)), value(i, "left"), 0)
+		require.NoError(t, err)
+	}
+	require.NoError(t, left.Close())
+	right := OpenRangePartition(4, rightRowStream, rightLogStream, rightLogStream.(streamclient.BlockReader),
+		[]byte("key50"), []byte(""), nil, rightPMClient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	for i := 50; i < 100; i++ {
+		_, err := right.Write([]byte(fmt.Sprintf("key%d", i)), value(i, "old"), 0)
+		require.NoError(t, err)
+		_, err = right.Write([]byte(fmt.Sprintf("key%d", i)), value(i, "right"), 0)
+		require.NoError(t, err)
+	}
+	require.NoError(t, right.Close())
+
+	//the survivor keeps its own streams and opens tables of both
+	locs := append(append([]*pspb.Location{}, leftPMClient.Tables...), rightPMClient.Tables...)
+	leftPMClient.Tables = locs
+	leftPMClient.Merged = &pspb.MergedStreams{Logs: []uint64{3}, Rows: []uint64{4}}
+	rp := OpenRangePartition(3, sharedStream{rowStream, rightRowStream}, logStream, blobReader{logStream, rightLogStream},
+		[]byte(""), []byte(""), locs, leftPMClient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock,
+		OpenOption{
+			MergedLogs:   map[uint64]streamclient.StreamClient{3: rightLogStream},
+			MergedRows:   map[uint64]streamclient.StreamClient{4: rightRowStream},
+			DeleteStream: deleteStream,
+		})
+	check := func(rp *RangePartition) {
+		res, err := rp.Range(RangeOption{Values: true})
+		require.NoError(t, err)
+		require.Equal(t, 90, len(res.Keys))
+		for i, key := range res.Keys {
+			require.Equal(t, []byte(fmt.Sprintf("key%d", i+10)), key)
+			if i+10 < 50 {
+				require.Equal(t, value(i+10, "left"), res.Values[i])
+			} else {
+				require.Equal(t, value(i+10, "right"), res.Values[i])
+			}
+		}
+	}
+	check(rp)
+
+	//values in the right log are moved, and tables in the right row stream are
+	//compacted, then both streams are deleted
+	close(release)
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(streams) == 2
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Empty(t, leftPMClient.Merged.Logs)
+	require.Empty(t, leftPMClient.Merged.Rows)
+	for _, loc := range leftPMClient.Tables {
+		require.Contains(t, rowStream.ExtentIDs(), loc.ExtentID)
+	}
+	check(rp)
+	_, err := rp.Write([]byte("key99"), []byte("new99"), 0)
+	require.NoError(t, err)
+	require.NoError(t, rp.Close())
+
+	rp = OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), leftPMClient.Tables, leftPMClient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer rp.Close()
+	for key, v := range map[string][]byte{"key10": value(10, "left"), "key98": value(98, "right"), "key99": []byte("new99")} {
+		got, err := rp.Get([]byte(key), 0)
+		require.NoError(t, err)
+		require.Equal(t, v, got)
+	}
+}
+
+func TestStats(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		_, err := rp.Write([]byte("key"), []byte("value"), 0)
+		require.NoError(t, err)
+		_, err = rp.Batch([]Mutation{
+			{Key: []byte("a"), Value: []byte("1")},
+			{Key: []byte("b"), Delete: true},
+		})
+		require.NoError(t, err)
+		_, err = rp.Get([]byte("key"), 0)
+		require.NoError(t, err)
+		_, err = rp.Range(RangeOption{})
+		require.NoError(t, err)
+
+		s := rp.Stats()
+		require.Equal(t, uint64(2), s.Reads)
+		require.Equal(t, uint64(3), s.Writes)
+		require.Equal(t, uint64(len("key")+len("value")+len("a")+len("1")+len("b")), s.WriteBytes)
+		require.True(t, s.MemtableSize > 0)
+	})
+}
+
+func TestWatch(t *testing.T) {
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		first, err := rp.Write([]byte("w/a"), []byte("1"), 0)
+		require.NoError(t, err)
+		_, err = rp.Write([]byte("other"), []byte("2"), 0)
+		require.NoError(t, err)
+		_, err = rp.Delete([]byte("w/a"))
+		require.NoError(t, err)
+
+		stop := make(chan struct{})
+		var events []WatchEvent
+		done := make(chan error)
+		go func() {
+			done <- rp.Watch([]byte("w/"), first, stop, func(evs []WatchEvent) error {
+				events = append(events, evs...)
+				if len(events) == 3 {
+					close(stop)
+				}
+				return nil
+			})
+		}()
+
+		//history from log stream, and the new write
+		time.Sleep(100 * time.Millisecond)
+		_, err = rp.Write([]byte("w/b"), []byte("3"), 0)
+		require.NoError(t, err)
+		require.NoError(t, <-done)
+
+		require.Equal(t, 3, len(events))
+		require.Equal(t, WatchEvent{Key: []byte("w/a"), Value: []byte("1"), Seq: first}, events[0])
+		require.Equal(t, []byte("w/a"), events[1].Key)
+		require.True(t, events[1].Delete)
+		require.Equal(t, []byte("w/b"), events[2].Key)
+		require.Equal(t, []byte("3"), events[2].Value)
+		require.True(t, events[1].Seq < events[2].Seq)
+	})
+}
+
+//watchUpTo returns events of Watch from fromSeq till the event at upTo
+func watchUpTo(rp *RangePartition, prefix []byte, fromSeq, upTo uint64) ([]WatchEvent, error) {
+	stop := make(chan struct{})
+	var events []WatchEvent
+	err := rp.Watch(prefix, fromSeq, stop, func(evs []WatchEvent) error {
+		events = append(events, evs...)
+		if events[len(events)-1].Seq >= upTo {
+			close(stop)
+		}
+		return nil
 	})
+	return events, err
+}
+
+func TestWatchFromTable(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	pmclient := new(pmclient.MockPMClient)
+	defer logStream.Close()
+	defer rowStream.Close()
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	var first uint64
+	for i := 0; i < 100; i++ {
+		seq, err := rp.Write([]byte(fmt.Sprintf("key%03d", i)), []byte("1"), 0)
+		require.NoError(t, err)
+		if i == 0 {
+			first = seq
+		}
+	}
+	require.NoError(t, rp.Close())
+
+	rp = OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer rp.Close()
+	var last uint64
+	for i := 100; i < 200; i++ {
+		seq, err := rp.Write([]byte(fmt.Sprintf("key%03d", i)), []byte("2"), 0)
+		require.NoError(t, err)
+		last = seq
+	}
+	require.Equal(t, 1, len(rp.tables))
+	tbl := rp.tables[0]
+
+	//events after the table are read from its value pointer
+	extentID, offset, err := rp.watchStart(tbl.LastSeq)
+	require.NoError(t, err)
+	require.Equal(t, tbl.VpExtentID, extentID)
+	require.Equal(t, tbl.VpOffset, offset)
+	extentID, offset, err = rp.watchStart(tbl.LastSeq - 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), extentID)
+	require.Equal(t, uint32(0), offset)
+
+	for _, from := range []uint64{first, tbl.LastSeq + 1} {
+		events, err := watchUpTo(rp, []byte("key"), from, last)
+		require.NoError(t, err)
+		start := 200 - len(events)
+		if from == first {
+			require.Equal(t, 0, start)
+		} else {
+			require.Equal(t, 100, start)
+		}
+		for i, ev := range events {
+			require.Equal(t, []byte(fmt.Sprintf("key%03d", start+i)), ev.Key)
+		}
+	}
+}
+
+func TestWatchSkipsMoved(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	pmclient := new(pmclient.MockPMClient)
+	defer logStream.Close()
+	defer rowStream.Close()
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer rp.Close()
+	first, err := rp.Write([]byte("a"), []byte("1"), 0)
+	require.NoError(t, err)
+	second, err := rp.Write([]byte("b"), []byte("1"), 0)
+	require.NoError(t, err)
+
+	//values moved from the log of a merged partition keep their seqs, which
+	//may be newer than events of this partition
+	req, err := rp.sendToWriteCh([]*pb.EntryInfo{{Log: &pb.Entry{
+		Key:   y.KeyWithTs([]byte("m"), second+1),
+		Value: []byte("1"),
+		Meta:  bitMoved,
+	}}}, 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, req.Wait())
+	third, err := rp.Write([]byte("c"), []byte("1"), 0)
+	require.NoError(t, err)
+	require.Equal(t, second+1, third)
+
+	events, err := watchUpTo(rp, nil, first, third)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(events))
+	for i, key := range []string{"a", "b", "c"} {
+		require.Equal(t, []byte(key), events[i].Key)
+	}
+}
+
+//blobReader reads blocks from any of the streams
+type blobReader []streamclient.StreamClient
+
+func (br blobReader) Read(ctx context.Context, extentID uint64, offset uint32, numOfBlocks uint32) ([]*pb.Block, uint32, error) {
+	var err error
+	for _, s := range br {
+		var blocks []*pb.Block
+		var end uint32
+		if blocks, end, err = s.Read(ctx, extentID, offset, numOfBlocks); err == nil {
+			return blocks, end, nil
+		}
+	}
+	return nil, 0, err
+}
+
+//sharedStream is a row stream which also reads tables in extents of other like
+//AutumnStreamClient does, e.g. row stream of the parent after split, or of a
+//merged partition
+type sharedStream struct {
+	streamclient.StreamClient
+	other streamclient.StreamClient
+}
+
+func (s sharedStream) Read(ctx context.Context, extentID uint64, offset uint32, numOfBlocks uint32) ([]*pb.Block, uint32, error) {
+	for _, id := range s.StreamClient.ExtentIDs() {
+		if id == extentID {
+			return s.StreamClient.Read(ctx, extentID, offset, numOfBlocks)
+		}
+	}
+	return s.other.Read(ctx, extentID, offset, numOfBlocks)
+}
+
+func TestBlobStream(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	blobStream := streamclient.NewMockStreamClient("blob")
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer blobStream.Close()
+
+	pmclient := new(pmclient.MockPMClient)
+	rp := OpenRangePartition(3, rowStream, logStream, blobReader{logStream, blobStream},
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	rp.SetBlobStream(blobStream, 4096)
+
+	small := make([]byte, 2048)
+	big := make([]byte, 8192)
+	utils.SetRandStringBytes(small)
+	utils.SetRandStringBytes(big)
+	_, err := rp.Write([]byte("small"), small, 0)
+	require.NoError(t, err)
+	_, err = rp.Write([]byte("big"), big, 0)
+	require.NoError(t, err)
+
+	//log stream only has the pointer of big
+	var blobEntries int
+	err = replayLog(logStream, 0, 0, true, func(ei *pb.EntryInfo) (bool, error) {
+		if ei.Log.Meta&uint32(y.BitBlobPointer) > 0 {
+			blobEntries++
+			require.Equal(t, []byte("big"), y.ParseKey(ei.Log.Key))
+			require.True(t, len(ei.Log.Value) < len(big))
+		}
+		return true, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, blobEntries)
+
+	v, err := rp.Get([]byte("big"), 0)
+	require.NoError(t, err)
+	require.Equal(t, big, v)
+	v, err = rp.Get([]byte("small"), 0)
+	require.NoError(t, err)
+	require.Equal(t, small, v)
+
+	//reopen, big is replayed from the pointer in log stream
+	rp.close(false)
+	rp = OpenRangePartition(3, rowStream, logStream, blobReader{logStream, blobStream},
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	v, err = rp.Get([]byte("big"), 0)
+	require.NoError(t, err)
+	require.Equal(t, big, v)
+	require.NoError(t, rp.Close())
+}
+
+func TestRangeSkipTables(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	pmclient := new(pmclient.MockPMClient)
+	defer logStream.Close()
+	defer rowStream.Close()
+
+	//each run flushes one table of tenant t{run}/
+	for run := 0; run < 3; run++ {
+		rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+			[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock,
+			OpenOption{PrefixBloomLen: 3})
+		for i := 0; i < 10; i++ {
+			_, err := rp.Write([]byte(fmt.Sprintf("t%d/%d", run, i)), []byte("v"), 0)
+			require.NoError(t, err)
+		}
+		require.NoError(t, rp.Close())
+	}
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer rp.Close()
+
+	_, err := rp.Write([]byte("t1/memtable"), []byte("v"), 0)
+	require.NoError(t, err)
+
+	res, err := rp.Range(RangeOption{Prefix: []byte("t1/")})
+	require.NoError(t, err)
+	require.Equal(t, 11, len(res.Keys))
+	require.Equal(t, []byte("t1/0"), res.Keys[0])
+	require.Equal(t, []byte("t1/memtable"), res.Keys[10])
+
+	res, err = rp.Range(RangeOption{Prefix: []byte("t"), Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, 31, len(res.Keys))
+	require.Equal(t, []byte("t2/9"), res.Keys[0])
+
+	res, err = rp.Range(RangeOption{Start: []byte("t0/5"), End: []byte("t1/1")})
+	require.NoError(t, err)
+	require.Equal(t, 6, len(res.Keys))
+}
+
+func TestDeleteRange(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	pmclient := new(pmclient.MockPMClient)
+	defer logStream.Close()
+	defer rowStream.Close()
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	for _, tenant := range []string{"t1/", "t2/"} {
+		for i := 0; i < 100; i++ {
+			_, err := rp.Write([]byte(fmt.Sprintf("%s%02d", tenant, i)), []byte("v"), 0)
+			require.NoError(t, err)
+		}
+	}
+	before := atomic.LoadUint64(&rp.commitSeq)
+	_, err := rp.DeleteRange([]byte("t1/"), prefixEnd([]byte("t1/")))
+	require.NoError(t, err)
+	_, err = rp.Write([]byte("t1/50"), []byte("new"), 0)
+	require.NoError(t, err)
+
+	check := func(rp *RangePartition) {
+		_, err := rp.Get([]byte("t1/10"), 0)
+		require.Equal(t, ErrNotFound, err)
+		v, err := rp.Get([]byte("t1/50"), 0)
+		require.NoError(t, err)
+		require.Equal(t, []byte("new"), v)
+		res, err := rp.Range(RangeOption{Prefix: []byte("t1/")})
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("t1/50")}, res.Keys)
+		res, err = rp.Range(RangeOption{Prefix: []byte("t"), Reverse: true})
+		require.NoError(t, err)
+		require.Equal(t, 101, len(res.Keys))
+	}
+	check(rp)
+
+	//reads before the tombstone still see the keys
+	v, err := rp.Get([]byte("t1/10"), before)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v"), v)
+
+	//conditions see the tombstone
+	_, err = rp.CondWrite([]byte("t1/20"), []byte("v"), 0, Condition{Type: CondAbsent})
+	require.NoError(t, err)
+	require.NoError(t, rp.Close())
+
+	//tombstones are in tables after reopen, major compaction drops them
+	rp = OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer rp.Close()
+	require.Eventually(t, func() bool {
+		return len(rp.rangeDeletes(math.MaxUint64, nil, nil)) == 0
+	}, 10*time.Second, 50*time.Millisecond)
+	_, err = rp.Get([]byte("t1/20"), 0)
+	require.NoError(t, err)
+	_, err = rp.Delete([]byte("t1/20"))
+	require.NoError(t, err)
+	check(rp)
+}
+
+func TestExportIngest(t *testing.T) {
+	var exported []ExportEntry
+	runRPTest(t, func(t *testing.T, rp *RangePartition) {
+		for i := 0; i < 50; i++ {
+			_, err := rp.Write([]byte(fmt.Sprintf("k%02d", i)), []byte("v"), 0)
+			require.NoError(t, err)
+		}
+		_, err := rp.Write([]byte("k10"), []byte("v"), 1)
+		require.NoError(t, err)
+		_, err = rp.Delete([]byte("k20"))
+		require.NoError(t, err)
+		_, err = rp.DeleteRange([]byte("k3"), []byte("k4"))
+		require.NoError(t, err)
+
+		seq, err := rp.Export(func(e ExportEntry) error {
+			exported = append(exported, e)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, atomic.LoadUint64(&rp.commitSeq), seq)
+	})
+	require.Equal(t, 50, len(exported))
+	require.Equal(t, uint64(1), exported[10].ExpiresAt)
+	require.True(t, exported[20].Deleted)
+	require.True(t, exported[35].Deleted)
+	require.Equal(t, []byte("v"), exported[49].Value)
+
+	//export files have two tables, like those written by autumn-client export
+	exportStream := streamclient.NewMockStreamClient("export")
+	defer exportStream.Close()
+	var locs []pspb.Location
+	for _, entries := range [][]ExportEntry{exported[:25], exported[25:]} {
+		b := table.NewTableBuilder(exportStream, pspb.CompressionType_snappy, 0)
+		for _, e := range entries {
+			vs := y.ValueStruct{Value: e.Value, ExpiresAt: e.ExpiresAt}
+			if e.Deleted {
+				vs.Meta = y.BitDelete
+			}
+			b.Add(y.KeyWithTs(e.Key, e.Version), vs)
+		}
+		b.FinishBlock()
+		extentID, offset, err := b.FinishAll(0, 0, 0)
+		require.NoError(t, err)
+		locs = append(locs, pspb.Location{ExtentID: extentID, Offset: offset})
+	}
+	addTable := func(ing *Ingest, loc pspb.Location) error {
+		index, _, err := table.ReadTableIndex(exportStream, loc.ExtentID, loc.Offset)
+		require.NoError(t, err)
+		var blocks []*pb.Block
+		for _, offset := range index.Offsets {
+			block, _, err := exportStream.Read(context.Background(), offset.ExtentID, offset.Offset, 1)
+			require.NoError(t, err)
+			blocks = append(blocks, block...)
+		}
+		if err = ing.AddBlocks(blocks); err != nil {
+			return err
+		}
+		return ing.AddTable(index)
+	}
+
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	pmclient := new(pmclient.MockPMClient)
+	defer logStream.Close()
+	defer rowStream.Close()
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	for _, key := range []string{"k20", "k30", "k45", "k46"} {
+		_, err := rp.Write([]byte(key), []byte("old"), 0)
+		require.NoError(t, err)
+	}
+
+	//tables must be sorted
+	ing, err := rp.NewIngest()
+	require.NoError(t, err)
+	require.NoError(t, addTable(ing, locs[1]))
+	require.Error(t, addTable(ing, locs[0]))
+	ing.Abort()
+
+	//blocks must match the index
+	ing, err = rp.NewIngest()
+	require.NoError(t, err)
+	index, _, err := table.ReadTableIndex(exportStream, locs[0].ExtentID, locs[0].Offset)
+	require.NoError(t, err)
+	require.Error(t, ing.AddTable(index))
+	ing.Abort()
+
+	//tables with value pointers or more than one version of a key are rejected
+	bad := func(add func(b *table.Builder)) pspb.Location {
+		b := table.NewTableBuilder(exportStream, pspb.CompressionType_snappy, 0)
+		add(b)
+		b.FinishBlock()
+		extentID, offset, err := b.FinishAll(0, 0, 0)
+		require.NoError(t, err)
+		return pspb.Location{ExtentID: extentID, Offset: offset}
+	}
+	vp := valuePointer{extentID: 100, offset: 512, len: 2048}
+	for _, loc := range []pspb.Location{
+		bad(func(b *table.Builder) {
+			b.Add(y.KeyWithTs([]byte("k50"), 2), y.ValueStruct{Value: []byte("new")})
+			b.Add(y.KeyWithTs([]byte("k50"), 1), y.ValueStruct{Value: []byte("old")})
+		}),
+		bad(func(b *table.Builder) {
+			b.Add(y.KeyWithTs([]byte("k50"), 1), y.ValueStruct{Value: []byte("v")})
+			b.Add(y.KeyWithTs([]byte("k51"), 1), y.ValueStruct{Value: vp.Encode(), Meta: y.BitValuePointer})
+		}),
+	} {
+		ing, err = rp.NewIngest()
+		require.NoError(t, err)
+		require.Error(t, addTable(ing, loc))
+		ing.Abort()
+	}
+
+	ing, err = rp.NewIngest()
+	require.NoError(t, err)
+	require.NoError(t, addTable(ing, locs[0]))
+	require.NoError(t, addTable(ing, locs[1]))
+	//writes after NewIngest are newer than ingested keys
+	_, err = rp.Write([]byte("k46"), []byte("newer"), 0)
+	require.NoError(t, err)
+	_, err = rp.Get([]byte("k00"), 0)
+	require.Equal(t, ErrNotFound, err)
+	seq, tables, blocks, err := ing.Finish()
+	require.NoError(t, err)
+	require.Equal(t, 2, tables)
+	require.True(t, blocks >= 2)
+	require.Equal(t, seq, atomic.LoadUint64(&rp.commitSeq))
+
+	check := func(rp *RangePartition) {
+		for _, key := range []string{"k10", "k20", "k30", "k35"} {
+			_, err := rp.Get([]byte(key), 0)
+			require.Equal(t, ErrNotFound, err)
+		}
+		v, err := rp.Get([]byte("k45"), 0)
+		require.NoError(t, err)
+		require.Equal(t, []byte("v"), v)
+		v, err = rp.Get([]byte("k46"), 0)
+		require.NoError(t, err)
+		require.Equal(t, []byte("newer"), v)
+		//keys of the tables are at the seqNumber of the ingest, after the old writes
+		vers, _, err := rp.GetVersions([]byte("k45"), 0, 0, true)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(vers))
+		require.True(t, vers[0].Version < seq)
+		require.Equal(t, []byte("v"), vers[0].Value)
+		require.Equal(t, []byte("old"), vers[1].Value)
+		res, err := rp.Range(RangeOption{})
+		require.NoError(t, err)
+		require.Equal(t, 38, len(res.Keys))
+	}
+	check(rp)
+	require.NoError(t, rp.Close())
+
+	//ingested tables are saved in PM
+	rp = OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	defer rp.Close()
+	check(rp)
+	next, err := rp.Write([]byte("k00"), []byte("new"), 0)
+	require.NoError(t, err)
+	require.True(t, next > seq)
 }
@@ -0,0 +1,116 @@
+package rangepartition
+
+import (
+	"bytes"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/rangepartition/y"
+	"github.com/pkg/errors"
+)
+
+//rangeDelete is a range tombstone, versions older than seq of keys in [start, end)
+//are deleted, empty end means no upper bound. It is written as a delete of start
+//whose value is end, so it is in log stream, memtable and tables as other entries.
+//Tables also keep their tombstones in TableIndex, so they are loaded without scanning
+type rangeDelete struct {
+	start []byte
+	end   []byte
+	seq   uint64
+}
+
+func (d rangeDelete) contains(userKey []byte) bool {
+	return bytes.Compare(userKey, d.start) >= 0 && (len(d.end) == 0 || bytes.Compare(userKey, d.end) < 0)
+}
+
+//overlaps returns true if d has keys in [lower, upper), empty upper means no upper bound
+func (d rangeDelete) overlaps(lower, upper []byte) bool {
+	if len(d.end) > 0 && bytes.Compare(d.end, lower) <= 0 {
+		return false
+	}
+	return len(upper) == 0 || bytes.Compare(d.start, upper) < 0
+}
+
+//deletedBy returns the seqNumber of the newest tombstone in dels which deletes
+//the version of userKey, 0 if it is not deleted
+func deletedBy(dels []rangeDelete, userKey []byte, version uint64) uint64 {
+	var seq uint64
+	for _, d := range dels {
+		if d.seq > version && d.seq > seq && d.contains(userKey) {
+			seq = d.seq
+		}
+	}
+	return seq
+}
+
+//addRangeDelete is called when a tombstone is written to memtable or its table
+//is opened. Tombstones are expected to be few, so they are kept in a slice
+func (rp *RangePartition) addRangeDelete(start, end []byte, seq uint64) {
+	rp.rangeDelLock.Lock()
+	defer rp.rangeDelLock.Unlock()
+	for _, d := range rp.rangeDels {
+		if d.seq == seq && bytes.Equal(d.start, start) {
+			return
+		}
+	}
+	rp.rangeDels = append(rp.rangeDels, rangeDelete{start: y.Copy(start), end: y.Copy(end), seq: seq})
+}
+
+//removeRangeDeletes forgets tombstones dropped by major compaction
+func (rp *RangePartition) removeRangeDeletes(dels []*pspb.RangeDelete) {
+	if len(dels) == 0 {
+		return
+	}
+	rp.rangeDelLock.Lock()
+	defer rp.rangeDelLock.Unlock()
+	kept := rp.rangeDels[:0]
+	for _, d := range rp.rangeDels {
+		dropped := false
+		for _, x := range dels {
+			if d.seq == x.Seq && bytes.Equal(d.start, x.Start) {
+				dropped = true
+				break
+			}
+		}
+		if !dropped {
+			kept = append(kept, d)
+		}
+	}
+	rp.rangeDels = kept
+}
+
+//rangeDeletes returns tombstones visible at readTs which overlap [lower, upper)
+func (rp *RangePartition) rangeDeletes(readTs uint64, lower, upper []byte) []rangeDelete {
+	rp.rangeDelLock.RLock()
+	defer rp.rangeDelLock.RUnlock()
+	var out []rangeDelete
+	for _, d := range rp.rangeDels {
+		if d.seq <= readTs && d.overlaps(lower, upper) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+//DeleteRange deletes keys in [start, end) of the partition with one write, empty
+//end means the end of the partition. Deleted keys are removed by the next major
+//compaction. It returns the seqNumber which the delete is committed at
+func (rp *RangePartition) DeleteRange(start, end []byte) (uint64, error) {
+	//tombstones never cover keys of other partitions after merge
+	lower, upper := rp.bounds(RangeOption{Start: start, End: end})
+	if len(upper) > 0 && bytes.Compare(lower, upper) >= 0 {
+		return 0, nil
+	}
+	//end is the value of the entry, it must be in memtable
+	if len(upper) > y.ValueThrottle {
+		return 0, errors.Errorf("end key is longer than %d", y.ValueThrottle)
+	}
+	e := &pb.EntryInfo{
+		Log: &pb.Entry{
+			Key:   lower,
+			Value: upper,
+			Meta:  uint32(y.BitDelete | y.BitRangeDelete),
+		},
+	}
+	return rp.writeEntries([]*pb.EntryInfo{e})
+}
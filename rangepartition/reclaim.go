@@ -0,0 +1,108 @@
+package rangepartition
+
+import (
+	"context"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/rangepartition/table"
+	"github.com/journeymidnight/autumn/streamclient"
+	"github.com/journeymidnight/autumn/xlog"
+)
+
+//triggerReclaim wakes up the compaction goroutine to reclaim rowStream, it never blocks
+func (rp *RangePartition) triggerReclaim() {
+	select {
+	case rp.reclaimCh <- struct{}{}:
+	default:
+	}
+}
+
+//releaseTable is called when the last reference of a compacted table is dropped
+func (rp *RangePartition) releaseTable(t *table.Table) {
+	rp.obsoleteLock.Lock()
+	delete(rp.obsolete, t)
+	rp.obsoleteLock.Unlock()
+	rp.triggerReclaim()
+}
+
+//reclaimRowStream truncates extents of rowStream before the first extent which
+//has a live table. Tables of this partition, compacted tables which are still
+//being read, tables and blocks being ingested, and tables of other partitions which read
+//rowStream after split are live. SM deletes truncated extents on extent nodes.
+//Row streams of merged partitions which have no live extents are dropped
+func (rp *RangePartition) reclaimRowStream() {
+	//tables being built are not in rp.tables yet
+	rp.rowLock.Lock()
+	defer rp.rowLock.Unlock()
+
+	extentIDs := rp.rowStream.ExtentIDs()
+	_, mergedRows := rp.mergedStreams()
+	if len(extentIDs) <= 1 && len(mergedRows) == 0 {
+		return
+	}
+	//stream of each extent which may have live tables
+	inStream := make(map[uint64]streamclient.StreamClient, len(extentIDs))
+	for _, extentID := range extentIDs {
+		inStream[extentID] = rp.rowStream
+	}
+	for _, stream := range mergedRows {
+		for _, extentID := range stream.ExtentIDs() {
+			inStream[extentID] = stream
+		}
+	}
+
+	live := make(map[uint64]bool)
+	known := make(map[pspb.Location]bool)
+	addTable := func(t *table.Table) {
+		known[t.Loc] = true
+		for _, extentID := range t.Extents() {
+			live[extentID] = true
+		}
+	}
+	rp.tableLock.RLock()
+	for _, t := range rp.tables {
+		addTable(t)
+	}
+	rp.tableLock.RUnlock()
+	rp.obsoleteLock.Lock()
+	for t := range rp.obsolete {
+		addTable(t)
+	}
+	for ing := range rp.ingests {
+		ing.liveExtents(live)
+	}
+	rp.obsoleteLock.Unlock()
+
+	shared, err := rp.pmClient.GetSharedTables(rp.PartID)
+	if err != nil {
+		xlog.Logger.Warnf("partition %d: failed to get shared tables: %v", rp.PartID, err)
+		return
+	}
+	for _, loc := range shared {
+		if inStream[loc.ExtentID] == nil || known[*loc] {
+			continue
+		}
+		//blocks of the table may be in extents before its meta block
+		t, err := table.OpenTable(inStream[loc.ExtentID], loc.ExtentID, loc.Offset)
+		if err != nil {
+			xlog.Logger.Warnf("partition %d: failed to open shared table: %v", rp.PartID, err)
+			return
+		}
+		addTable(t)
+	}
+	rp.reclaimMergedRows(mergedRows, live)
+
+	//the last extent is being written
+	i := 0
+	for i < len(extentIDs)-1 && !live[extentIDs[i]] {
+		i++
+	}
+	if i == 0 {
+		return
+	}
+	if _, _, err = rp.rowStream.Truncate(context.Background(), extentIDs[i]); err != nil {
+		xlog.Logger.Warnf("partition %d: failed to truncate row stream: %v", rp.PartID, err)
+		return
+	}
+	xlog.Logger.Infof("partition %d: reclaimed %d extents of row stream", rp.PartID, i)
+}
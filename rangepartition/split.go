@@ -0,0 +1,60 @@
+package rangepartition
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/rangepartition/y"
+	"github.com/pkg/errors"
+)
+
+var ErrCanNotSplit = errors.New("partition can not be split")
+
+//SplitKey picks the middle key of all blocks in tables as the split key,
+//the data in memtable is not considered
+func (rp *RangePartition) SplitKey() ([]byte, error) {
+	var keys [][]byte
+	rp.tableLock.RLock()
+	for _, t := range rp.tables {
+		for _, k := range t.BlockKeys() {
+			userKey := y.ParseKey(k)
+			if rp.InRange(userKey) && bytes.Compare(userKey, rp.StartKey) > 0 {
+				keys = append(keys, userKey)
+			}
+		}
+	}
+	rp.tableLock.RUnlock()
+
+	if len(keys) == 0 {
+		return nil, ErrCanNotSplit
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	return y.Copy(keys[len(keys)/2]), nil
+}
+
+//CanSplitAt returns true if splitKey is strictly inside the partition's range
+func (rp *RangePartition) CanSplitAt(splitKey []byte) bool {
+	return bytes.Compare(splitKey, rp.StartKey) > 0 && rp.InRange(splitKey)
+}
+
+//InRange returns true if key is in [StartKey, EndKey)
+func (rp *RangePartition) InRange(key []byte) bool {
+	return bytes.Compare(rp.StartKey, key) <= 0 && (len(rp.EndKey) == 0 || bytes.Compare(key, rp.EndKey) < 0)
+}
+
+//TableLocs returns the locations of all tables, in the order of rowStream.
+//After split, both partitions open the same tables, keys out of range are
+//filtered by reads and dropped by compaction
+func (rp *RangePartition) TableLocs() []*pspb.Location {
+	rp.tableLock.RLock()
+	defer rp.tableLock.RUnlock()
+	var locs []*pspb.Location
+	for _, t := range rp.tables {
+		loc := t.Loc
+		locs = append(locs, &loc)
+	}
+	return locs
+}
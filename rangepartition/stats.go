@@ -0,0 +1,72 @@
+package rangepartition
+
+import (
+	"sync/atomic"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+)
+
+type counters struct {
+	reads            uint64
+	writes           uint64
+	writeBytes       uint64
+	rawBlockBytes    uint64
+	storedBlockBytes uint64
+}
+
+func (c *counters) write(n int, size int) {
+	atomic.AddUint64(&c.writes, uint64(n))
+	atomic.AddUint64(&c.writeBytes, uint64(size))
+}
+
+//build counts data blocks of a new table
+func (c *counters) build(raw, stored uint64) {
+	atomic.AddUint64(&c.rawBlockBytes, raw)
+	atomic.AddUint64(&c.storedBlockBytes, stored)
+}
+
+//Stats is the load of a partition, Reads, Writes and WriteBytes only increase
+//since the partition is opened
+type Stats struct {
+	Reads        uint64
+	Writes       uint64
+	WriteBytes   uint64 //size of keys and values written
+	MemtableSize uint64 //memtable and immutable memtables
+	TableSize    uint64
+	NumTables    int
+
+	//data blocks of tables built since the partition is opened, the ratio of
+	//compression is StoredBlockBytes/RawBlockBytes
+	Compression      pspb.CompressionType
+	RawBlockBytes    uint64
+	StoredBlockBytes uint64
+}
+
+func (rp *RangePartition) Stats() Stats {
+	s := Stats{
+		Reads:      atomic.LoadUint64(&rp.counters.reads),
+		Writes:     atomic.LoadUint64(&rp.counters.writes),
+		WriteBytes: atomic.LoadUint64(&rp.counters.writeBytes),
+
+		Compression:      rp.Compression(),
+		RawBlockBytes:    atomic.LoadUint64(&rp.counters.rawBlockBytes),
+		StoredBlockBytes: atomic.LoadUint64(&rp.counters.storedBlockBytes),
+	}
+
+	rp.RLock()
+	if rp.mt != nil {
+		s.MemtableSize += uint64(rp.mt.MemSize())
+	}
+	for _, mt := range rp.imm {
+		s.MemtableSize += uint64(mt.MemSize())
+	}
+	rp.RUnlock()
+
+	rp.tableLock.RLock()
+	for _, t := range rp.tables {
+		s.TableSize += t.EstimatedSize()
+	}
+	s.NumTables = len(rp.tables)
+	rp.tableLock.RUnlock()
+	return s
+}
@@ -17,6 +17,7 @@
 package table
 
 import (
+	"bytes"
 	"context"
 	"math"
 	"unsafe"
@@ -77,16 +78,28 @@ type Builder struct {
 	stream       streamclient.StreamClient
 	writeCh      chan writeBlock
 	stopper      *utils.Stopper
+
+	compression pspb.CompressionType
+	rawBytes    uint64 //uncompressed size of data blocks
+	storedBytes uint64 //size of data blocks written to stream
+
+	prefixLen    uint32   //0 means no prefix bloom filter
+	prefixHashes []uint64 //fingerprints of distinct prefixes
+	lastPrefix   []byte
 }
 
-// NewTableBuilder makes a new TableBuilder.
-func NewTableBuilder(stream streamclient.StreamClient) *Builder {
+// NewTableBuilder makes a new TableBuilder, data blocks are compressed by compression.
+// If prefixLen is not 0, the table also has a bloom filter of the first prefixLen
+// bytes of user keys, keys shorter than prefixLen are not in it.
+func NewTableBuilder(stream streamclient.StreamClient, compression pspb.CompressionType, prefixLen uint32) *Builder {
 	b := &Builder{
-		tableIndex: &pspb.TableIndex{},
-		keyHashes:  make([]uint64, 0, 1024), // Avoid some malloc calls.
-		stream:     stream,
-		writeCh:    make(chan writeBlock, 16),
-		stopper:    utils.NewStopper(),
+		tableIndex:  &pspb.TableIndex{PrefixLen: prefixLen},
+		keyHashes:   make([]uint64, 0, 1024), // Avoid some malloc calls.
+		stream:      stream,
+		writeCh:     make(chan writeBlock, 16),
+		stopper:     utils.NewStopper(),
+		compression: compression,
+		prefixLen:   prefixLen,
 	}
 
 	b.stopper.RunWorker(func() {
@@ -193,7 +206,23 @@ func (b *Builder) append(data []byte) {
 }
 
 func (b *Builder) addHelper(key []byte, v y.ValueStruct) {
-	b.keyHashes = append(b.keyHashes, farm.Fingerprint64(y.ParseKey(key)))
+	userKey := y.ParseKey(key)
+	b.keyHashes = append(b.keyHashes, farm.Fingerprint64(userKey))
+	if v.Meta&y.BitRangeDelete > 0 {
+		b.tableIndex.RangeDeletes = append(b.tableIndex.RangeDeletes, &pspb.RangeDelete{
+			Start: y.Copy(userKey),
+			End:   y.Copy(v.Value),
+			Seq:   y.ParseTs(key),
+		})
+	}
+	//keys are sorted, versions and keys of the same prefix are adjacent
+	if b.prefixLen > 0 && uint32(len(userKey)) >= b.prefixLen {
+		prefix := userKey[:b.prefixLen]
+		if b.prefixHashes == nil || !bytes.Equal(prefix, b.lastPrefix) {
+			b.prefixHashes = append(b.prefixHashes, farm.Fingerprint64(prefix))
+			b.lastPrefix = append(b.lastPrefix[:0], prefix...)
+		}
+	}
 
 	// diffKey stores the difference of key with baseKey.
 	var diffKey []byte
@@ -250,12 +279,27 @@ func (b *Builder) FinishBlock() {
 	b.append(y.U32SliceToBytes(b.entryOffsets))
 	b.append(y.U32ToBytes(uint32(len(b.entryOffsets))))
 
-	b.currentBlock.CheckSum = utils.AdlerCheckSum(b.currentBlock.Data)
-	b.currentBlock.UserData = utils.MustMarshal(&pspb.RawBlockMeta{
+	blockMeta := &pspb.RawBlockMeta{
 		Type:             pspb.RawBlockType_data,
 		CompressedSize:   0,
 		UnCompressedSize: b.sz,
-	})
+	}
+	if b.compression != pspb.CompressionType_none {
+		compressed, err := compress(b.compression, b.currentBlock.Data[:b.sz])
+		utils.Check(err)
+		//keep the block uncompressed if compression does not help
+		if len(compressed) < int(b.sz) {
+			b.currentBlock.Data = compressed
+			b.currentBlock.BlockLength = uint32(len(compressed))
+			blockMeta.Compression = b.compression
+			blockMeta.CompressedSize = uint32(len(compressed))
+		}
+	}
+	b.rawBytes += uint64(b.sz)
+	b.storedBytes += uint64(len(b.currentBlock.Data))
+
+	b.currentBlock.CheckSum = utils.AdlerCheckSum(b.currentBlock.Data)
+	b.currentBlock.UserData = utils.MustMarshal(blockMeta)
 
 	xlog.Logger.Debugf("real block size is %d, len of entries is %d\n", b.sz, len(b.entryOffsets))
 	b.writeCh <- writeBlock{
@@ -265,6 +309,17 @@ func (b *Builder) FinishBlock() {
 	return
 }
 
+//BlockSizes returns the uncompressed size and the written size of data blocks
+func (b *Builder) BlockSizes() (uint64, uint64) {
+	return b.rawBytes, b.storedBytes
+}
+
+//SetVersionTimes saves when seqNumbers were flushed in the table, which is used
+//to find the age of versions, it must be called before FinishAll
+func (b *Builder) SetVersionTimes(times []*pspb.VersionTime) {
+	b.tableIndex.VersionTimes = times
+}
+
 func (b *Builder) addBlockToIndex(baseKey []byte, extentID uint64, offset uint32) {
 	// Add key to the block index.
 	bo := &pspb.BlockOffset{
@@ -329,20 +384,35 @@ func (b *Builder) FinishAll(headExtentID uint64, headOffset uint32, seqNum uint6
 	// Add bloom filter to the index.
 	b.tableIndex.BloomFilter = bf.JSONMarshal()
 
-	//alloc a new meta block
+	if b.prefixLen > 0 {
+		pbf := z.NewBloomFilter(float64(len(b.prefixHashes)), 0.01)
+		for _, h := range b.prefixHashes {
+			pbf.Add(h)
+		}
+		b.tableIndex.PrefixBloomFilter = pbf.JSONMarshal()
+	}
+
+	return WriteTableIndex(b.stream, b.tableIndex, headExtentID, headOffset, seqNum)
+}
+
+//WriteTableIndex appends the meta block of a table whose blocks are in the locations
+//of index.Offsets, it returns the location of the meta block which opens the table
+func WriteTableIndex(stream streamclient.StreamClient, index *pspb.TableIndex,
+	headExtentID uint64, headOffset uint32, seqNum uint64) (uint64, uint32, error) {
+	//alloc a new meta block, it is never compressed
 
-	sz := utils.Ceil(uint32(b.tableIndex.Size()), 4*KB)
+	sz := utils.Ceil(uint32(index.Size()), 4*KB)
 
 	metaBlock := &pb.Block{
 		BlockLength: sz,
 		Data:        make([]byte, sz, sz),
 	}
 
-	b.tableIndex.MarshalTo(metaBlock.Data)
+	index.MarshalTo(metaBlock.Data)
 
 	metaBlock.UserData = utils.MustMarshal(&pspb.RawBlockMeta{
 		Type:             pspb.RawBlockType_meta,
-		UnCompressedSize: uint32(b.tableIndex.Size()),
+		UnCompressedSize: uint32(index.Size()),
 		CompressedSize:   0,
 		VpExtentID:       headExtentID,
 		VpOffset:         headOffset,
@@ -350,7 +420,7 @@ func (b *Builder) FinishAll(headExtentID uint64, headOffset uint32, seqNum uint6
 	})
 	metaBlock.CheckSum = utils.AdlerCheckSum(metaBlock.Data)
 
-	extentID, offsets, err := b.stream.Append(context.Background(), []*pb.Block{metaBlock})
+	extentID, offsets, err := stream.Append(context.Background(), []*pb.Block{metaBlock})
 	if err != nil {
 		return 0, 0, err
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/rangepartition/y"
 	"github.com/journeymidnight/autumn/streamclient"
 	"github.com/journeymidnight/autumn/xlog"
@@ -56,7 +57,7 @@ func TestTableIndex(t *testing.T) {
 	stream := streamclient.NewMockStreamClient("log")
 	defer stream.Close()
 
-	builder := NewTableBuilder(stream)
+	builder := NewTableBuilder(stream, pspb.CompressionType_none, 0)
 
 	blockFirstKeys := make([][]byte, 0)
 	blockCount := 0
@@ -0,0 +1,51 @@
+package table
+
+import (
+	"github.com/golang/snappy"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+//EncodeAll and DecodeAll of zstd are safe for concurrent use
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+//compress returns data compressed by c
+func compress(c pspb.CompressionType, data []byte) ([]byte, error) {
+	switch c {
+	case pspb.CompressionType_none:
+		return data, nil
+	case pspb.CompressionType_snappy:
+		return snappy.Encode(nil, data), nil
+	case pspb.CompressionType_zstd:
+		return zstdEncoder.EncodeAll(data, make([]byte, 0, len(data))), nil
+	default:
+		return nil, errors.Errorf("unknown compression %d", c)
+	}
+}
+
+//decompress returns the original data whose size is sz
+func decompress(c pspb.CompressionType, data []byte, sz uint32) ([]byte, error) {
+	var ret []byte
+	var err error
+	switch c {
+	case pspb.CompressionType_none:
+		return data, nil
+	case pspb.CompressionType_snappy:
+		ret, err = snappy.Decode(make([]byte, sz), data)
+	case pspb.CompressionType_zstd:
+		ret, err = zstdDecoder.DecodeAll(data, make([]byte, 0, sz))
+	default:
+		return nil, errors.Errorf("unknown compression %d", c)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if uint32(len(ret)) != sz {
+		return nil, errors.Errorf("size of decompressed block is %d, expected %d", len(ret), sz)
+	}
+	return ret, nil
+}
@@ -18,7 +18,9 @@ package table
 
 import (
 	"bytes"
+	"encoding/binary"
 	"io"
+	"math"
 	"sort"
 
 	"github.com/journeymidnight/autumn/proto/pb"
@@ -46,13 +48,14 @@ type blockIterator struct {
 	key          []byte
 	val          []byte
 	entryOffsets []uint32
+	globalSeq    uint64 //if not 0, it replaces versions of keys
 
 	// prevOverlap stores the overlap of the previous key with the base key.
 	// This avoids unnecessary copy of base key when the overlap is same for multiple keys.
 	prevOverlap uint16
 }
 
-func (itr *blockIterator) setBlock(b *pb.Block) {
+func (itr *blockIterator) setBlock(b *pb.Block) error {
 	itr.err = nil
 	itr.idx = 0
 	itr.baseKey = itr.baseKey[:0]
@@ -63,10 +66,17 @@ func (itr *blockIterator) setBlock(b *pb.Block) {
 	var blockMeta pspb.RawBlockMeta
 	utils.MustUnMarshal(b.UserData, &blockMeta)
 	sz := blockMeta.UnCompressedSize
-	numEntries := y.BytesToU32(b.Data[sz-4 : sz])
+	data, err := decompress(blockMeta.Compression, b.Data, sz)
+	if err != nil {
+		itr.entryOffsets = nil
+		itr.data = nil
+		return err
+	}
+	numEntries := y.BytesToU32(data[sz-4 : sz])
 	entriesIndexStart := sz - 4 - numEntries*4
-	itr.entryOffsets = y.BytesToU32Slice(b.Data[entriesIndexStart : sz-4])
-	itr.data = b.Data[:entriesIndexStart]
+	itr.entryOffsets = y.BytesToU32Slice(data[entriesIndexStart : sz-4])
+	itr.data = data[:entriesIndexStart]
+	return nil
 }
 
 // setIdx sets the iterator to the entry at index i and set it's key and value.
@@ -110,6 +120,11 @@ func (itr *blockIterator) setIdx(i int) {
 	diffKey := entryData[headerSize:valueOff]
 	itr.key = append(itr.key[:h.overlap], diffKey...)
 	itr.val = entryData[valueOff:]
+	if itr.globalSeq > 0 {
+		//the next key copies its overlap from baseKey again
+		binary.BigEndian.PutUint64(itr.key[len(itr.key)-8:], math.MaxUint64-itr.globalSeq)
+		itr.prevOverlap = 0
+	}
 }
 
 func (itr *blockIterator) Valid() bool {
@@ -207,7 +222,10 @@ func (itr *Iterator) seekToFirst() {
 		itr.err = err
 		return
 	}
-	itr.bi.setBlock(block)
+	if err = itr.bi.setBlock(block); err != nil {
+		itr.err = err
+		return
+	}
 	itr.bi.seekToFirst()
 	itr.err = itr.bi.Error()
 }
@@ -224,7 +242,10 @@ func (itr *Iterator) seekToLast() {
 		itr.err = err
 		return
 	}
-	itr.bi.setBlock(block)
+	if err = itr.bi.setBlock(block); err != nil {
+		itr.err = err
+		return
+	}
 	itr.bi.seekToLast()
 	itr.err = itr.bi.Error()
 }
@@ -236,7 +257,10 @@ func (itr *Iterator) seekHelper(blockIdx int, key []byte) {
 		itr.err = err
 		return
 	}
-	itr.bi.setBlock(block)
+	if err = itr.bi.setBlock(block); err != nil {
+		itr.err = err
+		return
+	}
 	itr.bi.seek(key, origin)
 	itr.err = itr.bi.Error()
 }
@@ -310,7 +334,10 @@ func (itr *Iterator) next() {
 			itr.err = err
 			return
 		}
-		itr.bi.setBlock(block)
+		if err = itr.bi.setBlock(block); err != nil {
+			itr.err = err
+			return
+		}
 		itr.bi.seekToFirst()
 		itr.err = itr.bi.Error()
 		return
@@ -338,7 +365,10 @@ func (itr *Iterator) prev() {
 			itr.err = err
 			return
 		}
-		itr.bi.setBlock(block)
+		if err = itr.bi.setBlock(block); err != nil {
+			itr.err = err
+			return
+		}
 		itr.bi.seekToLast()
 		itr.err = itr.bi.Error()
 		return
@@ -1,14 +1,18 @@
 package table
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"sync/atomic"
 
 	"github.com/dgraph-io/ristretto"
 	"github.com/dgraph-io/ristretto/z"
+	"github.com/dgryski/go-farm"
 	"github.com/gogo/protobuf/proto"
 	"github.com/journeymidnight/autumn/proto/pb"
 	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/rangepartition/y"
 	"github.com/journeymidnight/autumn/streamclient"
 	"github.com/journeymidnight/autumn/utils"
 	"github.com/journeymidnight/autumn/xlog"
@@ -34,13 +38,21 @@ type Table struct {
 	// Stores the total size of key-values stored in this table (including the size on vlog).
 	estimatedSize uint64
 	bf            *z.Bloom
-	Cache         *ristretto.Cache
+	prefixBf      *z.Bloom //nil if the table has no prefix bloom filter
+	prefixLen     uint32
+	rangeDeletes  []*pspb.RangeDelete
+	versionTimes  []*pspb.VersionTime
+	globalSeq     uint64           //if not 0, versions of all keys are globalSeq
+	Cache         *ristretto.Cache //blocks shared by tables, nil means no cache
 	BfCache       *ristretto.Cache
 
 	Loc        pspb.Location
 	LastSeq    uint64
 	VpExtentID uint64
 	VpOffset   uint32
+
+	//called when the last reference is dropped, set by OnRelease
+	release func(*Table)
 }
 
 // IncrRef increments the refcount (having to do with whether the file should be deleted)
@@ -48,39 +60,74 @@ func (t *Table) IncrRef() {
 	atomic.AddInt32(&t.ref, 1)
 }
 
-// DecrRef decrements the refcount and possibly deletes the table
+// DecrRef decrements the refcount, the release function is called when the
+// last reference is dropped
 func (t *Table) DecrRef() error {
-	//FIXME:
+	newRef := atomic.AddInt32(&t.ref, -1)
+	if newRef == 0 && t.release != nil {
+		t.release(t)
+	}
 	return nil
 }
 
-func OpenTable(stream streamclient.StreamClient,
-	extentID uint64, offset uint32) (*Table, error) {
+//OnRelease sets fn which is called when the last reference is dropped. It must
+//be called before the owner drops its reference
+func (t *Table) OnRelease(fn func(*Table)) {
+	t.release = fn
+}
 
-	utils.AssertTrue(xlog.Logger != nil)
+//Extents returns extents holding the blocks of the table, in the order of the stream
+func (t *Table) Extents() []uint64 {
+	var ret []uint64
+	for _, offset := range t.blockIndex {
+		if len(ret) == 0 || ret[len(ret)-1] != offset.ExtentID {
+			ret = append(ret, offset.ExtentID)
+		}
+	}
+	if len(ret) == 0 || ret[len(ret)-1] != t.Loc.ExtentID {
+		ret = append(ret, t.Loc.ExtentID)
+	}
+	return ret
+}
 
+//ReadTableIndex reads the meta block of the table at (extentID, offset)
+func ReadTableIndex(stream streamclient.BlockReader,
+	extentID uint64, offset uint32) (*pspb.TableIndex, *pspb.RawBlockMeta, error) {
 	blocks, err := stream.Read(context.Background(), extentID, offset, 1)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if len(blocks) != 1 {
-		return nil, errors.Errorf("len of block is not 1")
+		return nil, nil, errors.Errorf("len of block is not 1")
 	}
 	var metaBlock pspb.RawBlockMeta
 	//must?
 	utils.MustUnMarshal(blocks[0].UserData, &metaBlock)
 	if metaBlock.Type != pspb.RawBlockType_meta {
-		return nil, errors.Errorf("block type error")
+		return nil, nil, errors.Errorf("block type error")
 	}
 
 	var tableIndex pspb.TableIndex
 	if err = tableIndex.Unmarshal(blocks[0].Data[:metaBlock.UnCompressedSize]); err != nil {
+		return nil, nil, err
+	}
+	return &tableIndex, &metaBlock, nil
+}
+
+func OpenTable(stream streamclient.StreamClient,
+	extentID uint64, offset uint32) (*Table, error) {
+
+	utils.AssertTrue(xlog.Logger != nil)
+
+	tableIndex, metaBlock, err := ReadTableIndex(stream, extentID, offset)
+	if err != nil {
 		return nil, err
 	}
 
 	t := &Table{
 		blockIndex:    make([]*pspb.BlockOffset, len(tableIndex.Offsets)),
 		stream:        stream,
+		ref:           1, //the caller is given one reference
 		estimatedSize: tableIndex.EstimatedSize,
 		Loc: pspb.Location{
 			ExtentID: extentID,
@@ -89,12 +136,26 @@ func OpenTable(stream streamclient.StreamClient,
 		LastSeq:    metaBlock.SeqNum,
 		VpExtentID: metaBlock.VpExtentID,
 		VpOffset:   metaBlock.VpOffset,
+		globalSeq:  tableIndex.GlobalSeq,
 	}
 
 	//read bloom filter
 	if t.bf, err = z.JSONUnmarshal(tableIndex.BloomFilter); err != nil {
 		return nil, err
 	}
+	if tableIndex.PrefixLen > 0 {
+		if t.prefixBf, err = z.JSONUnmarshal(tableIndex.PrefixBloomFilter); err != nil {
+			return nil, err
+		}
+		t.prefixLen = tableIndex.PrefixLen
+	}
+
+	for _, d := range tableIndex.RangeDeletes {
+		t.rangeDeletes = append(t.rangeDeletes, proto.Clone(d).(*pspb.RangeDelete))
+	}
+	for _, vt := range tableIndex.VersionTimes {
+		t.versionTimes = append(t.versionTimes, proto.Clone(vt).(*pspb.VersionTime))
+	}
 
 	//clone BlockOffset
 	for i, offset := range tableIndex.Offsets {
@@ -108,10 +169,38 @@ func OpenTable(stream streamclient.StreamClient,
 	return t, nil
 }
 
-//TODO: cache block
+//blocks are about 64KB, it is used to estimate the number of blocks in cache
+const avgBlockSize = 64 * KB
+
+//NewBlockCache creates a cache of blocks which is shared by tables, size is in bytes
+func NewBlockCache(size int64) (*ristretto.Cache, error) {
+	return ristretto.NewCache(&ristretto.Config{
+		NumCounters: size/avgBlockSize*10 + 1,
+		MaxCost:     size,
+		BufferItems: 64,
+		Metrics:     true,
+	})
+}
+
+//blockCacheKey is (extentID, offset), blocks never change after they are written
+func blockCacheKey(extentID uint64, offset uint32) []byte {
+	buf := make([]byte, 12)
+	binary.BigEndian.PutUint64(buf[:8], extentID)
+	binary.BigEndian.PutUint32(buf[8:], offset)
+	return buf
+}
+
+//block reads the block from Cache if it is set, blocks in Cache are read only
 func (t *Table) block(idx int) (*pb.Block, error) {
 	extentID := t.blockIndex[idx].ExtentID
 	offset := t.blockIndex[idx].Offset
+	var key []byte
+	if t.Cache != nil {
+		key = blockCacheKey(extentID, offset)
+		if b, ok := t.Cache.Get(key); ok {
+			return b.(*pb.Block), nil
+		}
+	}
 	blocks, err := t.stream.Read(context.Background(), extentID, offset, 1)
 	if err != nil {
 		return nil, err
@@ -119,15 +208,36 @@ func (t *Table) block(idx int) (*pb.Block, error) {
 	if len(blocks) != 1 {
 		return nil, errors.Errorf("len of blocks is not 1")
 	}
+	if t.Cache != nil {
+		t.Cache.Set(key, blocks[0], int64(len(blocks[0].Data)+len(blocks[0].UserData)))
+	}
 	return blocks[0], nil
 }
 
 // Smallest is its smallest key, or nil if there are none
 func (t *Table) Smallest() []byte { return t.smallest }
 
+//EstimatedSize is the size of key-values in this table, including the size on vlog
+func (t *Table) EstimatedSize() uint64 { return t.estimatedSize }
+
+//RangeDeletes returns range tombstones written in the table, they are read only
+func (t *Table) RangeDeletes() []*pspb.RangeDelete { return t.rangeDeletes }
+
+//VersionTimes returns when seqNumbers were flushed, they are read only
+func (t *Table) VersionTimes() []*pspb.VersionTime { return t.versionTimes }
+
 // Biggest is its biggest key, or nil if there are none
 func (t *Table) Biggest() []byte { return t.biggest }
 
+// BlockKeys returns the base key(with timestamp) of each block
+func (t *Table) BlockKeys() [][]byte {
+	keys := make([]([]byte), len(t.blockIndex))
+	for i := range t.blockIndex {
+		keys[i] = t.blockIndex[i].Key
+	}
+	return keys
+}
+
 func (t *Table) initBiggestAndSmallest() error {
 	t.smallest = t.blockIndex[0].Key
 
@@ -145,6 +255,7 @@ func (t *Table) initBiggestAndSmallest() error {
 func (t *Table) NewIterator(reversed bool) *Iterator {
 	t.IncrRef() // Important.
 	ti := &Iterator{t: t, reversed: reversed}
+	ti.bi.globalSeq = t.globalSeq
 	ti.next()
 	return ti
 }
@@ -152,3 +263,21 @@ func (t *Table) NewIterator(reversed bool) *Iterator {
 func (t *Table) DoesNotHave(hash uint64) bool {
 	return !t.bf.Has(hash)
 }
+
+//DoesNotHavePrefix returns true if no user key of the table has the prefix, it
+//only knows prefixes which are not shorter than the prefixLen of the table
+func (t *Table) DoesNotHavePrefix(prefix []byte) bool {
+	if t.prefixBf == nil || uint32(len(prefix)) < t.prefixLen {
+		return false
+	}
+	return !t.prefixBf.Has(farm.Fingerprint64(prefix[:t.prefixLen]))
+}
+
+//Overlaps returns true if the table may have user keys in [lower, upper), empty
+//upper means no upper bound
+func (t *Table) Overlaps(lower, upper []byte) bool {
+	if bytes.Compare(y.ParseKey(t.biggest), lower) < 0 {
+		return false
+	}
+	return len(upper) == 0 || bytes.Compare(y.ParseKey(t.smallest), upper) < 0
+}
@@ -21,7 +21,9 @@ import (
 	"math"
 	"sort"
 	"testing"
+	"time"
 
+	"github.com/journeymidnight/autumn/proto/pspb"
 	"github.com/journeymidnight/autumn/rangepartition/y"
 	"github.com/journeymidnight/autumn/utils"
 
@@ -49,7 +51,7 @@ func buildTestTable(t *testing.T, prefix string, n int) (streamclient.StreamClie
 func buildTable(t *testing.T, keyValues [][]string) (streamclient.StreamClient, uint64, uint32) {
 	//open local stream
 	stream := streamclient.NewMockStreamClient("log")
-	b := NewTableBuilder(stream)
+	b := NewTableBuilder(stream, pspb.CompressionType_none, 0)
 	defer b.Close()
 
 	sort.Slice(keyValues, func(i, j int) bool {
@@ -684,7 +686,7 @@ func TestTableBigValues(t *testing.T) {
 
 	n := 100 // Insert 100 keys.
 
-	builder := NewTableBuilder(stream)
+	builder := NewTableBuilder(stream, pspb.CompressionType_none, 0)
 	for i := 0; i < n; i++ {
 		key := y.KeyWithTs([]byte(key("", i)), 0)
 		vs := y.ValueStruct{Value: value(i)}
@@ -712,6 +714,92 @@ func TestTableBigValues(t *testing.T) {
 	require.Equal(t, n, count)
 }
 
+func TestBlockCache(t *testing.T) {
+	stream, id, offset := buildTestTable(t, "key", 10000)
+	defer stream.Close()
+
+	cache, err := NewBlockCache(16 << 20)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	table, err := OpenTable(stream, id, offset)
+	require.NoError(t, err)
+	defer table.DecrRef()
+	table.Cache = cache
+
+	//blocks are added to cache asynchronously
+	require.Eventually(t, func() bool {
+		it := table.NewIterator(false)
+		defer it.Close()
+		it.seek(y.KeyWithTs([]byte(key("key", 5000)), 0))
+		require.True(t, it.Valid())
+		require.EqualValues(t, "5000", string(it.Value().Value))
+		return cache.Metrics.Hits() > 0
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestTableRelease(t *testing.T) {
+	stream, id, offset := buildTestTable(t, "key", 1000)
+	defer stream.Close()
+
+	table, err := OpenTable(stream, id, offset)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{id}, table.Extents())
+
+	var released int
+	table.OnRelease(func(tbl *Table) {
+		require.True(t, tbl == table)
+		released++
+	})
+
+	//an iterator keeps the table alive
+	it := table.NewIterator(false)
+	table.DecrRef()
+	require.Equal(t, 0, released)
+	it.Rewind()
+	require.True(t, it.Valid())
+	it.Close()
+	require.Equal(t, 1, released)
+}
+
+func TestCompression(t *testing.T) {
+	for _, c := range []pspb.CompressionType{pspb.CompressionType_snappy, pspb.CompressionType_zstd} {
+		t.Run(c.String(), func(t *testing.T) {
+			stream := streamclient.NewMockStreamClient("log")
+			defer stream.Close()
+
+			n := 10000
+			b := NewTableBuilder(stream, c, 0)
+			for i := 0; i < n; i++ {
+				b.Add(y.KeyWithTs([]byte(key("key", i)), 0), y.ValueStruct{Value: []byte(fmt.Sprintf("%d", i)), Meta: 'A'})
+			}
+			b.FinishBlock()
+			id, offset, err := b.FinishAll(0, 0, 10)
+			require.NoError(t, err)
+			raw, stored := b.BlockSizes()
+			require.True(t, stored < raw)
+
+			table, err := OpenTable(stream, id, offset)
+			require.NoError(t, err)
+			defer table.DecrRef()
+
+			it := table.NewIterator(false)
+			defer it.Close()
+			count := 0
+			for it.Rewind(); it.Valid(); it.Next() {
+				require.EqualValues(t, key("key", count), y.ParseKey(it.Key()))
+				require.EqualValues(t, fmt.Sprintf("%d", count), string(it.Value().Value))
+				count++
+			}
+			require.Equal(t, n, count)
+
+			it.seek(y.KeyWithTs([]byte(key("key", 5000)), 0))
+			require.True(t, it.Valid())
+			require.EqualValues(t, "5000", string(it.Value().Value))
+		})
+	}
+}
+
 /*
 var cacheConfig = ristretto.Config{
 	NumCounters: 1000000 * 10,
@@ -900,3 +988,31 @@ func TestMain(m *testing.M) {
 // 	//var entrySize uint64 = 15 /* DiffKey len */ + 4 /* Header Size */ + 4 /* Encoded vp */
 // 	require.Equal(t, entrySize, table.EstimatedSize())
 // }
+
+func TestPrefixBloom(t *testing.T) {
+	stream := streamclient.NewMockStreamClient("log")
+	defer stream.Close()
+
+	b := NewTableBuilder(stream, pspb.CompressionType_none, 4)
+	for i := 0; i < 1000; i++ {
+		b.Add(y.KeyWithTs([]byte(key("pre1", i)), 0), y.ValueStruct{Value: []byte(fmt.Sprintf("%d", i)), Meta: 'A'})
+	}
+	b.FinishBlock()
+	id, offset, err := b.FinishAll(0, 0, 10)
+	require.NoError(t, err)
+
+	table, err := OpenTable(stream, id, offset)
+	require.NoError(t, err)
+	defer table.DecrRef()
+
+	require.False(t, table.DoesNotHavePrefix([]byte("pre1")))
+	require.False(t, table.DoesNotHavePrefix([]byte("pre10999")))
+	require.True(t, table.DoesNotHavePrefix([]byte("pre2")))
+	//shorter prefixes are not in the filter
+	require.False(t, table.DoesNotHavePrefix([]byte("pre")))
+
+	require.True(t, table.Overlaps([]byte("pre10500"), []byte("pre10501")))
+	require.True(t, table.Overlaps([]byte(""), []byte("")))
+	require.False(t, table.Overlaps([]byte("pre2"), []byte("")))
+	require.False(t, table.Overlaps([]byte("a"), []byte("pre1")))
+}
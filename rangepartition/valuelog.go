@@ -1,8 +1,9 @@
 package rangepartition
 
 import (
-	"bytes"
 	"context"
+	"github.com/pkg/errors"
+	"time"
 
 	"github.com/journeymidnight/autumn/proto/pb"
 	"github.com/journeymidnight/autumn/rangepartition/y"
@@ -11,6 +12,11 @@ import (
 	"github.com/journeymidnight/autumn/xlog"
 )
 
+//gc runs if at least half of the picked extents is stale
+const gcDiscardRatio = 0.5
+
+var errGCStopped = errors.New("gc stopped")
+
 //replay valuelog
 //compact valuelog
 func (rp *RangePartition) writeValueLog(reqs []*request) ([]*pb.EntryInfo, valuePointer, error) {
@@ -63,12 +69,6 @@ func replayLog(stream streamclient.StreamClient, startExtentID uint64, startOffs
 	return nil
 }
 
-//policy
-func (rp *RangePartition) pickLog(discardRatio float64) *pb.StreamInfo {
-	return rp.discard.MaxDiscard()
-
-}
-
 func discardEntry(ei *pb.EntryInfo, vs y.ValueStruct) bool {
 	if vs.Version != y.ParseTs(ei.Log.Key) {
 		// Version not found. Discard.
@@ -80,82 +80,267 @@ func discardEntry(ei *pb.EntryInfo, vs y.ValueStruct) bool {
 	return false
 }
 
-func (rp *RangePartition) runGC(discardRatio float64) {
-	streamInfo := rp.pickLog(discardRatio)
-	if streamInfo == nil {
-		return
+//logExtent is an extent of log stream before the replay head
+type logExtent struct {
+	id     uint64
+	size   uint64
+	maxSeq uint64 //the newest entry in the extent
+}
+
+//gcHead returns the extent where replay starts, extents before it are only read
+//by value pointers in tables
+func (rp *RangePartition) gcHead() uint64 {
+	logExtents := rp.logStream.ExtentIDs()
+	rp.tableLock.RLock()
+	defer rp.tableLock.RUnlock()
+	if t := headTable(rp.tables, logExtents); t != nil {
+		return t.VpExtentID
+	}
+	return 0
+}
+
+//LogHead returns the last extent of log stream which has data of the partition
+func (rp *RangePartition) LogHead() uint64 {
+	if rp.vhead.extentID != 0 {
+		return rp.vhead.extentID
 	}
-	
-	var candidate streamclient.StreamClient
+	return rp.gcHead()
+}
 
-	candidate = rp.openStream(*streamInfo)
+//pickGC returns extents to be collected, the extent after them and the seq of the
+//newest entry in them. Log stream
+//can only be truncated from the front, so the prefix of log stream which has the
+//biggest ratio of stale values is picked. Extents at or before the shared extent
+//are read by value pointers of partitions split from this one, they start the log
+//stream, so nothing is collected until they stop reading it after their major
+//compactions.
+func (rp *RangePartition) pickGC(discardRatio float64) ([]uint64, uint64, uint64, error) {
+	//PM knows if partitions split from this one still read the log
+	if rp.discard.SharedExtent() != 0 {
+		rp.saveDiscard()
+	}
+	head := rp.gcHead()
+	if head == 0 || rp.discard.SharedExtent() != 0 {
+		return nil, 0, 0, nil
+	}
 
-	candidate.Connect()
+	//entries of small values are read too, so watchers know seqs of entries
+	//which are truncated
+	var extents []logExtent
+	err := replayLog(rp.logStream, 0, 0, true, func(ei *pb.EntryInfo) (bool, error) {
+		if ei.ExtentID == head {
+			return false, nil
+		}
+		if len(extents) == 0 || extents[len(extents)-1].id != ei.ExtentID {
+			extents = append(extents, logExtent{id: ei.ExtentID})
+		}
+		ex := &extents[len(extents)-1]
+		//size of extent is the end of the last entry
+		ex.size = uint64(ei.Offset) + ei.EstimatedSize
+		if len(ei.Log.Key) > 0 {
+			if seq := y.ParseTs(ei.Log.Key); seq > ex.maxSeq {
+				ex.maxSeq = seq
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	var discard, size uint64
+	var bestRatio float64
+	best := -1
+	for i, ex := range extents {
+		discard += uint64(rp.discard.Discard(ex.id))
+		size += ex.size
+		if size == 0 {
+			continue
+		}
+		if ratio := float64(discard) / float64(size); ratio >= discardRatio && ratio >= bestRatio {
+			best, bestRatio = i, ratio
+		}
+	}
+	if best < 0 {
+		return nil, 0, 0, nil
+	}
+
+	var prefix []uint64
+	var maxSeq uint64
+	for _, ex := range extents[:best+1] {
+		prefix = append(prefix, ex.id)
+		if ex.maxSeq > maxSeq {
+			maxSeq = ex.maxSeq
+		}
+	}
+	next := head
+	if best+1 < len(extents) {
+		next = extents[best+1].id
+	}
+	return prefix, next, maxSeq, nil
+}
+
+//runGC rewrites live values in the picked extents to the end of log stream with
+//their versions, then truncates the extents
+func (rp *RangePartition) runGC(discardRatio float64) error {
+	prefix, next, truncated, err := rp.pickGC(discardRatio)
+	if err != nil || len(prefix) == 0 {
+		return err
+	}
+	inPrefix := make(map[uint64]bool, len(prefix))
+	for _, id := range prefix {
+		inPrefix[id] = true
+	}
+	xlog.Logger.Infof("partition %d: gc extents %v of log stream", rp.PartID, prefix)
+
+	count, moved, err := rp.moveValues(rp.logStream, func(extentID uint64) bool {
+		return inPrefix[extentID]
+	})
+	if err != nil {
+		return err
+	}
+
+	//watchers must know entries are gone before they are, so it is saved first
+	rp.discard.SetTruncatedSeq(truncated)
+	stats, err := rp.pmClient.SetDiscard(rp.PartID, rp.discard.Stats())
+	if err != nil {
+		return err
+	}
+	rp.discard.SetShared(stats)
+
+	//moved values are after the replay head, values in tables which point to
+	//prefix are stale now
+	if _, _, err = rp.logStream.Truncate(context.Background(), next); err != nil {
+		return err
+	}
+	rp.discard.Remove(prefix)
+	rp.saveDiscard()
+	xlog.Logger.Infof("partition %d: gc moved %d of %d entries", rp.PartID, moved, count)
+	return nil
+}
+
+//bitMoved is set in Meta of log entries written by moveValues, they are copies
+//of older entries. Tables only keep the lower byte of Meta
+const bitMoved uint32 = 1 << 8
+
+//moveValues rewrites live values of stream to the end of log stream with their
+//versions and bitMoved, entries are read from the start of stream until pick returns false
+//for their extents. It returns the number of entries read and moved
+func (rp *RangePartition) moveValues(stream streamclient.StreamClient, pick func(extentID uint64) bool) (int, int, error) {
 	var count, moved int
-	var freed uint64
-	var size uint64
-	wb := make([]*pb.EntryInfo, 0, 100)
+	var size int
+	var wb []*pb.EntryInfo
+	var reqs []*request
+	send := func() error {
+		if len(wb) == 0 {
+			return nil
+		}
+		//keep seqNum
+		req, err := rp.sendToWriteCh(wb, 0, nil)
+		if err != nil {
+			return err
+		}
+		reqs = append(reqs, req)
+		wb, size = nil, 0
+		return nil
+	}
 
 	fe := func(ei *pb.EntryInfo) (bool, error) {
+		if !pick(ei.ExtentID) {
+			return false, nil
+		}
+		select {
+		case <-rp.gcStopper.ShouldStop():
+			return false, errGCStopped
+		default:
+		}
 		count++
 		if count%100000 == 0 {
 			xlog.Logger.Debugf("Processing entry %d", count)
 		}
 
-		freed += ei.EstimatedSize
-		if ei.Log.Key == nil && ei.Log.Value == nil {
+		//small values are in tables
+		if len(ei.Log.Key) == 0 {
 			return true, nil
 		}
-
 		userKey := y.ParseKey(ei.Log.Key)
-
-		if bytes.Compare(userKey, rp.StartKey) < 0 {
+		if !rp.InRange(userKey) {
 			return true, nil
 		}
-		if len(rp.EndKey) > 0 && bytes.Compare(rp.EndKey, userKey) < 0 {
-			return true, nil
-		}
-
-		//startKey <=userKey <= endKey
-
-		vs := rp.getValueStruct(userKey, 0) //get the lasted version, do not support multiversion
 
-		if discardEntry(ei, vs) {
+		//older versions may be kept by compaction, find the version of the entry
+		var version uint64
+		if rp.keepsVersions() {
+			version = y.ParseTs(ei.Log.Key)
+		}
+		vs := rp.getValueStruct(userKey, version)
+		if discardEntry(ei, vs) || vs.Meta&y.BitValuePointer == 0 {
 			return true, nil
 		}
-
-		utils.AssertTrue(len(vs.Value) > 0)
 		var vp valuePointer
 		vp.Decode(vs.Value)
-		if vp.extentID == ei.ExtentID && vp.offset == ei.Offset {
-			moved++
-			//write ne to mt
-			//keep seqNum
-			ne := &pb.EntryInfo{
-				Log: &pb.Entry{
-					Key:   ei.Log.Key,
-					Value: ei.Log.Value,
-				},
-			}
+		if vp.extentID != ei.ExtentID || vp.offset != ei.Offset {
+			return true, nil
+		}
 
-			//?batch?
-			if len(wb) > 4 || ei.EstimatedSize+size > 16*MB {
-				req, err := rp.sendToWriteCh(wb)
-				if err != nil {
-					return false, err
-				}
-				go func() {
-					//Wait() will release req
-					req.Wait()
-				}()
+		value, err := rp.getValue(vs)
+		if err != nil {
+			return false, err
+		}
+		moved++
+		wb = append(wb, &pb.EntryInfo{
+			Log: &pb.Entry{
+				Key:       ei.Log.Key,
+				Value:     value,
+				Meta:      bitMoved,
+				UserMeta:  ei.Log.UserMeta,
+				ExpiresAt: vs.ExpiresAt,
+			},
+		})
+		size += len(value)
+		if len(wb) >= 64 || size > 16*MB {
+			if err = send(); err != nil {
+				return false, err
 			}
-			wb = append(wb, ne)
-			size += ei.EstimatedSize
-
 		}
 		return true, nil
 	}
 
-	replayLog(candidate, 0, 0, false, fe)
+	err := replayLog(stream, 0, 0, false, fe)
+	if err == nil {
+		err = send()
+	}
+	//Wait() will release req
+	for _, req := range reqs {
+		if werr := req.Wait(); werr != nil && err == nil {
+			err = werr
+		}
+	}
+	return count, moved, err
+}
 
+func (rp *RangePartition) startGC() {
+	rp.gcStopper = utils.NewStopper()
+	rp.gcStopper.RunWorker(func() {
+		randTicker := utils.NewRandomTicker(10*time.Minute, 20*time.Minute)
+		defer randTicker.Stop()
+		//logs of merged partitions are replayed as soon as possible
+		replayMerged := func() {
+			if err := rp.replayMergedLogs(); err != nil && err != errGCStopped {
+				xlog.Logger.Warnf("partition %d: failed to replay merged logs: %v", rp.PartID, err)
+			}
+		}
+		replayMerged()
+		for {
+			select {
+			case <-randTicker.C:
+				replayMerged()
+				if err := rp.runGC(gcDiscardRatio); err != nil && err != errGCStopped {
+					xlog.Logger.Warnf("partition %d: gc failed: %v", rp.PartID, err)
+				}
+			case <-rp.gcStopper.ShouldStop():
+				return
+			}
+		}
+	})
 }
@@ -5,7 +5,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/journeymidnight/autumn/manager/pmclient"
 	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/rangepartition/table"
+	"github.com/journeymidnight/autumn/rangepartition/y"
 	"github.com/journeymidnight/autumn/streamclient"
 	"github.com/journeymidnight/autumn/xlog"
 	"github.com/stretchr/testify/require"
@@ -85,3 +89,214 @@ func TestLogReplay(t *testing.T) {
 	})
 
 }
+
+func TestValueLogGC(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	defer logStream.Close()
+	defer rowStream.Close()
+	pmclient := new(pmclient.MockPMClient)
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+
+	//overwrite big values, so log stream has several extents of stale values
+	value := func(round, i int) []byte {
+		return []byte(fmt.Sprintf("%02d-%02d-%02044d", round, i, 0))
+	}
+	//values of live keys are never overwritten, gc moves them
+	var liveSeqs []uint64
+	for i := 0; i < 10; i++ {
+		seq, err := rp.Write([]byte(fmt.Sprintf("live%02d", i)), value(0, i), 0)
+		require.NoError(t, err)
+		liveSeqs = append(liveSeqs, seq)
+	}
+	rounds := 10
+	var seqs []uint64
+	for round := 0; round < rounds; round++ {
+		for i := 0; i < 100; i++ {
+			seq, err := rp.Write([]byte(fmt.Sprintf("key%02d", i)), value(round, i), 0)
+			require.NoError(t, err)
+			seqs = append(seqs, seq)
+		}
+	}
+	require.NoError(t, rp.Close())
+
+	rp = OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{Discard: pmclient.Discard})
+
+	//compaction finds stale values
+	var tbls []*table.Table
+	rp.tableLock.RLock()
+	for _, t := range rp.tables {
+		t.IncrRef()
+		tbls = append(tbls, t)
+	}
+	rp.tableLock.RUnlock()
+	require.NoError(t, rp.doCompact(tbls, true))
+	rp.removeTables(tbls)
+	require.NotNil(t, pmclient.Discard)
+	require.True(t, len(pmclient.Discard.Discards) > 0)
+
+	prefix, _, _, err := rp.pickGC(gcDiscardRatio)
+	require.NoError(t, err)
+	require.True(t, len(prefix) > 0)
+
+	//values are moved but the log is not truncated yet, watchers do not see
+	//the moved ones
+	logExtents := rp.logStream.ExtentIDs()
+	toMove := make(map[uint64]bool)
+	for _, extentID := range logExtents[:len(logExtents)-1] {
+		toMove[extentID] = true
+	}
+	_, moved, err := rp.moveValues(rp.logStream, func(extentID uint64) bool { return toMove[extentID] })
+	require.NoError(t, err)
+	require.True(t, moved > 0)
+	all := append(liveSeqs, seqs...)
+	events, err := watchUpTo(rp, nil, all[0], all[len(all)-1])
+	require.NoError(t, err)
+	require.Equal(t, len(all), len(events))
+	for i, ev := range events {
+		require.Equal(t, all[i], ev.Seq)
+	}
+
+	require.NoError(t, rp.runGC(gcDiscardRatio))
+	for _, extentID := range prefix {
+		_, ok := pmclient.Discard.Discards[extentID]
+		require.False(t, ok)
+		_, _, err := logStream.Read(context.Background(), extentID, 0, 1)
+		require.Error(t, err)
+	}
+
+	check := func() {
+		for i := 0; i < 100; i++ {
+			v, err := rp.Get([]byte(fmt.Sprintf("key%02d", i)), 0)
+			require.NoError(t, err)
+			require.Equal(t, value(rounds-1, i), v)
+		}
+	}
+	check()
+
+	//events in truncated extents are gone, moved values are not events again
+	truncated := pmclient.Discard.TruncatedSeq
+	require.True(t, truncated > 0)
+	last := seqs[len(seqs)-1]
+	_, err = watchUpTo(rp, []byte("key"), truncated, last)
+	require.Equal(t, ErrWatchCompacted, err)
+	events, err = watchUpTo(rp, []byte("key"), truncated+1, last)
+	require.NoError(t, err)
+	var expected []uint64
+	for _, seq := range seqs {
+		if seq > truncated {
+			expected = append(expected, seq)
+		}
+	}
+	require.NotEmpty(t, expected)
+	require.Equal(t, len(expected), len(events))
+	for i, ev := range events {
+		require.Equal(t, expected[i], ev.Seq)
+	}
+
+	//moved values are replayed from log stream
+	require.NoError(t, rp.Close())
+	rp = OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), pmclient.Tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{Discard: pmclient.Discard})
+	check()
+	require.Equal(t, truncated, rp.discard.TruncatedSeq())
+	require.NoError(t, rp.Close())
+}
+
+func TestValueLogGCAfterSplit(t *testing.T) {
+	logStream := streamclient.NewMockStreamClient("log")
+	rowStream := streamclient.NewMockStreamClient("sst")
+	rightLogStream := streamclient.NewMockStreamClient("log")
+	rightRowStream := streamclient.NewMockStreamClient("sst")
+	defer logStream.Close()
+	defer rowStream.Close()
+	defer rightLogStream.Close()
+	defer rightRowStream.Close()
+	rightPMClient := new(pmclient.MockPMClient)
+	pmclient := new(pmclient.MockPMClient)
+
+	rp := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte(""), nil, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{})
+	value := func(round, i int) []byte {
+		return []byte(fmt.Sprintf("%02d-%02d-%02044d", round, i, 0))
+	}
+	rounds := 10
+	for round := 0; round < rounds; round++ {
+		for i := 0; i < 100; i++ {
+			_, err := rp.Write([]byte(fmt.Sprintf("key%02d", i)), value(round, i), 0)
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, rp.Close())
+
+	//PM saves the log head of the parent as its shared extent
+	tables := rp.TableLocs()
+	discard := &pspb.DiscardStats{SharedExtent: rp.LogHead()}
+	left := OpenRangePartition(3, rowStream, logStream, logStream.(streamclient.BlockReader),
+		[]byte(""), []byte("key50"), tables, pmclient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock, OpenOption{Discard: discard})
+	right := OpenRangePartition(4, sharedStream{rightRowStream, rowStream}, rightLogStream, logStream.(streamclient.BlockReader),
+		[]byte("key50"), []byte(""), tables, rightPMClient, streamclient.OpenMockStreamClient, streamclient.UpdateStreamMock,
+		OpenOption{Discard: &pspb.DiscardStats{SharedFrom: []uint64{3}}})
+	defer left.Close()
+	defer right.Close()
+
+	//compaction of the parent finds stale values, values of the right half are dropped too
+	var tbls []*table.Table
+	left.tableLock.RLock()
+	for _, t := range left.tables {
+		t.IncrRef()
+		tbls = append(tbls, t)
+	}
+	left.tableLock.RUnlock()
+	require.NoError(t, left.doCompact(tbls, true))
+	left.removeTables(tbls)
+	require.True(t, len(pmclient.Discard.Discards) > 0)
+
+	//extents read by the right half are not collected
+	extents := logStream.ExtentIDs()
+	prefix, _, _, err := left.pickGC(gcDiscardRatio)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(prefix))
+	require.NoError(t, left.runGC(gcDiscardRatio))
+	require.Equal(t, extents, logStream.ExtentIDs())
+
+	check := func() {
+		for i := 0; i < 100; i++ {
+			part := left
+			if i >= 50 {
+				part = right
+			}
+			v, err := part.Get([]byte(fmt.Sprintf("key%02d", i)), 0)
+			require.NoError(t, err)
+			require.Equal(t, value(rounds-1, i), v)
+		}
+	}
+	check()
+
+	//major compaction of the right half copies values in the log of the parent
+	//into its tables, then it stops reading the log
+	require.True(t, right.runCompact(true))
+	require.Empty(t, rightPMClient.Discard.SharedFrom)
+	require.Empty(t, right.discard.SharedFrom())
+	right.tableLock.RLock()
+	for _, tbl := range right.tables {
+		it := tbl.NewIterator(false)
+		for it.Rewind(); it.Valid(); it.Next() {
+			require.Zero(t, it.Value().Meta&y.BitValuePointer)
+		}
+		it.Close()
+	}
+	right.tableLock.RUnlock()
+
+	//PM clears the shared extent of the parent, its gc truncates the log
+	left.discard.SetShared(&pspb.DiscardStats{})
+	prefix, _, _, err = left.pickGC(gcDiscardRatio)
+	require.NoError(t, err)
+	require.True(t, len(prefix) > 0)
+	require.NoError(t, left.runGC(gcDiscardRatio))
+	require.NotEqual(t, extents, logStream.ExtentIDs())
+	check()
+}
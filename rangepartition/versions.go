@@ -0,0 +1,169 @@
+package rangepartition
+
+import (
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/journeymidnight/autumn/proto/pspb"
+	"github.com/journeymidnight/autumn/rangepartition/y"
+)
+
+//versions only have seqNumbers, the age of a version is found by the first
+//VersionTime whose seq is not smaller than it. Every memtable flush adds one,
+//they are saved in each table, so they are loaded with tables
+const maxVersionTimes = 1024
+
+//SetRetention makes compaction keep the latest maxVersions versions of each key,
+//and versions written in the last retention. 0 and 0 keep the latest version only
+func (rp *RangePartition) SetRetention(maxVersions uint32, retention time.Duration) {
+	atomic.StoreUint32(&rp.maxVersions, maxVersions)
+	atomic.StoreInt64(&rp.retentionSecs, int64(retention/time.Second))
+}
+
+//keepsVersions returns true if compaction may keep older versions
+func (rp *RangePartition) keepsVersions() bool {
+	return atomic.LoadUint32(&rp.maxVersions) > 1 || atomic.LoadInt64(&rp.retentionSecs) > 0
+}
+
+//addVersionTimes merges times into rp.versionTimes. When there are too many, every
+//other old one is removed, versions before it look newer, so they are kept longer
+func (rp *RangePartition) addVersionTimes(times []*pspb.VersionTime) {
+	if len(times) == 0 {
+		return
+	}
+	rp.versionLock.Lock()
+	defer rp.versionLock.Unlock()
+	all := append(append([]*pspb.VersionTime{}, rp.versionTimes...), times...)
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].Seq < all[j].Seq
+	})
+	merged := all[:0]
+	for _, vt := range all {
+		if len(merged) > 0 && merged[len(merged)-1].Seq == vt.Seq {
+			continue
+		}
+		merged = append(merged, vt)
+	}
+	for len(merged) > maxVersionTimes {
+		thinned := merged[:0]
+		for i, vt := range merged {
+			if i%2 == 1 || i == len(merged)-1 {
+				thinned = append(thinned, vt)
+			}
+		}
+		merged = thinned
+	}
+	rp.versionTimes = merged
+}
+
+func (rp *RangePartition) getVersionTimes() []*pspb.VersionTime {
+	rp.versionLock.RLock()
+	defer rp.versionLock.RUnlock()
+	return append([]*pspb.VersionTime{}, rp.versionTimes...)
+}
+
+//retention decides which versions of a key are kept by compaction
+type retention struct {
+	maxVersions int
+	cutoff      int64 //versions written after it are kept, 0 means none
+	times       []*pspb.VersionTime
+}
+
+func (rp *RangePartition) retention() retention {
+	r := retention{maxVersions: int(atomic.LoadUint32(&rp.maxVersions))}
+	if r.maxVersions == 0 {
+		r.maxVersions = 1
+	}
+	if d := atomic.LoadInt64(&rp.retentionSecs); d > 0 {
+		r.cutoff = time.Now().Unix() - d
+		r.times = rp.getVersionTimes()
+	}
+	return r
+}
+
+//keeps returns true if the version is kept when there are n newer versions of
+//the key kept. A version is never kept if the next newer one is not
+func (r retention) keeps(n int, version uint64) bool {
+	if n < r.maxVersions {
+		return true
+	}
+	if r.cutoff == 0 {
+		return false
+	}
+	i := sort.Search(len(r.times), func(i int) bool {
+		return r.times[i].Seq >= version
+	})
+	//not flushed yet
+	if i == len(r.times) {
+		return true
+	}
+	return r.times[i].UnixTime > r.cutoff
+}
+
+//KeyVersion is a version of a key returned by GetVersions
+type KeyVersion struct {
+	Version   uint64
+	Value     []byte
+	Deleted   bool //the key is deleted at Version
+	ExpiresAt uint64
+}
+
+//GetVersions returns versions of userKey older than before from the newest one,
+//before 0 means all versions. Expired versions are returned too. It returns true
+//if there are more versions
+func (rp *RangePartition) GetVersions(userKey []byte, before uint64, limit uint32, values bool) ([]KeyVersion, bool, error) {
+	atomic.AddUint64(&rp.counters.reads, 1)
+	readTs := rp.readTs(0)
+	if before > 0 && before <= readTs {
+		readTs = before - 1
+	}
+	if readTs == 0 {
+		return nil, false, nil
+	}
+	if limit == 0 {
+		limit = math.MaxUint32
+	}
+
+	upper := append(y.Copy(userKey), 0)
+	iter := rp.newIterator(false, userKey, upper, nil)
+	defer iter.Close()
+	dels := rp.rangeDeletes(readTs, userKey, upper)
+
+	var out []KeyVersion
+	seekKey := y.KeyWithTs(userKey, readTs)
+	for iter.Seek(seekKey); iter.Valid(); iter.Next() {
+		if !y.SameKey(seekKey, iter.Key()) {
+			break
+		}
+		//a range tombstone is returned as a delete of the versions it covers
+		vs := iter.Value()
+		if vs.Meta&y.BitRangeDelete > 0 {
+			continue
+		}
+		if uint32(len(out)) >= limit {
+			return out, true, nil
+		}
+		version := y.ParseTs(iter.Key())
+		//older versions are deleted too
+		if seq := deletedBy(dels, userKey, version); seq > 0 {
+			out = append(out, KeyVersion{Version: seq, Deleted: true})
+			break
+		}
+		kv := KeyVersion{
+			Version:   version,
+			Deleted:   vs.Meta&y.BitDelete > 0,
+			ExpiresAt: vs.ExpiresAt,
+		}
+		if values && !kv.Deleted {
+			v, err := rp.getValue(vs)
+			if err != nil {
+				return nil, false, err
+			}
+			kv.Value = y.Copy(v)
+		}
+		out = append(out, kv)
+	}
+	return out, false, nil
+}
@@ -0,0 +1,330 @@
+package rangepartition
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/rangepartition/table"
+	"github.com/journeymidnight/autumn/rangepartition/y"
+	"github.com/pkg/errors"
+)
+
+const watchChCapacity = 64
+
+var ErrWatchStopped = errors.New("watch stopped")
+
+//ErrWatchCompacted means events to watch are in log extents truncated by gc
+var ErrWatchCompacted = errors.New("watch start is compacted")
+
+//WatchEvent is a put or a delete committed at Seq
+type WatchEvent struct {
+	Key       []byte
+	Value     []byte
+	Seq       uint64
+	ExpiresAt uint64
+	Delete    bool
+	//keys in [Key, End) are deleted, empty End means no upper bound
+	DeleteRange bool
+	End         []byte
+	blob        []byte //valuePointer into blob stream, resolved before events are delivered
+}
+
+//matches returns true if the event changes keys having prefix
+func (ev *WatchEvent) matches(prefix []byte) bool {
+	if !ev.DeleteRange {
+		return bytes.HasPrefix(ev.Key, prefix)
+	}
+	return rangeDelete{start: ev.Key, end: ev.End}.overlaps(prefix, prefixEnd(prefix))
+}
+
+type watcher struct {
+	prefix []byte
+	ch     chan []WatchEvent
+	//set if ch is full, the watcher reads missed events from log stream
+	overflow int32
+}
+
+type watchers struct {
+	sync.Mutex
+	all map[*watcher]struct{}
+	num int32 //atomic, skip building events if nobody watches
+}
+
+func (rp *RangePartition) addWatcher(prefix []byte) *watcher {
+	w := &watcher{
+		prefix: prefix,
+		ch:     make(chan []WatchEvent, watchChCapacity),
+	}
+	rp.watchers.Lock()
+	if rp.watchers.all == nil {
+		rp.watchers.all = make(map[*watcher]struct{})
+	}
+	rp.watchers.all[w] = struct{}{}
+	atomic.AddInt32(&rp.watchers.num, 1)
+	rp.watchers.Unlock()
+	return w
+}
+
+func (rp *RangePartition) removeWatcher(w *watcher) {
+	rp.watchers.Lock()
+	delete(rp.watchers.all, w)
+	atomic.AddInt32(&rp.watchers.num, -1)
+	rp.watchers.Unlock()
+}
+
+func (rp *RangePartition) toWatchEvent(ei *pb.EntryInfo) (WatchEvent, error) {
+	ev := WatchEvent{
+		Key:       y.ParseKey(ei.Log.Key),
+		Seq:       y.ParseTs(ei.Log.Key),
+		ExpiresAt: ei.Log.ExpiresAt,
+		Delete:    ei.Log.Meta&uint32(y.BitDelete) > 0,
+	}
+	if ev.Delete {
+		if ei.Log.Meta&uint32(y.BitRangeDelete) > 0 {
+			ev.DeleteRange = true
+			ev.End = append([]byte{}, ei.Log.Value...)
+		}
+		return ev, nil
+	}
+	//big values read from log stream do not carry values
+	if ei.Log.Meta&uint32(y.BitValuePointer) > 0 && len(ei.Log.Value) == 0 {
+		vp := valuePointer{extentID: ei.ExtentID, offset: ei.Offset}
+		v, err := rp.getValue(y.ValueStruct{Meta: y.BitValuePointer, Value: vp.Encode()})
+		if err != nil {
+			return ev, err
+		}
+		ev.Value = v
+		return ev, nil
+	}
+	if ei.Log.Meta&uint32(y.BitBlobPointer) > 0 {
+		v, err := rp.blobValue(ei.Log)
+		if err != nil {
+			return ev, err
+		}
+		ev.Value = v
+		return ev, nil
+	}
+	ev.Value = append([]byte{}, ei.Log.Value...)
+	return ev, nil
+}
+
+//publish sends committed entries to watchers, it is called by writeRequests in
+//the order of seqNumber. GC rewrites (seq == 0) are not new mutations.
+func (rp *RangePartition) publish(reqs []*request) {
+	if atomic.LoadInt32(&rp.watchers.num) == 0 {
+		return
+	}
+	var events []WatchEvent
+	for _, req := range reqs {
+		if req.seq == 0 || req.Err != nil {
+			continue
+		}
+		for _, ei := range req.entries {
+			ev := WatchEvent{
+				Key:       append([]byte{}, y.ParseKey(ei.Log.Key)...),
+				Seq:       y.ParseTs(ei.Log.Key),
+				ExpiresAt: ei.Log.ExpiresAt,
+				Delete:    ei.Log.Meta&uint32(y.BitDelete) > 0,
+			}
+			switch {
+			case ev.Delete:
+				if ei.Log.Meta&uint32(y.BitRangeDelete) > 0 {
+					ev.DeleteRange = true
+					ev.End = append([]byte{}, ei.Log.Value...)
+				}
+			case ei.Log.Meta&uint32(y.BitBlobPointer) > 0:
+				//do not read blob stream in write loop
+				ev.blob = append([]byte{}, ei.Log.Value...)
+			default:
+				ev.Value = append([]byte{}, ei.Log.Value...)
+			}
+			events = append(events, ev)
+		}
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	rp.watchers.Lock()
+	defer rp.watchers.Unlock()
+	for w := range rp.watchers.all {
+		var matched []WatchEvent
+		for _, ev := range events {
+			if ev.matches(w.prefix) {
+				matched = append(matched, ev)
+			}
+		}
+		if len(matched) == 0 || atomic.LoadInt32(&w.overflow) == 1 {
+			continue
+		}
+		select {
+		case w.ch <- matched:
+		default:
+			atomic.StoreInt32(&w.overflow, 1)
+		}
+	}
+}
+
+//watchStart returns where events after last start in log stream, it is the value
+//pointer of the newest table which has no events after last, or the start of log
+//stream. It returns ErrWatchCompacted if events after last may be truncated
+func (rp *RangePartition) watchStart(last uint64) (uint64, uint32, error) {
+	if last < rp.discard.TruncatedSeq() {
+		return 0, 0, ErrWatchCompacted
+	}
+	logExtents := rp.logStream.ExtentIDs()
+	inLog := make(map[uint64]bool, len(logExtents))
+	for _, extentID := range logExtents {
+		inLog[extentID] = true
+	}
+	rp.tableLock.RLock()
+	defer rp.tableLock.RUnlock()
+	var start *table.Table
+	for _, t := range rp.tables {
+		if inLog[t.VpExtentID] && t.LastSeq <= last && (start == nil || t.LastSeq > start.LastSeq) {
+			start = t
+		}
+	}
+	if start == nil {
+		return 0, 0, nil
+	}
+	return start.VpExtentID, start.VpOffset, nil
+}
+
+//catchUp reads events in (last, upTo] from log stream
+func (rp *RangePartition) catchUp(prefix []byte, last, upTo uint64, fn func([]WatchEvent) error) (uint64, error) {
+	from := last
+	extentID, offset, err := rp.watchStart(last)
+	if err != nil {
+		return last, err
+	}
+	var events []WatchEvent
+	flush := func() error {
+		if len(events) == 0 {
+			return nil
+		}
+		err := fn(events)
+		events = nil
+		return err
+	}
+	var ferr error
+	err = replayLog(rp.logStream, extentID, offset, true, func(ei *pb.EntryInfo) (bool, error) {
+		if len(ei.Log.Key) == 0 {
+			return true, nil
+		}
+		//values moved by gc or from merged logs keep their seqs, they are
+		//not new mutations, and may be newer than last
+		if ei.Log.Meta&bitMoved > 0 {
+			return true, nil
+		}
+		seq := y.ParseTs(ei.Log.Key)
+		userKey := y.ParseKey(ei.Log.Key)
+		if seq <= last || seq > upTo || !rp.InRange(userKey) {
+			return true, nil
+		}
+		isRange := ei.Log.Meta&uint32(y.BitRangeDelete) > 0
+		if !(&WatchEvent{Key: userKey, DeleteRange: isRange, End: ei.Log.Value}).matches(prefix) {
+			return true, nil
+		}
+		ev, err := rp.toWatchEvent(ei)
+		if err != nil {
+			return false, err
+		}
+		events = append(events, ev)
+		last = seq
+		if len(events) >= watchChCapacity {
+			if ferr = flush(); ferr != nil {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return last, err
+	}
+	if ferr != nil {
+		return last, ferr
+	}
+	//gc may truncate the log while it is read
+	if from < rp.discard.TruncatedSeq() {
+		return last, ErrWatchCompacted
+	}
+	return last, flush()
+}
+
+//Watch calls fn with puts and deletes of keys having prefix in the order of
+//seqNumber, starting from fromSeq. fromSeq 0 means from the next write. Events
+//before the current write are read from log stream. Value log GC truncates old
+//log extents, events in them are gone, so Watch returns ErrWatchCompacted if
+//fromSeq is before the log head, or a slow watcher falls behind it. Watch returns
+//when fn returns error, or stop is closed. A client can resume from the Seq of
+//the last event plus 1.
+func (rp *RangePartition) Watch(prefix []byte, fromSeq uint64, stop <-chan struct{}, fn func([]WatchEvent) error) error {
+	w := rp.addWatcher(prefix)
+	defer rp.removeWatcher(w)
+
+	var last uint64
+	if fromSeq == 0 {
+		last = atomic.LoadUint64(&rp.commitSeq)
+	} else {
+		last = fromSeq - 1
+	}
+
+	for {
+		//events after commitSeq will be published to w
+		commitSeq := atomic.LoadUint64(&rp.commitSeq)
+		if last < commitSeq {
+			var err error
+			if last, err = rp.catchUp(prefix, last, commitSeq, fn); err != nil {
+				return err
+			}
+			last = commitSeq
+		}
+
+		for atomic.LoadInt32(&w.overflow) == 0 {
+			select {
+			case <-stop:
+				return nil
+			case <-rp.writeStopper.ShouldStop():
+				return ErrWatchStopped
+			case events := <-w.ch:
+				i := 0
+				for i < len(events) && events[i].Seq <= last {
+					i++
+				}
+				if i == len(events) {
+					continue
+				}
+				events = events[i:]
+				//each watcher has its own copy of events
+				for j := range events {
+					if events[j].blob == nil {
+						continue
+					}
+					v, err := rp.getValue(y.ValueStruct{Meta: y.BitValuePointer, Value: events[j].blob})
+					if err != nil {
+						return err
+					}
+					events[j].Value, events[j].blob = v, nil
+				}
+				if err := fn(events); err != nil {
+					return err
+				}
+				last = events[len(events)-1].Seq
+			}
+		}
+
+		//missed some events, drop the queued ones and read them from log stream
+	drain:
+		for {
+			select {
+			case <-w.ch:
+			default:
+				break drain
+			}
+		}
+		atomic.StoreInt32(&w.overflow, 0)
+	}
+}
@@ -127,6 +127,8 @@ func BytesToU32Slice(b []byte) []uint32 {
 const (
 	BitDelete       byte = 1 << 0    // Set if the key has been deleted.
 	BitValuePointer byte = 1 << 1    // Set if the value is NOT stored directly next to key.
+	BitBlobPointer  byte = 1 << 2    // Set in log entries whose value is a valuePointer into a blob stream.
+	BitRangeDelete  byte = 1 << 3    // Set with BitDelete, keys in [key, value) older than the version are deleted.
 	ValueThrottle        = (1 << 10) // 1 *KB
 )
 
@@ -0,0 +1,186 @@
+package streamclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/journeymidnight/autumn/extent"
+	"github.com/journeymidnight/autumn/proto/pb"
+	"github.com/journeymidnight/autumn/utils"
+	"github.com/journeymidnight/autumn/wire_errors"
+	"github.com/pkg/errors"
+)
+
+var errLocalStream = errors.New("not supported by local stream")
+
+//LocalStreamClient is a stream in a local directory, each extent is a file named
+//by its ID. It is used to write and read tables out of the cluster, e.g. exported
+//partitions. Extents are sealed when they are bigger than MaxExtentSize
+type LocalStreamClient struct {
+	utils.SafeMutex //protect exs
+	dir             string
+	exs             []*extent.Extent
+}
+
+func localExtentName(dir string, id uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%d.ext", id))
+}
+
+//NewLocalStreamClient creates an empty stream in dir
+func NewLocalStreamClient(dir string) (*LocalStreamClient, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	ex, err := extent.CreateExtent(localExtentName(dir, 1), 1)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalStreamClient{
+		dir: dir,
+		exs: []*extent.Extent{ex},
+	}, nil
+}
+
+//OpenLocalStreamClient opens the stream in dir created by NewLocalStreamClient
+func OpenLocalStreamClient(dir string) (*LocalStreamClient, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.ext"))
+	if err != nil {
+		return nil, err
+	}
+	var ids []uint64
+	for _, name := range names {
+		id, err := strconv.ParseUint(strings.TrimSuffix(filepath.Base(name), ".ext"), 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, errors.Errorf("no extents in %s", dir)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	client := &LocalStreamClient{dir: dir}
+	for _, id := range ids {
+		ex, err := extent.OpenExtent(localExtentName(dir, id))
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		client.exs = append(client.exs, ex)
+	}
+	return client, nil
+}
+
+func (client *LocalStreamClient) Connect() error {
+	return nil
+}
+
+//Close closes extents, files are kept
+func (client *LocalStreamClient) Close() {
+	for _, ex := range client.exs {
+		ex.Close()
+	}
+}
+
+func (client *LocalStreamClient) ExtentIDs() []uint64 {
+	client.RLock()
+	defer client.RUnlock()
+	var ret []uint64
+	for _, ex := range client.exs {
+		ret = append(ret, ex.ID)
+	}
+	return ret
+}
+
+func (client *LocalStreamClient) AppendEntries(ctx context.Context, entries []*pb.EntryInfo) (uint64, uint32, error) {
+	blocks := make([]*pb.Block, 0, len(entries))
+	for _, entry := range entries {
+		blocks = append(blocks, &pb.Block{Data: utils.MustMarshal(entry.Log)})
+	}
+	extentID, offsets, tail, err := client.Append(ctx, blocks)
+	if err != nil {
+		return 0, 0, err
+	}
+	for i := range entries {
+		entries[i].ExtentID = extentID
+		entries[i].Offset = offsets[i]
+	}
+	return extentID, tail, nil
+}
+
+func (client *LocalStreamClient) Append(ctx context.Context, blocks []*pb.Block) (uint64, []uint32, uint32, error) {
+	client.Lock()
+	defer client.Unlock()
+	ex := client.exs[len(client.exs)-1]
+
+	ex.Lock()
+	offsets, end, err := ex.AppendBlocks(blocks, true)
+	ex.Unlock()
+	if err != nil {
+		return 0, nil, 0, err
+	}
+
+	if ex.CommitLength() > uint32(MaxExtentSize) {
+		if err = ex.Seal(ex.CommitLength()); err != nil {
+			return 0, nil, 0, err
+		}
+		newEx, err := extent.CreateExtent(localExtentName(client.dir, ex.ID+1), ex.ID+1)
+		if err != nil {
+			return 0, nil, 0, err
+		}
+		client.exs = append(client.exs, newEx)
+	}
+	return ex.ID, offsets, end, nil
+}
+
+func (client *LocalStreamClient) Read(ctx context.Context, extentID uint64, offset uint32, numOfBlocks uint32) ([]*pb.Block, uint32, error) {
+	var ex *extent.Extent
+	client.RLock()
+	for i := range client.exs {
+		if client.exs[i].ID == extentID {
+			ex = client.exs[i]
+			break
+		}
+	}
+	client.RUnlock()
+	if ex == nil {
+		return nil, 0, errors.Errorf("no extent %d in %s", extentID, client.dir)
+	}
+
+	blocks, _, end, err := ex.ReadBlocks(offset, numOfBlocks, (32 << 20))
+	if err == wire_errors.EndOfExtent || err == wire_errors.EndOfStream {
+		return blocks, end, io.EOF
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return blocks, end, nil
+}
+
+//local streams only have tables
+func (client *LocalStreamClient) NewLogEntryIter(opts ...ReadOption) LogEntryIter {
+	return localLogEntryIter{}
+}
+
+func (client *LocalStreamClient) Truncate(ctx context.Context, extentID uint64) (pb.StreamInfo, pb.StreamInfo, error) {
+	return pb.StreamInfo{}, pb.StreamInfo{}, errLocalStream
+}
+
+type localLogEntryIter struct{}
+
+func (iter localLogEntryIter) HasNext() (bool, error) {
+	return false, errLocalStream
+}
+
+func (iter localLogEntryIter) Next() *pb.EntryInfo {
+	return nil
+}
@@ -81,13 +81,18 @@ func UpdateStreamMock([]pb.StreamInfo) {
 }
 
 func (client *MockStreamClient) Truncate(ctx context.Context, extentID uint64) (pb.StreamInfo, pb.StreamInfo, error) {
-
-	var i int
-	for i = range client.exs {
-		if client.exs[i].ID == extentID {
+	client.Lock()
+	defer client.Unlock()
+	i := -1
+	for j := range client.exs {
+		if client.exs[j].ID == extentID {
+			i = j
 			break
 		}
 	}
+	if i < 0 {
+		return pb.StreamInfo{}, pb.StreamInfo{}, errors.Errorf("extent %d is not in stream %d", extentID, client.ID)
+	}
 	if i == 0 {
 		return pb.StreamInfo{}, pb.StreamInfo{}, errNoTrucate
 	}
@@ -113,6 +118,16 @@ func (client *MockStreamClient) Truncate(ctx context.Context, extentID uint64) (
 	return blobStreamInfo, myStreamInfo, nil
 }
 
+func (client *MockStreamClient) ExtentIDs() []uint64 {
+	client.RLock()
+	defer client.RUnlock()
+	var ret []uint64
+	for _, ex := range client.exs {
+		ret = append(ret, ex.ID)
+	}
+	return ret
+}
+
 //block API, entries has been batched
 func (client *MockStreamClient) AppendEntries(ctx context.Context, entries []*pb.EntryInfo) (uint64, uint32, error) {
 	blocks := make([]*pb.Block,0, len(entries))
@@ -122,8 +137,15 @@ func (client *MockStreamClient) AppendEntries(ctx context.Context, entries []*pb
         blocks = append(blocks,  &pb.Block{
                        data,
         })}
-    extentID, _, tail, err := client.Append(ctx, blocks)
-    return extentID, tail, err
+	extentID, offsets, tail, err := client.Append(ctx, blocks)
+	if err != nil {
+		return 0, 0, err
+	}
+	for i := range entries {
+		entries[i].ExtentID = extentID
+		entries[i].Offset = offsets[i]
+	}
+	return extentID, tail, nil
 }
 
 //block API
@@ -200,11 +222,13 @@ func (client *MockStreamClient) NewLogEntryIter(opts ...ReadOption) LogEntryIter
 		x.currentOffset = 0
 		x.currentIndex = 0
 	} else {
-		x.currentOffset = readOpt.Offset
-
+		//read from start if extentID is not in this stream
+		x.currentOffset = 0
+		x.currentIndex = 0
 		for i := range client.exs {
 			if client.exs[i].ID == readOpt.ExtentID {
 				x.currentIndex = i
+				x.currentOffset = readOpt.Offset
 			}
 		}
 
@@ -44,6 +44,8 @@ type StreamClient interface {
 	NewLogEntryIter(opt ...ReadOption) LogEntryIter
 	Read(ctx context.Context, extentID uint64, offset uint32, numOfBlocks uint32) ([]*pb.Block, uint32, error)
 	Truncate(ctx context.Context, extentID uint64) (pb.StreamInfo, pb.StreamInfo, error)
+	//ExtentIDs returns extents of the stream, the last one is being written
+	ExtentIDs() []uint64
 	//FIXME: stat => ([]extentID , offset)
 }
 
@@ -248,33 +250,49 @@ func (sc *AutumnStreamClient) NewLogEntryIter(opts ...ReadOption) LogEntryIter {
 	} else {
 		leIter.currentOffset = readOpt.Offset
 		leIter.currentExtentIndex = sc.getExtentIndexFromID(readOpt.ExtentID)
-		/*
-			if leIter.currentExtentIndex < 0 {
-				return nil, errors.Errorf("can not find extentID %d in stream %d", opt.ExtentID, sc.streamID)
-			}
-		*/
+		//extentID is not in this stream(for example, tables inherited from
+		//the parent partition after split), all entries are newer, read from start
+		if leIter.currentExtentIndex < 0 {
+			leIter.currentExtentIndex = 0
+			leIter.currentOffset = 0
+		}
 	}
 	return leIter
 }
 
+//Truncate removes extents before extentID from the stream, SM deletes them on
+//extent nodes. It returns the removed extents and the rest of the stream
 func (sc *AutumnStreamClient) Truncate(ctx context.Context, extentID uint64) (pb.StreamInfo, pb.StreamInfo, error) {
 	sc.Lock()
 	defer sc.Unlock()
-	var i int
-	for i = range sc.streamInfo.ExtentIDs {
-		if sc.streamInfo.ExtentIDs[i] == extentID {
+	i := -1
+	for j := range sc.streamInfo.ExtentIDs {
+		if sc.streamInfo.ExtentIDs[j] == extentID {
+			i = j
 			break
 		}
 	}
+	if i < 0 {
+		return pb.StreamInfo{}, pb.StreamInfo{}, errors.Errorf("extent %d is not in stream %d", extentID, sc.streamID)
+	}
 	if i == 0 {
 		return pb.StreamInfo{}, pb.StreamInfo{}, errNoTrucate
 	}
-	/*
-		sc.streamInfo.ExtentIDs = sc.streamInfo.ExtentIDs[i:]
-		return sc.smClient.TruncateStream(ctx, sc.streamID, sc.streamInfo.ExtentIDs)
-	*/
-	//FIXME:
-	return pb.StreamInfo{}, pb.StreamInfo{}, errNoTrucate
+	if err := sc.smClient.TruncateStream(ctx, sc.streamID, extentID); err != nil {
+		return pb.StreamInfo{}, pb.StreamInfo{}, err
+	}
+	front := pb.StreamInfo{
+		StreamID:  sc.streamID,
+		ExtentIDs: append([]uint64{}, sc.streamInfo.ExtentIDs[:i]...),
+	}
+	sc.streamInfo.ExtentIDs = append([]uint64{}, sc.streamInfo.ExtentIDs[i:]...)
+	return front, *sc.streamInfo, nil
+}
+
+func (sc *AutumnStreamClient) ExtentIDs() []uint64 {
+	sc.RLock()
+	defer sc.RUnlock()
+	return append([]uint64{}, sc.streamInfo.ExtentIDs...)
 }
 
 func (sc *AutumnStreamClient) getExtentIndexFromID(extentID uint64) int {
@@ -344,6 +362,41 @@ func (sc *AutumnStreamClient) MustAllocNewExtent(oldExtentID uint64, dataShard,
 	return nil
 }
 
+//SealLastExtent seals the last extent and appends a new one to the stream, so
+//appends of other clients which still write the old last extent fail
+func (sc *AutumnStreamClient) SealLastExtent() error {
+	extentID, _, err := sc.getLastExtentConn()
+	if err != nil {
+		return err
+	}
+	dataShard, parityShard, err := sc.extentShards(extentID)
+	if err != nil {
+		return err
+	}
+	return sc.MustAllocNewExtent(extentID, dataShard, parityShard)
+}
+
+//Shards returns dataShard and parityShard of the last extent, new extents of
+//the stream are created with the same layout
+func (sc *AutumnStreamClient) Shards() (uint32, uint32, error) {
+	sc.RLock()
+	if sc.streamInfo == nil || len(sc.streamInfo.ExtentIDs) == 0 {
+		sc.RUnlock()
+		return 0, 0, errors.New("no streamInfo or streamInfo is not correct")
+	}
+	extentID := sc.streamInfo.ExtentIDs[len(sc.streamInfo.ExtentIDs)-1]
+	sc.RUnlock()
+	return sc.extentShards(extentID)
+}
+
+func (sc *AutumnStreamClient) extentShards(extentID uint64) (uint32, uint32, error) {
+	exInfo := sc.em.GetExtentInfo(extentID)
+	if exInfo == nil {
+		return 0, 0, errors.Errorf("no such extent %d", extentID)
+	}
+	return uint32(len(exInfo.Replicates)), uint32(len(exInfo.Parity)), nil
+}
+
 func (sc *AutumnStreamClient) Connect() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	s, _, err := sc.smClient.StreamInfo(ctx, []uint64{sc.streamID})
@@ -374,8 +427,16 @@ func (sc *AutumnStreamClient) AppendEntries(ctx context.Context, entries []*pb.E
                        data,
                })
     }
-	extentID, _, tail, err := sc.Append(ctx, blocks)
-    return extentID, tail, err
+	extentID, offsets, tail, err := sc.Append(ctx, blocks)
+	if err != nil {
+		return 0, 0, err
+	}
+	//big values in LSM point to where they are
+	for i := range entries {
+		entries[i].ExtentID = extentID
+		entries[i].Offset = offsets[i]
+	}
+	return extentID, tail, nil
 }
 
 func (sc *AutumnStreamClient) Read(ctx context.Context, extentID uint64, offset uint32, numOfBlocks uint32) ([]*pb.Block, error) {
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/journeymidnight/autumn/proto/pb"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -11,8 +12,47 @@ var (
 	EndOfStream = errors.New("EndOfStream")
 	VersionLow = errors.New("version too low")
 	NotLeader = errors.New("not a leader")
+	PreconditionFailed = errors.New("precondition failed")
+	//the partition is not served by this PS, clients should refresh regions
+	Redirect = errors.New("partition is not on this PS")
+	PSVersionMismatch = errors.New("psversion mismatch")
+	//the key does not exist, is deleted or expired
+	NotFound = errors.New("not found")
+	//events to watch have been truncated from log stream
+	WatchCompacted = errors.New("watch start is compacted")
 )
 
+//IsRedirect checks error returned by grpc
+func IsRedirect(err error) bool {
+	if err == nil {
+		return false
+	}
+	return err == Redirect || status.Convert(err).Message() == Redirect.Error()
+}
+
+//IsPSVersionMismatch checks error returned by grpc
+func IsPSVersionMismatch(err error) bool {
+	if err == nil {
+		return false
+	}
+	return err == PSVersionMismatch || status.Convert(err).Message() == PSVersionMismatch.Error()
+}
+
+//IsNotFound checks error returned by grpc
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return err == NotFound || status.Convert(err).Message() == NotFound.Error()
+}
+
+//IsWatchCompacted checks error returned by grpc
+func IsWatchCompacted(err error) bool {
+	if err == nil {
+		return false
+	}
+	return err == WatchCompacted || status.Convert(err).Message() == WatchCompacted.Error()
+}
 
 func FromPBCode(code pb.Code, des string) error {
 	switch code {
@@ -24,6 +64,10 @@ func FromPBCode(code pb.Code, des string) error {
 		return VersionLow
 	case pb.Code_NotLEADER:
 		return NotLeader
+	case pb.Code_PreconditionFailed:
+		return PreconditionFailed
+	case pb.Code_EPSVersion:
+		return PSVersionMismatch
 	case pb.Code_OK:
 		return nil
 	default:
@@ -41,6 +85,10 @@ func ConvertToPBCode(err error) (pb.Code, string) {
 		return pb.Code_EVersionLow, err.Error()
 	case NotLeader:
 		return pb.Code_NotLEADER, err.Error()
+	case PreconditionFailed:
+		return pb.Code_PreconditionFailed, err.Error()
+	case PSVersionMismatch:
+		return pb.Code_EPSVersion, err.Error()
 	case nil:
 		return pb.Code_OK, ""
 	default: